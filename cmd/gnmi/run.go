@@ -1,16 +1,21 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
+	"path/filepath"
 	"reflect"
+	"time"
 
 	"github.com/openconfig/ygot/ygot"
 
 	oopt "github.com/osrg/oopt/pkg/gnmi"
 	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/sonic"
 )
 
 var (
@@ -20,8 +25,79 @@ var (
 
 const (
 	CONFIG_FILE = "config.json"
+	// wait before listening again after the subscription is lost
+	EVENT_LISTEN_RETRY_INTERVAL = 5 * time.Second
 )
 
+// listenEvents keeps the notifications from transyncd in the history while the server runs
+func listenEvents(h *sonic.EventHistory) {
+	for {
+		err := sonic.ListenEvents(context.Background(), h, func(err error) {
+			log.Printf("event: %v", err)
+		})
+		log.Printf("listening events failed: %v", err)
+		time.Sleep(EVENT_LISTEN_RETRY_INTERVAL)
+	}
+}
+
+// loadConfig reads the committed configuration
+func loadConfig() (*model.PacketTransponder, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("%s/%s", git_dir, CONFIG_FILE))
+	if err != nil {
+		return nil, err
+	}
+	config := &model.PacketTransponder{}
+	if err = model.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// evaluateAlarms evaluates the alarms on the state of the committed configuration every interval
+func evaluateAlarms(m *sonic.AlarmManager, interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		config, err := loadConfig()
+		if err != nil {
+			log.Printf("alarm: %v", err)
+			continue
+		}
+		state, err := sonic.SampleAlarmState(config)
+		if err != nil {
+			log.Printf("alarm: %v", err)
+			continue
+		}
+		if err = m.Evaluate(state, time.Now()); err != nil {
+			log.Printf("alarm: %v", err)
+		}
+	}
+}
+
+// collectPM samples the state of the enabled optical modules into the PM bins every interval
+func collectPM(h *sonic.PMHistory, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		config, err := loadConfig()
+		if err != nil {
+			log.Printf("pm: %v", err)
+			continue
+		}
+		for name, module := range config.OpticalModule {
+			if module.Enabled != nil && !*module.Enabled {
+				continue
+			}
+			if err = sonic.FillTransportState(name, module); err != nil {
+				log.Printf("pm: %v", err)
+				continue
+			}
+			if err = h.Add(name, module, now); err != nil {
+				log.Printf("pm: %v", err)
+			}
+		}
+	}
+}
+
 func callback(newConfig ygot.ValidatedGoStruct) error {
 	buf, err := ygot.EmitJSON(newConfig, &ygot.EmitJSONConfig{
 		Format: ygot.RFC7951,
@@ -42,8 +118,25 @@ func callback(newConfig ygot.ValidatedGoStruct) error {
 
 func main() {
 	port := flag.Int64("port", 10164, "Listen port")
+	stateDir := flag.String("state-dir", "/var/lib/oopt", "directory to keep the event history, the alarms and the PM bins")
+	historySize := flag.Int("event-history-size", sonic.DEFAULT_EVENT_HISTORY_SIZE, "number of the events to keep. 0 disables the event history")
+	alarmInterval := flag.Duration("alarm-interval", sonic.DEFAULT_ALARM_INTERVAL, "interval to evaluate the alarms. 0 disables the alarms")
+	clearedAlarmSize := flag.Int("cleared-alarm-size", sonic.DEFAULT_CLEARED_ALARM_SIZE, "number of the cleared alarms to keep")
+	pmInterval := flag.Duration("pm-interval", sonic.DEFAULT_PM_INTERVAL, "interval to sample the PM bins. 0 disables the PM bins")
+	pmHistory15m := flag.Int("pm-history-15m", sonic.DEFAULT_PM_HISTORY_15M, "number of the completed 15 minutes PM bins to keep")
+	pmHistory24h := flag.Int("pm-history-24h", sonic.DEFAULT_PM_HISTORY_24H, "number of the completed 24 hours PM bins to keep")
+	databaseConfig := flag.String("database-config", sonic.DEFAULT_DATABASE_CONFIG_FILE, "SONiC database_config.json to locate the redis databases")
 	flag.Parse()
 
+	dbConfig, err := sonic.LoadDatabaseConfig(*databaseConfig)
+	if os.IsNotExist(err) {
+		dbConfig, err = sonic.DefaultDatabaseConfig(), nil
+	}
+	if err != nil {
+		panic(fmt.Sprintf("database config: %v", err))
+	}
+	sonic.SetDatabaseConfig(dbConfig)
+
 	data, err := ioutil.ReadFile(fmt.Sprintf("%s/%s", git_dir, CONFIG_FILE))
 	if err != nil {
 		panic(fmt.Sprintf("open: %v", err))
@@ -78,5 +171,31 @@ func main() {
 	if err != nil {
 		panic(fmt.Sprintf("NewServer() failed: %v", err))
 	}
+	if *historySize > 0 {
+		history, err := sonic.NewEventHistory(filepath.Join(*stateDir, sonic.EVENT_HISTORY_FILE), *historySize)
+		if err != nil {
+			panic(fmt.Sprintf("event history: %v", err))
+		}
+		srv.SetEventSource(history)
+		go listenEvents(history)
+	}
+	if *alarmInterval > 0 {
+		alarms, err := sonic.NewAlarmManager(filepath.Join(*stateDir, sonic.ALARM_FILE), *clearedAlarmSize)
+		if err != nil {
+			panic(fmt.Sprintf("alarms: %v", err))
+		}
+		srv.SetAlarmSource(alarms)
+		go evaluateAlarms(alarms, *alarmInterval)
+	}
+	if *pmInterval > 0 {
+		pm, err := sonic.NewPMHistory(filepath.Join(*stateDir, sonic.PM_DIR), *pmInterval, map[string]int{
+			sonic.PM_BIN_15M: *pmHistory15m,
+			sonic.PM_BIN_24H: *pmHistory24h,
+		})
+		if err != nil {
+			panic(fmt.Sprintf("pm: %v", err))
+		}
+		go collectPM(pm, *pmInterval)
+	}
 	srv.Serve()
 }
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+func alarmsPath() string {
+	return filepath.Join(viper.GetString("state_dir"), sonic.ALARM_FILE)
+}
+
+func writeAlarms(w io.Writer, alarms []sonic.Alarm, showCleared bool) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if showCleared {
+		fmt.Fprintln(tw, "TIME\tCLEARED\tSEVERITY\tRESOURCE\tTYPE\tTEXT")
+	} else {
+		fmt.Fprintln(tw, "TIME\tSEVERITY\tRESOURCE\tTYPE\tTEXT")
+	}
+	for _, a := range alarms {
+		created := a.TimeCreated.Local().Format(time.RFC3339)
+		if !showCleared {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", created, a.Severity, a.Resource, a.TypeID, a.Text)
+			continue
+		}
+		cleared := "-"
+		if !a.Active() {
+			cleared = a.TimeCleared.Local().Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", created, cleared, a.Severity, a.Resource, a.TypeID, a.Text)
+	}
+	return tw.Flush()
+}
+
+func NewAlarmsCmd() *cobra.Command {
+	var all bool
+	alarmsCmd := &cobra.Command{
+		Use:   "alarms",
+		Short: "show the alarms raised by the gnmi server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			alarms, err := sonic.LoadAlarms(alarmsPath())
+			if err != nil {
+				return err
+			}
+			selected := []sonic.Alarm{}
+			for _, a := range alarms {
+				if all || a.Active() {
+					selected = append(selected, a)
+				}
+			}
+			return writeAlarms(os.Stdout, selected, all)
+		},
+	}
+	alarmsCmd.Flags().BoolVarP(&all, "all", "a", false, "show the cleared alarms too")
+	return alarmsCmd
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/viper"
+	"gopkg.in/src-d/go-git.v4"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+func setupCommitTest(t *testing.T) *sonic.MemoryStore {
+	for _, key := range []string{"git_dir", "state_dir"} {
+		orig := viper.GetString(key)
+		viper.Set(key, t.TempDir())
+		t.Cleanup(func() { viper.Set(key, orig) })
+	}
+	store := sonic.NewMemoryStore()
+	t.Cleanup(sonic.UseMemoryStore(store))
+	if err := initConfig(false); err != nil {
+		t.Fatal(err)
+	}
+	if err := persistentPreRunE(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestCommit(t *testing.T) {
+	store := setupCommitTest(t)
+	config := store.DB(sonic.CONFIG_DB)
+	transport := store.DB(sonic.TRANSPORT_CONFIG_DB)
+
+	current.Interface["Ethernet1"].Mtu = ygot.Uint16(9000)
+	current.Interface["Ethernet2"].Description = ygot.String("uplink")
+	current.OpticalModule["Opt1"].Prbs = ygot.Bool(true)
+	if err := persistentPostRunE(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := commit("test", false); err != nil {
+		t.Fatal(err)
+	}
+
+	if h, _ := config.HGetAll("PORT|Ethernet1"); h["mtu"] != "9000" {
+		t.Errorf("mtu is not applied: %v", h)
+	}
+	if h, _ := config.HGetAll("PORT|Ethernet2"); h["description"] != "uplink" {
+		t.Errorf("description is not applied: %v", h)
+	}
+	if h, _ := transport.HGetAll("MODULE_CONFIG_TABLE|Opt1"); h["prbs"] != "on" {
+		t.Errorf("prbs is not applied: %v", h)
+	}
+	// interfaces which are not changed are not touched
+	if h, _ := config.HGetAll("PORT|Ethernet3"); len(h) != 0 {
+		t.Errorf("unexpected entry: %v", h)
+	}
+
+	head, err := getHeadCommit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied, err := getAppliedCommit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != applied {
+		t.Errorf("applied commit %s is not HEAD %s", applied, head)
+	}
+	// the runtime state doesn't show up in the config repo
+	repo, err := git.PlainOpen(viper.GetString("git_dir"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, err := w.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.IsClean() {
+		t.Errorf("work tree is not clean:\n%s", status)
+	}
+}
+
+func TestCommitInvalidConfig(t *testing.T) {
+	store := setupCommitTest(t)
+	current.Interface["Ethernet1"].Mtu = ygot.Uint16(9000)
+	// an optical module connection without the module is rejected by validateFinal
+	current.Interface["Ethernet1"].OpticalModuleConnection = &model.PacketTransponder_Interface_OpticalModuleConnection{
+		Id: ygot.Uint32(1),
+	}
+	if err := persistentPostRunE(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := commit("test", false); err == nil {
+		t.Fatal("expected validation error")
+	}
+	if h, _ := store.DB(sonic.CONFIG_DB).HGetAll("PORT|Ethernet1"); len(h) != 0 {
+		t.Errorf("invalid config is applied: %v", h)
+	}
+}
+
+func TestValidateSystem(t *testing.T) {
+	s := &model.PacketTransponder_System{
+		Hostname:          ygot.String("oopt-1"),
+		MacAddress:        ygot.String("00:11:22:33:44:55"),
+		ManagementAddress: ygot.String("192.168.0.10/24"),
+		ManagementGateway: ygot.String("192.168.0.1"),
+	}
+	if err := validateSystem(s); err != nil {
+		t.Fatal(err)
+	}
+	// the same leaves are rejected by the schema on gNMI Set
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	for _, invalid := range []*model.PacketTransponder_System{
+		{Hostname: ygot.String("oopt 1")},
+		{MacAddress: ygot.String("00:11:22:33:44")},
+		{ManagementAddress: ygot.String("192.168.0.10")},
+		{ManagementGateway: ygot.String("192.168.0.256")},
+	} {
+		if err := validateSystem(invalid); err == nil {
+			t.Errorf("%+v is accepted", invalid)
+		}
+		if err := invalid.Validate(); err == nil {
+			t.Errorf("%+v is accepted by the schema", invalid)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright (C) 2018 Nippon Telegraph and Telephone Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+const (
+	COMPLETE_CMD_NAME     = "__complete"
+	COMPLETION_ANNOTATION = "oopt_completion"
+)
+
+const (
+	BASH_COMPLETION_TEMPLATE = `# bash completion for %[1]s
+__%[1]s_complete()
+{
+    local IFS=$'\n'
+    COMPREPLY=( $("${COMP_WORDS[0]}" %[2]s "${COMP_WORDS[@]:1:${COMP_CWORD}}" 2>/dev/null) )
+}
+complete -o default -F __%[1]s_complete %[1]s
+`
+	ZSH_COMPLETION_TEMPLATE = `#compdef %[1]s
+__%[1]s_complete()
+{
+    local -a candidates
+    candidates=(${(f)"$("${words[1]}" %[2]s "${(@)words[2,CURRENT]}" 2>/dev/null)"})
+    compadd -a candidates
+}
+compdef __%[1]s_complete %[1]s
+`
+	FISH_COMPLETION_TEMPLATE = `# fish completion for %[1]s
+complete -c %[1]s -f -a '(%[1]s %[2]s (commandline -opc)[2..-1] (commandline -ct) 2>/dev/null)'
+`
+)
+
+// completionFuncs returns the object names which can be used as the
+// argument of the Dynamic command annotated with the map key
+var completionFuncs = map[string]func(m *model.PacketTransponder) []string{
+	"port": func(m *model.PacketTransponder) []string {
+		names := make([]string, 0, len(m.Port))
+		for k := range m.Port {
+			names = append(names, k)
+		}
+		return names
+	},
+	"interface": func(m *model.PacketTransponder) []string {
+		names := make([]string, 0, len(m.Interface))
+		for k := range m.Interface {
+			names = append(names, k)
+		}
+		return names
+	},
+	"optical-module": func(m *model.PacketTransponder) []string {
+		names := make([]string, 0, len(m.OpticalModule))
+		for k := range m.OpticalModule {
+			names = append(names, k)
+		}
+		return names
+	},
+}
+
+func loadConfigForCompletion(gitDir string) *model.PacketTransponder {
+	data, err := ioutil.ReadFile(fmt.Sprintf("%s/%s", gitDir, CONFIG_FILE))
+	if err != nil {
+		return nil
+	}
+	m := &model.PacketTransponder{}
+	if err = model.Unmarshal(data, m); err != nil {
+		return nil
+	}
+	return m
+}
+
+func lookupFlag(cmd *cobra.Command, arg string) *pflag.Flag {
+	name := strings.TrimLeft(arg, "-")
+	if i := strings.Index(name, "="); i >= 0 {
+		name = name[:i]
+	}
+	for _, flags := range []*pflag.FlagSet{cmd.Flags(), cmd.InheritedFlags()} {
+		if strings.HasPrefix(arg, "--") {
+			if f := flags.Lookup(name); f != nil {
+				return f
+			}
+		} else if f := flags.ShorthandLookup(name); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+func findCompletionChild(cmd *cobra.Command, name string) *cobra.Command {
+	var dynamic *cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.Dynamic != nil {
+			dynamic = c
+			continue
+		}
+		if c.Name() == name || c.HasAlias(name) {
+			return c
+		}
+	}
+	return dynamic
+}
+
+// complete returns the candidates for the last element of args,
+// the preceding elements are the words already typed after the root command
+func complete(root *cobra.Command, args []string) []string {
+	if len(args) == 0 {
+		args = []string{""}
+	}
+	words, cur := args[:len(args)-1], args[len(args)-1]
+
+	cmd := root
+	gitDir := viper.GetString("git_dir")
+	positional := 0
+	for i := 0; i < len(words); i++ {
+		w := words[i]
+		if strings.HasPrefix(w, "-") {
+			f := lookupFlag(cmd, w)
+			if f == nil || f.Value.Type() == "bool" {
+				continue
+			}
+			value := ""
+			if j := strings.Index(w, "="); j >= 0 {
+				value = w[j+1:]
+			} else if i+1 < len(words) {
+				i++
+				value = words[i]
+			}
+			if f.Name == "git-dir" {
+				gitDir = value
+			}
+			continue
+		}
+		if c := findCompletionChild(cmd, w); c != nil {
+			cmd = c
+			positional = 0
+			continue
+		}
+		positional++
+	}
+
+	if len(words) > 0 {
+		if prev := words[len(words)-1]; strings.HasPrefix(prev, "-") && !strings.Contains(prev, "=") {
+			if f := lookupFlag(cmd, prev); f != nil && f.Value.Type() != "bool" {
+				return nil
+			}
+		}
+	}
+
+	candidates := []string{}
+	if strings.HasPrefix(cur, "-") {
+		add := func(f *pflag.Flag) {
+			candidates = append(candidates, "--"+f.Name)
+		}
+		cmd.Flags().VisitAll(add)
+		cmd.InheritedFlags().VisitAll(add)
+	} else if positional == 0 {
+		for _, c := range cmd.Commands() {
+			if !c.IsAvailableCommand() {
+				continue
+			}
+			if c.Dynamic != nil {
+				f, ok := completionFuncs[c.Annotations[COMPLETION_ANNOTATION]]
+				if !ok {
+					continue
+				}
+				if m := loadConfigForCompletion(gitDir); m != nil {
+					candidates = append(candidates, f(m)...)
+				}
+				continue
+			}
+			candidates = append(candidates, c.Name())
+		}
+		candidates = append(candidates, cmd.ValidArgs...)
+	}
+
+	ret := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if strings.HasPrefix(c, cur) {
+			ret = append(ret, c)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func NewCompletionCmd() *cobra.Command {
+	completionCmd := &cobra.Command{
+		Use:       "completion [bash|zsh|fish]",
+		ValidArgs: []string{"bash", "zsh", "fish"},
+		Args:      cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := cmd.Root().Name()
+			var t string
+			switch args[0] {
+			case "bash":
+				t = BASH_COMPLETION_TEMPLATE
+			case "zsh":
+				t = ZSH_COMPLETION_TEMPLATE
+			case "fish":
+				t = FISH_COMPLETION_TEMPLATE
+			default:
+				return fmt.Errorf("unsupported shell: %s", args[0])
+			}
+			fmt.Printf(t, name, COMPLETE_CMD_NAME)
+			return nil
+		},
+	}
+	return completionCmd
+}
+
+func NewCompleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:                COMPLETE_CMD_NAME,
+		Hidden:             true,
+		DisableFlagParsing: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, c := range complete(cmd.Root(), args) {
+				fmt.Println(c)
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/viper"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+// writeCompletionConfig writes config.json of the ports, interfaces and optical modules to a temporary directory
+func writeCompletionConfig(t *testing.T, ports, interfaces, modules []string) string {
+	m := &model.PacketTransponder{}
+	for _, name := range ports {
+		if _, err := m.NewPort(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range interfaces {
+		if err := newInterface(m, name, model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, name := range modules {
+		if _, err := m.NewOpticalModule(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := ygot.EmitJSON(m, &ygot.EmitJSONConfig{Format: ygot.RFC7951})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err = ioutil.WriteFile(fmt.Sprintf("%s/%s", dir, CONFIG_FILE), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestComplete(t *testing.T) {
+	dir := writeCompletionConfig(t, []string{"Port1", "Port2"}, []string{"Ethernet1", "Ethernet2_1", "Ethernet2_2"}, []string{"Opt1", "Opt2"})
+	other := writeCompletionConfig(t, nil, nil, []string{"Opt3"})
+	root := NewRootCmd()
+	orig := viper.GetString("git_dir")
+	viper.Set("git_dir", dir)
+	defer viper.Set("git_dir", orig)
+
+	for _, c := range []struct {
+		args     []string
+		expected []string
+	}{
+		// subcommands
+		{[]string{"c"}, []string{"commit", "completion"}},
+		{[]string{"completion", ""}, []string{"bash", "fish", "zsh"}},
+		{[]string{"interface", "Ethernet1", "m"}, []string{"mac-address", "mtu"}},
+		// object names from config.json
+		{[]string{"port", ""}, []string{"Port1", "Port2"}},
+		{[]string{"interface", "Ethernet2"}, []string{"Ethernet2_1", "Ethernet2_2"}},
+		{[]string{"optical-module", ""}, []string{"Opt1", "Opt2"}},
+		{[]string{"--git-dir", other, "optical-module", ""}, []string{"Opt3"}},
+		{[]string{"-c", other, "port", ""}, []string{}},
+		{[]string{"--git-dir=/nonexistent", "optical-module", ""}, []string{}},
+		// enum values
+		{[]string{"port", "Port1", "breakout-mode", "channel-speed", "SPEED_2"}, []string{"SPEED_20GB", "SPEED_2500MB", "SPEED_25GB"}},
+		{[]string{"optical-module", "Opt1", "frequency", "grid", ""}, []string{"FLEXGRID", "GRID_100GHZ", "GRID_25GHZ", "GRID_33GHZ", "GRID_50GHZ"}},
+		{[]string{"optical-module", "Opt1", "modulation-type", ""}, []string{"DP_16QAM", "DP_8QAM", "DP_QPSK"}},
+		// channels of the platform
+		{[]string{"interface", "Ethernet1", "connection", "optical-module", "channel", ""}, []string{"A", "B"}},
+		// flags
+		{[]string{"port", "--git"}, []string{"--git-dir"}},
+		{[]string{"optical-module", "Opt1", "frequency", "set", "--g"}, []string{"--git-dir", "--grid"}},
+		{[]string{"optical-module", "Opt1", "frequency", "set", "-g", ""}, []string{}},
+		{[]string{"--git-dir", ""}, []string{}},
+		{[]string{"--dry", "p"}, []string{"plan", "pm", "port"}},
+	} {
+		got := complete(root, c.args)
+		if len(got) == 0 && len(c.expected) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%q: expected %v, got %v", c.args, c.expected, got)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/viper"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+const (
+	COUNTERS_STATE_FILE = "counters.json"
+)
+
+// counters which are not cumulative and must not be cleared
+var nonCumulativeCounters = map[string]bool{
+	"last-clear": true,
+	"in-bps":     true,
+	"in-pps":     true,
+	"out-bps":    true,
+	"out-pps":    true,
+}
+
+type counterSample struct {
+	Time   int64             `json:"time"`
+	Values map[string]uint64 `json:"values"`
+}
+
+// interfaceCounterState is kept in the state directory
+// to clear counters and calculate rates across oopt invocations
+type interfaceCounterState struct {
+	LastClear uint64            `json:"last-clear,omitempty"`
+	Baseline  map[string]uint64 `json:"baseline,omitempty"`
+	Last      *counterSample    `json:"last,omitempty"`
+}
+
+func counterStatePath() string {
+	return fmt.Sprintf("%s/%s", viper.GetString("state_dir"), COUNTERS_STATE_FILE)
+}
+
+func loadCounterStates() (map[string]*interfaceCounterState, error) {
+	states := map[string]*interfaceCounterState{}
+	data, err := ioutil.ReadFile(counterStatePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return states, nil
+		}
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &states); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", counterStatePath(), err)
+	}
+	return states, nil
+}
+
+func saveCounterStates(states map[string]*interfaceCounterState) error {
+	if err := os.MkdirAll(viper.GetString("state_dir"), 0755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(states)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(counterStatePath(), data, 0644)
+}
+
+// counterFields returns the cumulative counters keyed by their YANG name
+func counterFields(c *model.PacketTransponder_Interface_Counters) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Tag.Get("path")
+		if nonCumulativeCounters[name] {
+			continue
+		}
+		if f := v.Field(i); f.Type() == reflect.TypeOf((*uint64)(nil)) {
+			fields[name] = f
+		}
+	}
+	return fields
+}
+
+func counterValues(c *model.PacketTransponder_Interface_Counters) map[string]uint64 {
+	values := map[string]uint64{}
+	for name, f := range counterFields(c) {
+		if !f.IsNil() {
+			values[name] = f.Elem().Uint()
+		}
+	}
+	return values
+}
+
+func rate(name string, cur, last *counterSample, scale uint64) *uint64 {
+	c, ok1 := cur.Values[name]
+	l, ok2 := last.Values[name]
+	d := cur.Time - last.Time
+	if !ok1 || !ok2 || c < l || d <= 0 {
+		return nil
+	}
+	return ygot.Uint64(uint64(float64(c-l) * float64(scale) / time.Duration(d).Seconds()))
+}
+
+// applyCounterState fills the rates of the counters read from COUNTERS_DB
+// and subtracts the baseline stored by the last `clear counters`
+func applyCounterState(name string, intf *model.PacketTransponder_Interface) error {
+	if intf.Counters == nil {
+		return nil
+	}
+	states, err := loadCounterStates()
+	if err != nil {
+		return err
+	}
+	state, ok := states[name]
+	if !ok {
+		state = &interfaceCounterState{}
+		states[name] = state
+	}
+
+	c := intf.Counters
+	cur := &counterSample{
+		Time:   time.Now().UnixNano(),
+		Values: counterValues(c),
+	}
+	if last := state.Last; last != nil {
+		c.InBps = rate("in-octets", cur, last, 8)
+		c.InPps = rate("in-pkts", cur, last, 1)
+		c.OutBps = rate("out-octets", cur, last, 8)
+		c.OutPps = rate("out-pkts", cur, last, 1)
+	}
+	state.Last = cur
+
+	if state.LastClear > 0 {
+		c.LastClear = ygot.Uint64(state.LastClear)
+		for k, f := range counterFields(c) {
+			base, ok := state.Baseline[k]
+			if !ok || f.IsNil() {
+				continue
+			}
+			// the counter is reset when it goes below the baseline
+			if v := f.Elem().Uint(); v >= base {
+				f.Set(reflect.ValueOf(ygot.Uint64(v - base)))
+			}
+		}
+	}
+	return saveCounterStates(states)
+}
+
+func clearCounters(name string, intf *model.PacketTransponder_Interface) error {
+	if intf.Counters == nil {
+		return fmt.Errorf("no counters found for %s", name)
+	}
+	states, err := loadCounterStates()
+	if err != nil {
+		return err
+	}
+	state, ok := states[name]
+	if !ok {
+		state = &interfaceCounterState{}
+		states[name] = state
+	}
+	state.LastClear = uint64(time.Now().UnixNano())
+	state.Baseline = counterValues(intf.Counters)
+	return saveCounterStates(states)
+}
+
+func formatCounter(v *uint64) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *v)
+}
+
+func counterWatchTable(c *model.PacketTransponder_Interface_Counters) *watchTable {
+	t := &watchTable{
+		header: []string{"counters", "in", "out"},
+	}
+	if c == nil {
+		c = &model.PacketTransponder_Interface_Counters{}
+	}
+	t.addRow("octets", formatCounter(c.InOctets), formatCounter(c.OutOctets))
+	t.addRow("pkts", formatCounter(c.InPkts), formatCounter(c.OutPkts))
+	t.addRow("bps", formatCounter(c.InBps), formatCounter(c.OutBps))
+	t.addRow("pps", formatCounter(c.InPps), formatCounter(c.OutPps))
+	t.addRow("errors", formatCounter(c.InErrors), formatCounter(c.OutErrors))
+	t.addRow("discards", formatCounter(c.InDiscards), formatCounter(c.OutDiscards))
+	t.addRow("fcs-errors", formatCounter(c.InFcsErrors), "-")
+	t.addRow("rs-fec-corrected", formatCounter(c.InRsFecCorrectedCodewords), "-")
+	t.addRow("rs-fec-uncorrected", formatCounter(c.InRsFecUncorrectedCodewords), "-")
+	return t
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/viper"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+func TestRate(t *testing.T) {
+	sample := func(sec int64, octets uint64) *counterSample {
+		return &counterSample{Time: sec * int64(time.Second), Values: map[string]uint64{"in-octets": octets}}
+	}
+	for _, c := range []struct {
+		cur, last *counterSample
+		scale     uint64
+		expected  *uint64
+	}{
+		{sample(2, 2000), sample(1, 1000), 8, ygot.Uint64(8000)},
+		{sample(3, 2000), sample(1, 1000), 1, ygot.Uint64(500)},
+		// the counter wrapped or was reset
+		{sample(2, 10), sample(1, 1000), 8, nil},
+		{sample(1, 2000), sample(1, 1000), 8, nil},
+		{&counterSample{Time: 2 * int64(time.Second)}, sample(1, 1000), 8, nil},
+	} {
+		got := rate("in-octets", c.cur, c.last, c.scale)
+		if (got == nil) != (c.expected == nil) || (got != nil && *got != *c.expected) {
+			t.Errorf("%v -> %v: expected %s, got %s", c.last, c.cur, formatCounter(c.expected), formatCounter(got))
+		}
+	}
+}
+
+func TestCounterState(t *testing.T) {
+	orig := viper.GetString("state_dir")
+	viper.Set("state_dir", t.TempDir())
+	defer viper.Set("state_dir", orig)
+	store := sonic.NewMemoryStore()
+	defer sonic.UseMemoryStore(store)()
+	db := store.DB(sonic.COUNTERS_DB)
+	db.HMSet("COUNTERS_PORT_NAME_MAP", map[string]interface{}{"Ethernet1": "oid:0x1"})
+	setCounters := func(octets, pkts, fec string) {
+		db.HMSet("COUNTERS:oid:0x1", map[string]interface{}{
+			"SAI_PORT_STAT_IF_IN_OCTETS":                     octets,
+			"SAI_PORT_STAT_IF_IN_UCAST_PKTS":                 pkts,
+			"SAI_PORT_STAT_IF_IN_FEC_CORRECTABLE_FRAMES":     fec,
+			"SAI_PORT_STAT_IF_IN_FEC_NOT_CORRECTABLE_FRAMES": "0",
+		})
+	}
+	sample := func() *model.PacketTransponder_Interface_Counters {
+		i := &model.PacketTransponder_Interface{}
+		if err := sonic.FillInterfaceCounters("Ethernet1", i); err != nil {
+			t.Fatal(err)
+		}
+		if err := applyCounterState("Ethernet1", i); err != nil {
+			t.Fatal(err)
+		}
+		return i.Counters
+	}
+	// moves the last sample back so that the rates are calculated over a second
+	rewind := func() {
+		states, err := loadCounterStates()
+		if err != nil {
+			t.Fatal(err)
+		}
+		states["Ethernet1"].Last.Time -= int64(time.Second)
+		if err = saveCounterStates(states); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the rates are a bit lower than the ones over a second since time passes while sampling
+	checkRate := func(name string, v *uint64, expected uint64) {
+		if v == nil || *v > expected || *v < expected*9/10 {
+			t.Errorf("unexpected %s: %s, expected about %d", name, formatCounter(v), expected)
+		}
+	}
+
+	// no rates without the previous sample
+	setCounters("1000", "10", "100")
+	c := sample()
+	if c.InBps != nil || c.InPps != nil || c.LastClear != nil {
+		t.Errorf("unexpected first sample: %+v", c)
+	}
+
+	rewind()
+	setCounters("3000", "30", "150")
+	c = sample()
+	checkRate("in-bps", c.InBps, 16000)
+	checkRate("in-pps", c.InPps, 20)
+	if *c.InOctets != 3000 {
+		t.Errorf("unexpected in-octets: %d", *c.InOctets)
+	}
+
+	// clear counters stores the raw counters as the baseline
+	i := &model.PacketTransponder_Interface{}
+	if err := sonic.FillInterfaceCounters("Ethernet1", i); err != nil {
+		t.Fatal(err)
+	}
+	before := uint64(time.Now().UnixNano())
+	if err := clearCounters("Ethernet1", i); err != nil {
+		t.Fatal(err)
+	}
+	rewind()
+	setCounters("5000", "50", "175")
+	c = sample()
+	if c.LastClear == nil || *c.LastClear < before || *c.LastClear > uint64(time.Now().UnixNano()) {
+		t.Errorf("unexpected last-clear: %s", formatCounter(c.LastClear))
+	}
+	if *c.InOctets != 2000 || *c.InPkts != 20 || *c.InRsFecCorrectedCodewords != 25 || *c.InRsFecUncorrectedCodewords != 0 {
+		t.Errorf("baseline is not subtracted: %+v", c)
+	}
+	// the rates come from the raw counters
+	checkRate("in-bps", c.InBps, 16000)
+
+	// the counters are reset below the baseline
+	rewind()
+	setCounters("400", "4", "10")
+	c = sample()
+	if c.InBps != nil || c.InPps != nil {
+		t.Errorf("unexpected rates after reset: %s, %s", formatCounter(c.InBps), formatCounter(c.InPps))
+	}
+	if *c.InOctets != 400 || *c.InPkts != 4 {
+		t.Errorf("reset counters are not shown as they are: %+v", c)
+	}
+	rewind()
+	setCounters("1400", "14", "10")
+	c = sample()
+	checkRate("in-bps", c.InBps, 8000)
+
+	// clearing an interface without counters stores nothing
+	if err := clearCounters("Ethernet2", &model.PacketTransponder_Interface{}); err == nil {
+		t.Error("expected error for an interface without counters")
+	}
+	states, err := loadCounterStates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := states["Ethernet2"]; ok || len(states) != 1 {
+		t.Errorf("unexpected states: %v", states)
+	}
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	DEPLOY_BACKEND_KUBERNETES = "kubernetes"
+	DEPLOY_BACKEND_PROCESS    = "process"
+)
+
+// Volume is a host directory mounted to all containers of a component
+type Volume struct {
+	Name      string `yaml:"name"`
+	HostPath  string `yaml:"host-path"`
+	MountPath string `yaml:"mount-path"`
+}
+
+type Container struct {
+	Name string `yaml:"name"`
+	// the image of the component is used when empty
+	Image   string   `yaml:"image"`
+	Command []string `yaml:"command"`
+	// command to check the container is ready
+	Probe []string `yaml:"probe"`
+}
+
+// Component is a set of daemons started and stopped together.
+// it is a pod with the kubernetes backend
+type Component struct {
+	Name        string
+	Image       string
+	Privileged  bool
+	HostNetwork bool
+	Volumes     []Volume
+	// directory where the files passed by SetConfig are placed
+	ConfigPath string
+	// init containers run to completion in order before the containers start
+	InitContainers []Container
+	Containers     []Container
+}
+
+func (c *Component) image(container Container) string {
+	if container.Image != "" {
+		return container.Image
+	}
+	return c.Image
+}
+
+type ComponentStatus struct {
+	// Running, Pending, Failed or NotFound
+	State    string
+	Ready    bool
+	Restarts int
+	// reason why the component isn't ready
+	Reason string
+}
+
+// Backend deploys the components
+type Backend interface {
+	// SetConfig stores the config files of the component.
+	// they are used from the next Start
+	SetConfig(c *Component, files map[string]string) error
+	// Start starts the component and waits until it becomes ready
+	Start(c *Component) error
+	// Stop stops the component and waits until it is gone.
+	// it is not an error when the component isn't running
+	Stop(c *Component) error
+	Status(c *Component) (*ComponentStatus, error)
+	// Exec runs the command in the running component
+	Exec(c *Component, command ...string) ([]byte, error)
+}
+
+type Deployment struct {
+	Backend    Backend
+	Components map[string]*Component
+}
+
+// the deployment config file overrides the default components.
+//
+//	backend: process
+//	components:
+//	  sonic:
+//	    image: sonic:201811
+//	    volumes:
+//	    - name: tmp
+//	      host-path: /var/tmp
+//	    containers:
+//	    - name: orchagent
+//	      command: ['orchagent']
+//
+// containers and volumes are merged by name and new ones are appended
+type deploymentConfig struct {
+	Backend    string                      `yaml:"backend"`
+	Components map[string]*componentConfig `yaml:"components"`
+}
+
+type componentConfig struct {
+	Image          string      `yaml:"image"`
+	Privileged     *bool       `yaml:"privileged"`
+	HostNetwork    *bool       `yaml:"host-network"`
+	Volumes        []Volume    `yaml:"volumes"`
+	ConfigPath     string      `yaml:"config-path"`
+	InitContainers []Container `yaml:"init-containers"`
+	Containers     []Container `yaml:"containers"`
+}
+
+func defaultComponents() map[string]*Component {
+	components := map[string]*Component{}
+	for _, c := range []*Component{redisComponent(), transyncdComponent(), ofdpaComponent(), sonicComponent()} {
+		components[c.Name] = c
+	}
+	return components
+}
+
+func mergeVolumes(vs []Volume, overrides []Volume) []Volume {
+	for _, o := range overrides {
+		found := false
+		for i, v := range vs {
+			if v.Name != o.Name {
+				continue
+			}
+			if o.HostPath != "" {
+				vs[i].HostPath = o.HostPath
+			}
+			if o.MountPath != "" {
+				vs[i].MountPath = o.MountPath
+			}
+			found = true
+		}
+		if !found {
+			if o.MountPath == "" {
+				o.MountPath = o.HostPath
+			}
+			vs = append(vs, o)
+		}
+	}
+	return vs
+}
+
+func mergeContainers(cs []Container, overrides []Container) []Container {
+	for _, o := range overrides {
+		found := false
+		for i, c := range cs {
+			if c.Name != o.Name {
+				continue
+			}
+			if o.Image != "" {
+				cs[i].Image = o.Image
+			}
+			if len(o.Command) > 0 {
+				cs[i].Command = o.Command
+			}
+			if len(o.Probe) > 0 {
+				cs[i].Probe = o.Probe
+			}
+			found = true
+		}
+		if !found {
+			cs = append(cs, o)
+		}
+	}
+	return cs
+}
+
+func (o *componentConfig) apply(c *Component) {
+	if o.Image != "" {
+		c.Image = o.Image
+	}
+	if o.Privileged != nil {
+		c.Privileged = *o.Privileged
+	}
+	if o.HostNetwork != nil {
+		c.HostNetwork = *o.HostNetwork
+	}
+	if o.ConfigPath != "" {
+		c.ConfigPath = o.ConfigPath
+	}
+	c.Volumes = mergeVolumes(c.Volumes, o.Volumes)
+	c.InitContainers = mergeContainers(c.InitContainers, o.InitContainers)
+	c.Containers = mergeContainers(c.Containers, o.Containers)
+}
+
+func newBackend(name string) (Backend, error) {
+	switch name {
+	case "", DEPLOY_BACKEND_KUBERNETES:
+		return &kubeBackend{}, nil
+	case DEPLOY_BACKEND_PROCESS:
+		return &processBackend{dir: fmt.Sprintf("%s/%s", viper.GetString("state_dir"), PROCESS_STATE_DIR)}, nil
+	}
+	return nil, fmt.Errorf("unknown deploy backend %s, supported backends: %s, %s", name, DEPLOY_BACKEND_KUBERNETES, DEPLOY_BACKEND_PROCESS)
+}
+
+func parseDeployment(data []byte) (*Deployment, error) {
+	var config deploymentConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid deploy config: %v", err)
+	}
+	backend, err := newBackend(config.Backend)
+	if err != nil {
+		return nil, err
+	}
+	components := defaultComponents()
+	for name, o := range config.Components {
+		c, ok := components[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown component %s, components: %s", name, strings.Join(componentNames(components), ", "))
+		}
+		if o != nil {
+			o.apply(c)
+		}
+	}
+	return &Deployment{Backend: backend, Components: components}, nil
+}
+
+func componentNames(components map[string]*Component) []string {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var deployment *Deployment
+
+// getDeployment loads the deploy config file given by --deploy-config.
+// the default components on kubernetes are used without the file
+func getDeployment() (*Deployment, error) {
+	if deployment != nil {
+		return deployment, nil
+	}
+	var data []byte
+	if name := viper.GetString("deploy_config"); name != "" {
+		var err error
+		data, err = ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	d, err := parseDeployment(data)
+	if err != nil {
+		return nil, err
+	}
+	deployment = d
+	return deployment, nil
+}
+
+func (d *Deployment) component(name string) (*Component, error) {
+	c, ok := d.Components[name]
+	if !ok {
+		return nil, fmt.Errorf("component %s not found", name)
+	}
+	return c, nil
+}
+
+// restartComponent stops the component and starts it with the config files.
+// the config files are kept as is when files is nil
+func restartComponent(name string, files map[string]string) error {
+	d, err := getDeployment()
+	if err != nil {
+		return err
+	}
+	c, err := d.component(name)
+	if err != nil {
+		return err
+	}
+	if files != nil {
+		if err = d.Backend.SetConfig(c, files); err != nil {
+			return err
+		}
+	}
+	if err = d.Backend.Stop(c); err != nil {
+		return err
+	}
+	return d.Backend.Start(c)
+}
+
+func stopComponent(name string) error {
+	d, err := getDeployment()
+	if err != nil {
+		return err
+	}
+	c, err := d.component(name)
+	if err != nil {
+		return err
+	}
+	return d.Backend.Stop(c)
+}
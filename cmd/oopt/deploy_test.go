@@ -0,0 +1,91 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDeployment(t *testing.T) {
+	d, err := parseDeployment([]byte(`
+backend: process
+components:
+  sonic:
+    image: sonic:201811
+    volumes:
+    - name: tmp
+      host-path: /var/tmp
+    - name: log
+      host-path: /var/log
+    containers:
+    - name: orchagent
+      command: ['orchagent']
+    - name: teamsyncd
+      command: ['teamsyncd']
+  ofdpa:
+    privileged: false
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := d.Backend.(*processBackend); !ok {
+		t.Errorf("unexpected backend: %T", d.Backend)
+	}
+	c, err := d.component(SONIC_POD_NAME)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Image != "sonic:201811" {
+		t.Errorf("image is not overridden: %s", c.Image)
+	}
+	// init containers with their own image keep it
+	if c.image(c.InitContainers[0]) != "redis" || c.image(c.InitContainers[1]) != "sonic:201811" {
+		t.Errorf("unexpected init container images: %v", c.InitContainers)
+	}
+	expected := []Volume{
+		redisVolume,
+		{Name: "tmp", HostPath: "/var/tmp", MountPath: "/tmp"},
+		{Name: "log", HostPath: "/var/log", MountPath: "/var/log"},
+	}
+	if !reflect.DeepEqual(c.Volumes, expected) {
+		t.Errorf("unexpected volumes: %v", c.Volumes)
+	}
+	names := []string{}
+	for _, v := range c.Containers {
+		names = append(names, v.Name)
+		if v.Name == "orchagent" && !reflect.DeepEqual(v.Command, []string{"orchagent"}) {
+			t.Errorf("command is not overridden: %v", v.Command)
+		}
+	}
+	if names[len(names)-1] != "teamsyncd" || len(names) != 8 {
+		t.Errorf("unexpected containers: %v", names)
+	}
+	ofdpa, _ := d.component(OFDPA_POD_NAME)
+	if ofdpa.Privileged || !ofdpa.HostNetwork {
+		t.Errorf("unexpected ofdpa component: %+v", ofdpa)
+	}
+}
+
+func TestParseDeploymentDefault(t *testing.T) {
+	d, err := parseDeployment(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := d.Backend.(*kubeBackend); !ok {
+		t.Errorf("unexpected backend: %T", d.Backend)
+	}
+	if !reflect.DeepEqual(d.Components, defaultComponents()) {
+		t.Errorf("default components are modified")
+	}
+}
+
+func TestParseDeploymentError(t *testing.T) {
+	for _, config := range []string{
+		"backend: docker",
+		"components:\n  frr:\n    image: frr",
+		"components:\n  sonic:\n    images: sonic",
+	} {
+		if _, err := parseDeployment([]byte(config)); err == nil {
+			t.Errorf("expected error for %q", config)
+		}
+	}
+}
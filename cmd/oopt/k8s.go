@@ -1,81 +1,392 @@
 package main
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
+	"time"
 
-	"github.com/spf13/viper"
-	"text/template"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
 const (
-	SONIC_K8S_CONFIG_MAP_NAME = "sonic-configmap.yml"
-	CONFIG_MAP_TEMPLATE       = `apiVersion: v1
-kind: ConfigMap
-data:
-{{- range $key, $value := .Config }}
-  {{ $key }}: |
-{{- $lines := split $value }}
-{{- range $lines }}
-    {{. -}}
-{{ end -}}
-{{ end }}
-
-metadata:
-  name: {{ .Name }}
-`
+	POD_READY_TIMEOUT  = 3 * time.Minute
+	POD_DELETE_TIMEOUT = time.Minute
 )
 
-func createConfigMap(name string, config map[string]string) error {
-	filename := fmt.Sprintf("%s/%s", viper.GetString("git_dir"), fmt.Sprintf("%s.yml", name))
-	f, err := os.Create(filename)
-	defer f.Close()
+var podPollInterval = time.Second
+
+var errPodNotFound = errors.New("pod not found")
+
+type Pod struct {
+	Name     string
+	Phase    string
+	Ready    bool
+	Restarts int
+	// reason why a container isn't running. e.g. CrashLoopBackOff, ErrImagePull
+	Reason string
+}
+
+type Event struct {
+	Type    string
+	Reason  string
+	Message string
+}
+
+// kubeClient is the part of the Kubernetes API used by oopt
+type kubeClient interface {
+	// ApplyConfigMap creates the config map or replaces it when it already exists
+	ApplyConfigMap(cm *corev1.ConfigMap) error
+	CreatePod(pod *corev1.Pod) error
+	// DeletePod deletes the pod immediately. it is not an error when the pod doesn't exist
+	DeletePod(name string) error
+	// GetPod returns errPodNotFound when the pod doesn't exist
+	GetPod(name string) (*Pod, error)
+	PodEvents(name string) ([]Event, error)
+	Exec(pod string, command ...string) ([]byte, error)
+}
+
+var kube kubeClient = &clientsetClient{}
+
+// clientsetClient talks to the API server through the typed client of client-go.
+// the API server and the namespace are taken from the kubeconfig like kubectl,
+// or from the service account when oopt runs in a pod
+type clientsetClient struct {
+	clientset kubernetes.Interface
+	config    *rest.Config
+	namespace string
+}
+
+// connect loads the kubeconfig on the first use
+func (c *clientsetClient) connect() error {
+	if c.clientset != nil {
+		return nil
+	}
+	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(clientcmd.NewDefaultClientConfigLoadingRules(), &clientcmd.ConfigOverrides{})
+	config, err := loader.ClientConfig()
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to load kubeconfig: %v", err)
 	}
-	funcMap := template.FuncMap{
-		"split": func(line string) []string {
-			return strings.Split(line, "\n")
-		},
-	}
-	t := template.New("config-map.tmpl").Funcs(funcMap)
-	t = template.Must(t.Parse(CONFIG_MAP_TEMPLATE))
-	m := struct {
-		Name   string
-		Config map[string]string
-	}{}
-	m.Name = name
-	m.Config = config
-	t.Execute(f, m)
-	var cmd *exec.Cmd
-	if isConfigMapExists(name) {
-		cmd = exec.Command("kubectl", "replace", "-f", filename)
-	} else {
-		cmd = exec.Command("kubectl", "create", "-f", filename)
-	}
-	output, err := cmd.Output()
+	namespace, _, err := loader.Namespace()
 	if err != nil {
-		fmt.Println("failed to create config-map:", output)
+		return fmt.Errorf("failed to get namespace: %v", err)
 	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	c.clientset, c.config, c.namespace = clientset, config, namespace
+	return nil
+}
+
+func (c *clientsetClient) ApplyConfigMap(cm *corev1.ConfigMap) error {
+	if err := c.connect(); err != nil {
+		return err
+	}
+	configMaps := c.clientset.CoreV1().ConfigMaps(c.namespace)
+	current, err := configMaps.Get(cm.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(cm)
+		return err
+	} else if err != nil {
+		return err
+	}
+	current.Data = cm.Data
+	_, err = configMaps.Update(current)
 	return err
 }
 
-func isConfigMapExists(name string) bool {
-	cmd := exec.Command("kubectl", "get", "cm", name)
-	return cmd.Run() == nil
+func (c *clientsetClient) CreatePod(pod *corev1.Pod) error {
+	if err := c.connect(); err != nil {
+		return err
+	}
+	_, err := c.clientset.CoreV1().Pods(c.namespace).Create(pod)
+	return err
+}
+
+func (c *clientsetClient) DeletePod(name string) error {
+	if err := c.connect(); err != nil {
+		return err
+	}
+	var grace int64
+	err := c.clientset.CoreV1().Pods(c.namespace).Delete(name, &metav1.DeleteOptions{GracePeriodSeconds: &grace})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *clientsetClient) GetPod(name string) (*Pod, error) {
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	p, err := c.clientset.CoreV1().Pods(c.namespace).Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, errPodNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	pod := &Pod{
+		Name:  p.Name,
+		Phase: string(p.Status.Phase),
+	}
+	for _, c := range p.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			pod.Ready = c.Status == corev1.ConditionTrue
+		}
+	}
+	reason := func(s corev1.ContainerState) {
+		if pod.Reason != "" {
+			return
+		}
+		if s.Waiting != nil && s.Waiting.Reason != "PodInitializing" && s.Waiting.Reason != "ContainerCreating" {
+			pod.Reason = s.Waiting.Reason
+		} else if s.Terminated != nil && s.Terminated.Reason != "Completed" {
+			pod.Reason = s.Terminated.Reason
+		}
+	}
+	for _, s := range p.Status.InitContainerStatuses {
+		reason(s.State)
+	}
+	for _, s := range p.Status.ContainerStatuses {
+		pod.Restarts += int(s.RestartCount)
+		reason(s.State)
+	}
+	return pod, nil
 }
 
-func isPodRunning(name string) bool {
-	cmd := exec.Command("kubectl", "get", "pod", name)
-	return cmd.Run() == nil
+func (c *clientsetClient) PodEvents(name string) ([]Event, error) {
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	list, err := c.clientset.CoreV1().Events(c.namespace).List(metav1.ListOptions{
+		FieldSelector: fields.Set{"involvedObject.kind": "Pod", "involvedObject.name": name}.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events of %s: %v", name, err)
+	}
+	events := make([]Event, 0, len(list.Items))
+	for _, e := range list.Items {
+		// the field selector isn't supported by every server
+		if e.InvolvedObject.Kind != "Pod" || e.InvolvedObject.Name != name {
+			continue
+		}
+		events = append(events, Event{Type: e.Type, Reason: e.Reason, Message: e.Message})
+	}
+	return events, nil
+}
+
+// Exec runs the command in the first container of the pod like kubectl exec
+func (c *clientsetClient) Exec(pod string, command ...string) ([]byte, error) {
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	p, err := c.clientset.CoreV1().Pods(c.namespace).Get(pod, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(p.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("pod %s doesn't have containers", pod)
+	}
+	req := c.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(c.namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: p.Spec.Containers[0].Name,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(c.config, "POST", req.URL())
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	if err = executor.Stream(remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr}); err != nil {
+		return stdout.Bytes(), fmt.Errorf("exec %s in %s: %v: %s", strings.Join(command, " "), pod, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// podError adds the warning events of the pod to err
+func podError(c kubeClient, name string, err error) error {
+	events, e := c.PodEvents(name)
+	if e != nil || len(events) == 0 {
+		return err
+	}
+	msgs := []string{}
+	for _, ev := range events {
+		if ev.Type == "Normal" {
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: %s", ev.Reason, ev.Message))
+	}
+	if len(msgs) == 0 {
+		return err
+	}
+	return fmt.Errorf("%v\nevents of %s:\n  %s", err, name, strings.Join(msgs, "\n  "))
+}
+
+func waitPodReady(c kubeClient, name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		pod, err := c.GetPod(name)
+		if err != nil && err != errPodNotFound {
+			return err
+		}
+		if pod != nil {
+			if pod.Ready {
+				return nil
+			}
+			if pod.Phase == "Failed" || pod.Phase == "Succeeded" {
+				return podError(c, name, fmt.Errorf("pod %s terminated: %s %s", name, pod.Phase, pod.Reason))
+			}
+		}
+		if time.Now().After(deadline) {
+			state := "not found"
+			if pod != nil {
+				state = strings.TrimSpace(fmt.Sprintf("%s %s", pod.Phase, pod.Reason))
+			}
+			return podError(c, name, fmt.Errorf("timed out waiting for pod %s to be ready: %s", name, state))
+		}
+		time.Sleep(podPollInterval)
+	}
+}
+
+func waitPodDeleted(c kubeClient, name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		_, err := c.GetPod(name)
+		if err == errPodNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for pod %s to be deleted", name)
+		}
+		time.Sleep(podPollInterval)
+	}
 }
 
 func deletePod(name string) error {
-	if isPodRunning(name) {
-		cmd := exec.Command("kubectl", "delete", "pod", name, "--grace-period=0", "--force")
-		return cmd.Run()
+	if err := kube.DeletePod(name); err != nil {
+		return err
 	}
-	return nil
+	return waitPodDeleted(kube, name, POD_DELETE_TIMEOUT)
+}
+
+// kubeBackend runs each component as a pod.
+// the config files are passed as the config map <component>-config
+type kubeBackend struct{}
+
+func kubeConfigMapName(c *Component) string {
+	return fmt.Sprintf("%s-config", c.Name)
+}
+
+// configMapManifest returns the config map passing the config files to the component
+func configMapManifest(c *Component, files map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: kubeConfigMapName(c)},
+		Data:       files,
+	}
+}
+
+func podManifest(c *Component) *corev1.Pod {
+	p := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{Name: c.Name},
+		Spec:       corev1.PodSpec{HostNetwork: c.HostNetwork},
+	}
+	mounts := make([]corev1.VolumeMount, 0, len(c.Volumes)+1)
+	for _, v := range c.Volumes {
+		p.Spec.Volumes = append(p.Spec.Volumes, corev1.Volume{
+			Name:         v.Name,
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: v.HostPath}},
+		})
+		mounts = append(mounts, corev1.VolumeMount{Name: v.Name, MountPath: v.MountPath})
+	}
+	if c.ConfigPath != "" {
+		name := kubeConfigMapName(c)
+		p.Spec.Volumes = append(p.Spec.Volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: name},
+			}},
+		})
+		mounts = append(mounts, corev1.VolumeMount{Name: name, MountPath: c.ConfigPath})
+	}
+	container := func(v Container, privileged bool) corev1.Container {
+		k := corev1.Container{
+			Name:            v.Name,
+			Image:           c.image(v),
+			ImagePullPolicy: corev1.PullNever,
+			Command:         v.Command,
+			VolumeMounts:    mounts,
+		}
+		if privileged {
+			k.SecurityContext = &corev1.SecurityContext{Privileged: &privileged}
+		}
+		if len(v.Probe) > 0 {
+			k.ReadinessProbe = &corev1.Probe{
+				Handler:       corev1.Handler{Exec: &corev1.ExecAction{Command: v.Probe}},
+				PeriodSeconds: 1,
+			}
+		}
+		return k
+	}
+	for _, v := range c.InitContainers {
+		p.Spec.InitContainers = append(p.Spec.InitContainers, container(v, false))
+	}
+	for _, v := range c.Containers {
+		p.Spec.Containers = append(p.Spec.Containers, container(v, c.Privileged))
+	}
+	return p
+}
+
+func (b *kubeBackend) SetConfig(c *Component, files map[string]string) error {
+	return kube.ApplyConfigMap(configMapManifest(c, files))
+}
+
+func (b *kubeBackend) Start(c *Component) error {
+	if err := kube.CreatePod(podManifest(c)); err != nil {
+		return err
+	}
+	return waitPodReady(kube, c.Name, POD_READY_TIMEOUT)
+}
+
+func (b *kubeBackend) Stop(c *Component) error {
+	return deletePod(c.Name)
+}
+
+func (b *kubeBackend) Status(c *Component) (*ComponentStatus, error) {
+	pod, err := kube.GetPod(c.Name)
+	if err == errPodNotFound {
+		return &ComponentStatus{State: "NotFound"}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &ComponentStatus{
+		State:    pod.Phase,
+		Ready:    pod.Ready,
+		Restarts: pod.Restarts,
+		Reason:   pod.Reason,
+	}, nil
+}
+
+func (b *kubeBackend) Exec(c *Component, command ...string) ([]byte, error) {
+	return kube.Exec(c.Name, command...)
 }
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/yaml"
+)
+
+// fakeKubeClient returns the pods in states one by one on each GetPod call.
+// nil in states means the pod doesn't exist
+type fakeKubeClient struct {
+	states  []*Pod
+	events  []Event
+	calls   int
+	deleted []string
+	created []*corev1.Pod
+	execs   [][]string
+	configs map[string]map[string]string
+}
+
+func (c *fakeKubeClient) ApplyConfigMap(cm *corev1.ConfigMap) error {
+	if c.configs == nil {
+		c.configs = map[string]map[string]string{}
+	}
+	c.configs[cm.Name] = cm.Data
+	return nil
+}
+
+func (c *fakeKubeClient) CreatePod(pod *corev1.Pod) error {
+	c.created = append(c.created, pod)
+	return nil
+}
+
+func (c *fakeKubeClient) DeletePod(name string) error {
+	c.deleted = append(c.deleted, name)
+	return nil
+}
+
+func (c *fakeKubeClient) GetPod(name string) (*Pod, error) {
+	i := c.calls
+	if i >= len(c.states) {
+		i = len(c.states) - 1
+	}
+	c.calls++
+	if c.states[i] == nil {
+		return nil, errPodNotFound
+	}
+	return c.states[i], nil
+}
+
+func (c *fakeKubeClient) PodEvents(name string) ([]Event, error) {
+	return c.events, nil
+}
+
+func (c *fakeKubeClient) Exec(pod string, command ...string) ([]byte, error) {
+	c.execs = append(c.execs, append([]string{pod}, command...))
+	return nil, nil
+}
+
+func withFakeKube(t *testing.T, c *fakeKubeClient) {
+	orig, interval := kube, podPollInterval
+	kube, podPollInterval, deployment = c, time.Millisecond, nil
+	t.Cleanup(func() {
+		kube, podPollInterval, deployment = orig, interval, nil
+	})
+}
+
+func TestKubeBackendStartWaitsReady(t *testing.T) {
+	c := &fakeKubeClient{
+		states: []*Pod{
+			nil,
+			{Name: "redis", Phase: "Pending"},
+			{Name: "redis", Phase: "Running"},
+			{Name: "redis", Phase: "Running", Ready: true},
+		},
+	}
+	withFakeKube(t, c)
+	b := &kubeBackend{}
+	if err := b.Start(redisComponent()); err != nil {
+		t.Fatal(err)
+	}
+	if c.calls != 4 {
+		t.Errorf("expected 4 polls, got %d", c.calls)
+	}
+	if len(c.created) != 1 {
+		t.Fatalf("unexpected created pods: %v", c.created)
+	}
+	probe := c.created[0].Spec.Containers[0].ReadinessProbe
+	if probe == nil || probe.Exec == nil || !reflect.DeepEqual(probe.Exec.Command, []string{"redis-cli", "ping"}) {
+		t.Errorf("unexpected readiness probe: %v", probe)
+	}
+}
+
+func TestWaitPodReadyTimeout(t *testing.T) {
+	c := &fakeKubeClient{
+		states: []*Pod{
+			{Name: "ofdpa", Phase: "Pending", Reason: "ErrImagePull"},
+		},
+		events: []Event{
+			{Type: "Normal", Reason: "Scheduled", Message: "Successfully assigned ofdpa"},
+			{Type: "Warning", Reason: "Failed", Message: "Failed to pull image \"debian:jessie\""},
+		},
+	}
+	withFakeKube(t, c)
+	err := waitPodReady(kube, "ofdpa", 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected timeout")
+	}
+	msg := err.Error()
+	for _, s := range []string{"timed out", "ErrImagePull", "Failed to pull image"} {
+		if !strings.Contains(msg, s) {
+			t.Errorf("%q is not in the error: %s", s, msg)
+		}
+	}
+	if strings.Contains(msg, "Scheduled") {
+		t.Errorf("normal events shouldn't be in the error: %s", msg)
+	}
+}
+
+func TestWaitPodReadyFailed(t *testing.T) {
+	c := &fakeKubeClient{
+		states: []*Pod{
+			{Name: "transyncd", Phase: "Running"},
+			{Name: "transyncd", Phase: "Failed", Reason: "Error"},
+		},
+		events: []Event{
+			{Type: "Warning", Reason: "BackOff", Message: "Back-off restarting failed container"},
+		},
+	}
+	withFakeKube(t, c)
+	err := waitPodReady(kube, "transyncd", time.Minute)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "terminated") || !strings.Contains(err.Error(), "Back-off") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if c.calls != 2 {
+		t.Errorf("expected to give up after 2 polls, got %d", c.calls)
+	}
+}
+
+func TestKubeBackendStopWaitsDeleted(t *testing.T) {
+	c := &fakeKubeClient{
+		states: []*Pod{
+			{Name: "sonic", Phase: "Running", Ready: true},
+			{Name: "sonic", Phase: "Running"},
+			nil,
+		},
+	}
+	withFakeKube(t, c)
+	b := &kubeBackend{}
+	if err := b.Stop(sonicComponent()); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.deleted) != 1 || c.deleted[0] != "sonic" {
+		t.Errorf("unexpected deleted pods: %v", c.deleted)
+	}
+	if c.calls != 3 {
+		t.Errorf("expected 3 polls, got %d", c.calls)
+	}
+}
+
+func TestApplyOFDPAPortMode(t *testing.T) {
+	c := &fakeKubeClient{}
+	withFakeKube(t, c)
+	port := &OFDPAPort{NumChannels: 2, ChannelSpeed: 50, OFDPAIndex: 68, Lanes: 4}
+	if err := ApplyOFDPAPortMode("config", port); err != nil {
+		t.Fatal(err)
+	}
+	if c.configs["ofdpa-config"]["ofdpa.conf"] != "config" {
+		t.Errorf("config map is not updated: %v", c.configs)
+	}
+	if len(c.execs) != port.Lanes {
+		t.Fatalf("expected %d commands, got %v", port.Lanes, c.execs)
+	}
+	if cmd := strings.Join(c.execs[0], " "); cmd != "ofdpa client_drivshell port 68 lanes=2 speed=50000 enable=true" {
+		t.Errorf("unexpected command: %s", cmd)
+	}
+}
+
+func TestPodManifest(t *testing.T) {
+	p := podManifest(ofdpaComponent())
+	if p.Kind != "Pod" || p.Name != "ofdpa" || !p.Spec.HostNetwork || len(p.Spec.Containers) != 1 {
+		t.Fatalf("unexpected pod: %v", p)
+	}
+	last := p.Spec.Volumes[len(p.Spec.Volumes)-1]
+	if last.Name != "ofdpa-config" || last.ConfigMap == nil || last.ConfigMap.Name != "ofdpa-config" {
+		t.Errorf("config map volume not found: %v", p.Spec.Volumes)
+	}
+	container := p.Spec.Containers[0]
+	if container.Image != "debian:jessie" || container.SecurityContext == nil || !*container.SecurityContext.Privileged {
+		t.Errorf("unexpected container: %v", container)
+	}
+	mount := container.VolumeMounts[len(container.VolumeMounts)-1]
+	if mount.Name != "ofdpa-config" || mount.MountPath != "/etc/accton" {
+		t.Errorf("unexpected config mount: %v", mount)
+	}
+}
+
+func TestManifestYAML(t *testing.T) {
+	c := ofdpaComponent()
+	for _, obj := range []interface{}{podManifest(c), configMapManifest(c, map[string]string{"ofdpa.conf": "a"})} {
+		y, err := manifestYAML(obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(y, "apiVersion: v1\n") {
+			t.Errorf("apiVersion is not in the manifest:\n%s", y)
+		}
+		// kubectl reads back the same object
+		parsed := reflect.New(reflect.TypeOf(obj).Elem()).Interface()
+		if err = yaml.UnmarshalStrict([]byte(y), parsed); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(parsed, obj) {
+			t.Errorf("expected %v, got %v", obj, parsed)
+		}
+	}
+}
+
+func TestClientsetClientConfigMap(t *testing.T) {
+	c := &clientsetClient{clientset: fake.NewSimpleClientset(), namespace: "oopt"}
+	for _, data := range []map[string]string{{"ofdpa.conf": "a"}, {"ofdpa.conf": "b"}} {
+		if err := c.ApplyConfigMap(configMapManifest(ofdpaComponent(), data)); err != nil {
+			t.Fatal(err)
+		}
+		cm, err := c.clientset.CoreV1().ConfigMaps("oopt").Get("ofdpa-config", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cm.Data["ofdpa.conf"] != data["ofdpa.conf"] {
+			t.Errorf("unexpected config map: %v", cm.Data)
+		}
+	}
+}
+
+func TestClientsetClientPod(t *testing.T) {
+	c := &clientsetClient{clientset: fake.NewSimpleClientset(), namespace: "oopt"}
+	if _, err := c.GetPod("ofdpa"); err != errPodNotFound {
+		t.Fatalf("expected errPodNotFound, got %v", err)
+	}
+	if err := c.CreatePod(podManifest(ofdpaComponent())); err != nil {
+		t.Fatal(err)
+	}
+	pods := c.clientset.CoreV1().Pods("oopt")
+	p, err := pods.Get("ofdpa", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Spec.HostNetwork || len(p.Spec.Containers) != 1 || p.Spec.Containers[0].Image != "debian:jessie" {
+		t.Fatalf("unexpected pod: %v", p.Spec)
+	}
+
+	p.Status = corev1.PodStatus{
+		Phase:      corev1.PodRunning,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}},
+		ContainerStatuses: []corev1.ContainerStatus{{
+			RestartCount: 3,
+			State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		}},
+	}
+	if _, err = pods.UpdateStatus(p); err != nil {
+		t.Fatal(err)
+	}
+	pod, err := c.GetPod("ofdpa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pod.Phase != "Running" || pod.Ready || pod.Restarts != 3 || pod.Reason != "CrashLoopBackOff" {
+		t.Errorf("unexpected pod: %+v", pod)
+	}
+
+	for _, name := range []string{"ofdpa", "ofdpa"} {
+		if err = c.DeletePod(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = c.GetPod("ofdpa"); err != errPodNotFound {
+		t.Errorf("expected errPodNotFound, got %v", err)
+	}
+}
+
+func TestClientsetClientPodEvents(t *testing.T) {
+	event := func(name, pod, reason string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "oopt"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: pod},
+			Type:           "Warning",
+			Reason:         reason,
+		}
+	}
+	c := &clientsetClient{
+		clientset: fake.NewSimpleClientset(event("a", "ofdpa", "Failed"), event("b", "sonic", "BackOff")),
+		namespace: "oopt",
+	}
+	events, err := c.PodEvents("ofdpa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Reason != "Failed" {
+		t.Errorf("unexpected events: %v", events)
+	}
+}
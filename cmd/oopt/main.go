@@ -16,10 +16,10 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
 	"github.com/osrg/oopt/pkg/sonic"
 
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
@@ -45,7 +46,8 @@ var virtual bool
 var dry bool
 
 const (
-	CONFIG_FILE = "config.json"
+	CONFIG_FILE         = "config.json"
+	APPLIED_COMMIT_FILE = "applied-commit"
 )
 
 func RemoveContents(dir string) error {
@@ -112,8 +114,14 @@ func validateFinal(config *model.PacketTransponder) error {
 	if err != nil {
 		return err
 	}
+	if err = validateSystem(config.System); err != nil {
+		return err
+	}
 	usedID := map[int]string{}
 	for k, v := range config.Interface {
+		if err := sonic.ValidateInterface(v); err != nil {
+			return fmt.Errorf("interface %s: %v", k, err)
+		}
 		if c := v.OpticalModuleConnection; c != nil {
 			if c.Id == nil || c.OpticalModule == nil || c.OpticalModule.Channel == nil || c.OpticalModule.Name == nil {
 				return fmt.Errorf("insufficient configuration for optical module connection")
@@ -127,37 +135,33 @@ func validateFinal(config *model.PacketTransponder) error {
 		}
 	}
 	for k, v := range config.Port {
+		port, err := platform.Current().Port(k)
+		if err != nil {
+			return err
+		}
 		bMode := v.BreakoutMode
-		switch *bMode.NumChannels {
-		case 1:
-			switch bMode.ChannelSpeed {
-			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB:
-			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB:
-			default:
-				return fmt.Errorf("unsupported port speed %v for port %s", bMode.ChannelSpeed, k)
-			}
-		case 2:
-			switch bMode.ChannelSpeed {
-			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB:
-			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB:
-			default:
-				return fmt.Errorf("port speed must be 20G, 40G or 50G for breakout(2) port %s", k)
-			}
-		case 4:
-			switch bMode.ChannelSpeed {
-			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB:
-			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB:
-			default:
-				return fmt.Errorf("port speed must be 10G or 25G for breakout(4) port %s", k)
+		breakout, err := port.Breakout(*bMode.NumChannels)
+		if err != nil {
+			return err
+		}
+		if !breakout.Supports(bMode.ChannelSpeed) {
+			speeds := make([]string, 0, len(breakout.ChannelSpeeds))
+			for _, s := range breakout.ChannelSpeeds {
+				speeds = append(speeds, enumName(s))
 			}
-		default:
-			return fmt.Errorf("invalid num-channels %d for port %s", *bMode.NumChannels, k)
+			return fmt.Errorf("port speed must be %s for breakout(%d) port %s", strings.Join(speeds, " or "), *bMode.NumChannels, k)
 		}
 	}
 	for k, v := range config.OpticalModule {
 		if err := sonic.FillTransportDefaultConfig(v, config); err != nil {
 			return err
 		}
+		if _, err := sonic.CentreFrequency(v.OpticalModuleFrequency); err != nil {
+			return fmt.Errorf("name: %s, %v", k, err)
+		}
+		if _, err := sonic.OpticalModuleAlarmThresholds(v); err != nil {
+			return fmt.Errorf("name: %s, %v", k, err)
+		}
 		if *v.AllowOversubscription {
 			continue
 		}
@@ -208,12 +212,16 @@ func handleDiff(newConfig, oldConfig *model.PacketTransponder, diff *gnmipb.Noti
 	portDiffTask := map[string][]sonic.DiffTask{}
 
 	var taskMap map[string][]sonic.DiffTask
+	systemChanged := false
 
 	for _, u := range diff.Update {
 		elems := u.GetPath().GetElem()
 		e := elems[0]
 		n := elems[1]
 		switch e.Name {
+		case "system":
+			systemChanged = true
+			continue
 		case "optical-modules":
 			taskMap = optDiffTask
 		case "interfaces":
@@ -236,6 +244,9 @@ func handleDiff(newConfig, oldConfig *model.PacketTransponder, diff *gnmipb.Noti
 		e := elems[0]
 		n := elems[1]
 		switch e.Name {
+		case "system":
+			systemChanged = true
+			continue
 		case "optical-modules":
 			taskMap = optDiffTask
 		case "interfaces":
@@ -253,14 +264,14 @@ func handleDiff(newConfig, oldConfig *model.PacketTransponder, diff *gnmipb.Noti
 		taskMap[name] = append(task, sonic.DiffTask{sonic.DiffDeleted, elems[2:], nil})
 	}
 
-	for k, v := range optDiffTask {
-		if err := sonic.HandleOptDiff(k, v); err != nil {
+	if systemChanged {
+		if err := updateSystemConfig(newConfig, oldConfig); err != nil {
 			return rebootOFDPA, err
 		}
 	}
 
-	for k, v := range intfDiffTask {
-		if err := sonic.HandleInterfaceDiff(newConfig, oldConfig, k, v); err != nil {
+	for k, v := range optDiffTask {
+		if err := sonic.HandleOptDiff(newConfig, k, v); err != nil {
 			return rebootOFDPA, err
 		}
 	}
@@ -274,13 +285,67 @@ func handleDiff(newConfig, oldConfig *model.PacketTransponder, diff *gnmipb.Noti
 			rebootOFDPA = true
 		}
 	}
+	if rebootOFDPA {
+		return rebootOFDPA, nil
+	}
+
+	// interfaces removed by breakout changes must leave their VLANs
+	// before their PORT entries are removed
+	for k, v := range intfDiffTask {
+		if _, ok := newConfig.Interface[k]; ok {
+			continue
+		}
+		if err := sonic.HandleInterfaceDiff(newConfig, oldConfig, k, v); err != nil {
+			return rebootOFDPA, err
+		}
+	}
+
+	for k, v := range portDiffTask {
+		if !sonic.BreakoutChanged(v) {
+			continue
+		}
+		if err := reconfigurePort(newConfig, oldConfig, k); err != nil {
+			return rebootOFDPA, err
+		}
+	}
+
+	for k, v := range intfDiffTask {
+		if _, ok := newConfig.Interface[k]; !ok {
+			continue
+		}
+		if err := sonic.HandleInterfaceDiff(newConfig, oldConfig, k, v); err != nil {
+			return rebootOFDPA, err
+		}
+	}
 
 	return rebootOFDPA, nil
 }
 
+// reconfigurePort applies the breakout mode of the port
+// to SONiC and OF-DPA without restarting them
+func reconfigurePort(newConfig, oldConfig *model.PacketTransponder, name string) error {
+	log.Printf("reconfiguring %s\n", name)
+	if err := reconfigureSONiCPort(newConfig, oldConfig, name); err != nil {
+		return err
+	}
+	if virtual {
+		return nil
+	}
+	port, err := platform.Current().Port(name)
+	if err != nil {
+		return err
+	}
+	bMode := newConfig.Port[name].BreakoutMode
+	config, err := NewOFDPAConfigFromModel(newConfig)
+	if err != nil {
+		return err
+	}
+	return ApplyOFDPAPortMode(config, NewPort(port, int(*bMode.NumChannels), ofdpaChannelSpeed(bMode.ChannelSpeed)))
+}
+
 const (
-	portNum          = 16
-	opticalModuleNum = 8
+	MIN_MTU = 68
+	MAX_MTU = 9216
 )
 
 func newInterface(t *model.PacketTransponder, name string, speed model.E_OpenconfigIfEthernet_ETHERNET_SPEED) error {
@@ -288,15 +353,17 @@ func newInterface(t *model.PacketTransponder, name string, speed model.E_Opencon
 	if err != nil {
 		return err
 	}
-	iface.Mtu = ygot.Uint16(1500)
+	iface.Enabled = ygot.Bool(true)
+	iface.Mtu = ygot.Uint16(sonic.DEFAULT_MTU)
 	iface.PortSpeed = speed
 	return nil
 }
 
 func defaultConfiguration() (*model.PacketTransponder, error) {
 	d := &model.PacketTransponder{}
-	for i := 1; i <= portNum; i++ {
-		port, err := d.NewPort(fmt.Sprintf("Port%d", i))
+	p := platform.Current()
+	for _, v := range p.Ports {
+		port, err := d.NewPort(v.Name)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create port: %v", err)
 		}
@@ -305,13 +372,13 @@ func defaultConfiguration() (*model.PacketTransponder, error) {
 			ChannelSpeed: speed,
 			NumChannels:  ygot.Uint8(1),
 		}
-		err = newInterface(d, fmt.Sprintf("Ethernet%d", i), speed)
+		err = newInterface(d, v.Interface, speed)
 		if err != nil {
 			return nil, err
 		}
 	}
-	for i := 1; i <= opticalModuleNum; i++ {
-		_, err := d.NewOpticalModule(fmt.Sprintf("Opt%d", i))
+	for _, v := range p.OpticalModules {
+		_, err := d.NewOpticalModule(v.Name)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create optical module: %v", err)
 		}
@@ -456,12 +523,12 @@ func NewPortCmd() *cobra.Command {
 
 			current.Port[name].BreakoutMode.ChannelSpeed = speed
 
-			portNum, err := strconv.Atoi(name[len("Port"):])
+			port, err := platform.Current().Port(name)
 			if err != nil {
 				return err
 			}
 
-			ethName := fmt.Sprintf("Ethernet%d", portNum)
+			ethName := port.Interface
 
 			for k, v := range current.Interface {
 				if k == ethName || strings.HasPrefix(k, ethName+"_") {
@@ -473,8 +540,8 @@ func NewPortCmd() *cobra.Command {
 	}
 
 	numChannelsCmd := &cobra.Command{
-		Use:       "num-channels [1|4]",
-		ValidArgs: []string{"1", "4"},
+		Use:       "num-channels [1|2|4]",
+		ValidArgs: []string{"1", "2", "4"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return fmt.Errorf("specify channel number")
@@ -484,35 +551,29 @@ func NewPortCmd() *cobra.Command {
 				return err
 			}
 
+			port, err := platform.Current().Port(name)
+			if err != nil {
+				return err
+			}
 			// ygot doesn't catch invalid num-channels
-			if num != 1 && num != 4 {
-				return fmt.Errorf("supported num-channels: 1, 2 or 4")
+			if _, err = port.Breakout(uint8(num)); err != nil {
+				return err
 			}
 			if *current.Port[name].BreakoutMode.NumChannels == uint8(num) {
 				return fmt.Errorf("num-channels is already set to %d", num)
 			}
 			current.Port[name].BreakoutMode.NumChannels = ygot.Uint8(uint8(num))
-			portNum, err := strconv.Atoi(name[len("Port"):])
-			if err != nil {
-				return err
-			}
-			switch num {
-			case 1:
-				for i := 1; i <= 4; i++ {
-					delete(current.Interface, fmt.Sprintf("Ethernet%d_%d", portNum, i))
+			ethName := port.Interface
+			for k := range current.Interface {
+				if k == ethName || strings.HasPrefix(k, ethName+"_") {
+					delete(current.Interface, k)
 				}
-				err = newInterface(current, fmt.Sprintf("Ethernet%d", portNum), current.Port[name].BreakoutMode.ChannelSpeed)
+			}
+			for _, n := range port.InterfaceNames(int(num)) {
+				err = newInterface(current, n, current.Port[name].BreakoutMode.ChannelSpeed)
 				if err != nil {
 					return err
 				}
-			case 4:
-				delete(current.Interface, fmt.Sprintf("Ethernet%d", portNum))
-				for i := 1; i <= 4; i++ {
-					err = newInterface(current, fmt.Sprintf("Ethernet%d_%d", portNum, i), current.Port[name].BreakoutMode.ChannelSpeed)
-					if err != nil {
-						return err
-					}
-				}
 			}
 			return nil
 		},
@@ -549,6 +610,7 @@ func NewPortCmd() *cobra.Command {
 	descriptionCmd.AddCommand(clearCmd)
 
 	portCmdImpl := &cobra.Command{
+		Annotations: map[string]string{COMPLETION_ANNOTATION: "port"},
 		Dynamic: func(n string) (bool, error) {
 			name = n
 			return true, nil
@@ -629,18 +691,29 @@ func NewInterfaceCmd() *cobra.Command {
 		},
 	}
 
+	// the channels of the optical module on the selected platform are checked on run
+	channels := platform.ChannelNames()
+
 	channelCmd := &cobra.Command{
-		Use:       "channel",
+		Use:       fmt.Sprintf("channel [%s]", strings.Join(channels, "|")),
 		Args:      cobra.OnlyValidArgs,
-		ValidArgs: []string{"A", "B"},
+		ValidArgs: channels,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
 				return fmt.Errorf("invalid usage")
 			}
-			if args[0] != "A" && args[0] != "B" {
-				return fmt.Errorf("unsupported channel %s, supported channels are 'A' and 'B'", args[0])
+			m := current.Interface[name].OpticalModuleConnection.OpticalModule
+			if m.Name == nil {
+				return fmt.Errorf("set optical-module name first")
 			}
-			current.Interface[name].OpticalModuleConnection.OpticalModule.Channel = ygot.String(args[0])
+			module, err := platform.Current().OpticalModule(*m.Name)
+			if err != nil {
+				return err
+			}
+			if _, err = module.Channel(args[0]); err != nil {
+				return fmt.Errorf("unsupported channel %s, supported channels are %s", args[0], strings.Join(module.ChannelNames(), ", "))
+			}
+			m.Channel = ygot.String(args[0])
 			return nil
 		},
 	}
@@ -665,12 +738,29 @@ func NewInterfaceCmd() *cobra.Command {
 	}
 	connectionCmd.AddCommand(idCmd, moduleCmd, clearCmd)
 
+	getState := func() error {
+		err := sonic.FillInterfaceState(name, current.Interface[name])
+		if err != nil {
+			return err
+		}
+		return applyCounterState(name, current.Interface[name])
+	}
+
+	var watchMode bool
+	var watchInterval time.Duration
 	stateCmd := &cobra.Command{
 		Use:  "state",
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := sonic.FillInterfaceState(name, current.Interface[name])
-			if err != nil {
+			if watchMode {
+				return watch(name, watchInterval, func() ([]*watchTable, error) {
+					if err := getState(); err != nil {
+						return nil, err
+					}
+					return interfaceWatchTables(current.Interface[name]), nil
+				})
+			}
+			if err := getState(); err != nil {
 				return err
 			}
 			json, err := ygot.EmitJSON(current.Interface[name], nil)
@@ -684,12 +774,34 @@ func NewInterfaceCmd() *cobra.Command {
 			return nil
 		},
 	}
+	stateCmd.Flags().BoolVarP(&watchMode, "watch", "w", false, "redraw the state periodically")
+	stateCmd.Flags().DurationVarP(&watchInterval, "interval", "", DEFAULT_WATCH_INTERVAL, "interval of watch mode")
+
+	countersCmd := &cobra.Command{
+		Use:  "counters",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := sonic.FillInterfaceCounters(name, current.Interface[name])
+			if err != nil {
+				return err
+			}
+			return clearCounters(name, current.Interface[name])
+		},
+	}
+
+	clearCountersCmd := &cobra.Command{
+		Use: "clear",
+		PersistentPostRunE: func(cmd *cobra.Command, args []string) error {
+			return nil
+		},
+	}
+	clearCountersCmd.AddCommand(countersCmd)
 
 	descriptionClearCmd := &cobra.Command{
 		Use:  "clear",
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			current.Port[name].Description = nil
+			current.Interface[name].Description = nil
 			return nil
 		},
 	}
@@ -711,7 +823,106 @@ func NewInterfaceCmd() *cobra.Command {
 	}
 	descriptionCmd.AddCommand(descriptionClearCmd)
 
+	enableCmd := &cobra.Command{
+		Use:  "enable",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			current.Interface[name].Enabled = ygot.Bool(true)
+			return nil
+		},
+	}
+
+	disableCmd := &cobra.Command{
+		Use:  "disable",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			current.Interface[name].Enabled = ygot.Bool(false)
+			return nil
+		},
+	}
+
+	mtuCmd := &cobra.Command{
+		Use:  "mtu",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if mtu := current.Interface[name].Mtu; mtu != nil {
+					fmt.Println(*mtu)
+				}
+				return nil
+			}
+			mtu, err := strconv.ParseUint(args[0], 10, 16)
+			if err != nil {
+				return err
+			}
+			if mtu < MIN_MTU || mtu > MAX_MTU {
+				return fmt.Errorf("mtu must be between %d and %d", MIN_MTU, MAX_MTU)
+			}
+			current.Interface[name].Mtu = ygot.Uint16(uint16(mtu))
+			return nil
+		},
+	}
+
+	autoNegotiateCmd := &cobra.Command{
+		Use:       "auto-negotiate",
+		ValidArgs: []string{"on", "off"},
+		Args:      cobra.OnlyValidArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("invalid usage")
+			}
+			current.Interface[name].AutoNegotiate = ygot.Bool(args[0] == "on")
+			return nil
+		},
+	}
+
+	flowControlCmd := &cobra.Command{
+		Use:       "flow-control",
+		ValidArgs: []string{"on", "off"},
+		Args:      cobra.OnlyValidArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("invalid usage")
+			}
+			if args[0] == "on" {
+				return sonic.ErrFlowControlUnsupported
+			}
+			current.Interface[name].EnableFlowControl = ygot.Bool(false)
+			return nil
+		},
+	}
+
+	macAddressClearCmd := &cobra.Command{
+		Use:  "clear",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			current.Interface[name].MacAddress = nil
+			return nil
+		},
+	}
+
+	macAddressCmd := &cobra.Command{
+		Use:  "mac-address",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if mac := current.Interface[name].MacAddress; mac != nil {
+					fmt.Println(*mac)
+				}
+				return nil
+			}
+			mac, err := net.ParseMAC(args[0])
+			if err != nil {
+				return err
+			}
+			current.Interface[name].MacAddress = ygot.String(mac.String())
+			return nil
+		},
+	}
+	macAddressCmd.AddCommand(macAddressClearCmd)
+
 	intfCmdImpl := &cobra.Command{
+		Annotations: map[string]string{COMPLETION_ANNOTATION: "interface"},
 		Dynamic: func(n string) (bool, error) {
 			name = n
 			return true, nil
@@ -741,7 +952,7 @@ func NewInterfaceCmd() *cobra.Command {
 			return nil
 		},
 	}
-	intfCmdImpl.AddCommand(connectionCmd, stateCmd, descriptionCmd)
+	intfCmdImpl.AddCommand(connectionCmd, stateCmd, descriptionCmd, clearCountersCmd, enableCmd, disableCmd, mtuCmd, autoNegotiateCmd, flowControlCmd, macAddressCmd)
 
 	intfCmd := &cobra.Command{
 		Use:               "interface <interface-name>",
@@ -762,6 +973,18 @@ func NewInterfaceCmd() *cobra.Command {
 	return intfCmd
 }
 
+// setFrequencyGrid sets the grid clearing the leaves the grid doesn't use
+// so that the config doesn't carry conflicting tuning leaves
+func setFrequencyGrid(f *model.PacketTransponder_OpticalModule_OpticalModuleFrequency, grid model.E_PacketTransport_FrequencyGridType) {
+	if grid == model.PacketTransport_FrequencyGridType_FLEXGRID {
+		f.Channel = nil
+	} else {
+		f.CentralFrequencyN = nil
+		f.SlotWidthM = nil
+	}
+	f.Grid = grid
+}
+
 func NewOpticalModuleCmd() *cobra.Command {
 	var name string
 	var verbose bool
@@ -794,7 +1017,7 @@ func NewOpticalModuleCmd() *cobra.Command {
 				return fmt.Errorf("unknown grid: %s", g)
 			}
 
-			current.OpticalModule[name].OpticalModuleFrequency.Grid = grid
+			setFrequencyGrid(current.OpticalModule[name].OpticalModuleFrequency, grid)
 			return nil
 		},
 	}
@@ -815,6 +1038,85 @@ func NewOpticalModuleCmd() *cobra.Command {
 			return nil
 		},
 	}
+	centralFrequencyNCmd := &cobra.Command{
+		Use:   "central-frequency-n <n>",
+		Short: "set the central frequency of FLEXGRID to 193.1THz + n * 6.25GHz",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			n, err := strconv.ParseInt(args[0], 10, 16)
+			if err != nil {
+				return err
+			}
+			if _, err = sonic.FlexgridFrequency(int16(n)); err != nil {
+				return err
+			}
+			current.OpticalModule[name].OpticalModuleFrequency.CentralFrequencyN = ygot.Int16(int16(n))
+			return nil
+		},
+	}
+	slotWidthMCmd := &cobra.Command{
+		Use:   "slot-width-m <m>",
+		Short: "set the slot width of FLEXGRID to m * 12.5GHz",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := strconv.ParseUint(args[0], 10, 8)
+			if err != nil {
+				return err
+			}
+			if m < 1 {
+				return fmt.Errorf("slot-width-m can't be 0")
+			}
+			current.OpticalModule[name].OpticalModuleFrequency.SlotWidthM = ygot.Uint8(uint8(m))
+			return nil
+		},
+	}
+
+	var setGrid string
+	setCmd := &cobra.Command{
+		Use:   "set <frequency>",
+		Short: "set the channel by the centre frequency like 193.1THz or the wavelength like 1552.52nm",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f := current.OpticalModule[name].OpticalModuleFrequency
+			grid := f.Grid
+			if setGrid != "" {
+				grid = model.PacketTransport_FrequencyGridType_UNSET
+				for k, v := range model.ΛEnum["E_PacketTransport_FrequencyGridType"] {
+					if setGrid == v.Name {
+						grid = model.E_PacketTransport_FrequencyGridType(k)
+						break
+					}
+				}
+				if grid == model.PacketTransport_FrequencyGridType_UNSET {
+					return fmt.Errorf("unknown grid: %s", setGrid)
+				}
+			} else if grid == model.PacketTransport_FrequencyGridType_UNSET {
+				grid = model.PacketTransport_FrequencyGridType_GRID_50GHZ
+			}
+			frequency, err := sonic.ParseFrequency(args[0])
+			if err != nil {
+				return err
+			}
+			if grid == model.PacketTransport_FrequencyGridType_FLEXGRID {
+				n, err := sonic.FlexgridN(frequency)
+				if err != nil {
+					return err
+				}
+				setFrequencyGrid(f, grid)
+				f.CentralFrequencyN = ygot.Int16(n)
+				return nil
+			}
+			channel, err := sonic.FrequencyChannel(grid, frequency)
+			if err != nil {
+				return err
+			}
+			setFrequencyGrid(f, grid)
+			f.Channel = ygot.Uint8(channel)
+			return nil
+		},
+	}
+	setCmd.Flags().StringVarP(&setGrid, "grid", "g", "", fmt.Sprintf("grid [%s]. the configured grid is used by default", strings.Join(grids, "|")))
+
 	frequencyCmd := &cobra.Command{
 		Use: "frequency",
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
@@ -831,7 +1133,7 @@ func NewOpticalModuleCmd() *cobra.Command {
 			return nil
 		},
 	}
-	frequencyCmd.AddCommand(gridCmd, channelCmd)
+	frequencyCmd.AddCommand(gridCmd, channelCmd, centralFrequencyNCmd, slotWidthMCmd, setCmd)
 
 	berIntervalCmd := &cobra.Command{
 		Use:  "ber-interval",
@@ -932,11 +1234,73 @@ func NewOpticalModuleCmd() *cobra.Command {
 
 	allowOversubscriptionCmd.AddCommand(clearAllowOversubscriptionCmd)
 
-	mods := make([]string, 0, len(model.ΛEnum["E_PacketTransport_OpticalModulationType"]))
+	postFecBerClearCmd := &cobra.Command{
+		Use:  "clear",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			current.OpticalModule[name].AlarmThresholds.PostFecBer = nil
+			return nil
+		},
+	}
+	postFecBerCmd := &cobra.Command{
+		Use:  "post-fec-ber <ber>",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ber, err := strconv.ParseFloat(args[0], 64)
+			if err != nil {
+				return err
+			}
+			if ber < 0 {
+				return fmt.Errorf("ber can't be negative")
+			}
+			current.OpticalModule[name].AlarmThresholds.PostFecBer = ygot.Float64(ber)
+			return nil
+		},
+	}
+	postFecBerCmd.AddCommand(postFecBerClearCmd)
 
-	for _, v := range model.ΛEnum["E_PacketTransport_OpticalModulationType"] {
-		mods = append(mods, v.Name)
+	sdFecBerRisingSamplesClearCmd := &cobra.Command{
+		Use:  "clear",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			current.OpticalModule[name].AlarmThresholds.SdFecBerRisingSamples = nil
+			return nil
+		},
 	}
+	sdFecBerRisingSamplesCmd := &cobra.Command{
+		Use:  "sd-fec-ber-rising-samples <samples>",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			samples, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			current.OpticalModule[name].AlarmThresholds.SdFecBerRisingSamples = ygot.Uint32(uint32(samples))
+			return nil
+		},
+	}
+	sdFecBerRisingSamplesCmd.AddCommand(sdFecBerRisingSamplesClearCmd)
+
+	alarmThresholdsCmd := &cobra.Command{
+		Use: "alarm-thresholds",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			err := persistentPreRunE(cmd, args)
+			if err != nil {
+				return err
+			}
+			if _, ok := current.OpticalModule[name]; !ok {
+				return fmt.Errorf("optical-module %s doesn't exist", name)
+			}
+			if current.OpticalModule[name].AlarmThresholds == nil {
+				current.OpticalModule[name].AlarmThresholds = &model.PacketTransponder_OpticalModule_AlarmThresholds{}
+			}
+			return nil
+		},
+	}
+	alarmThresholdsCmd.AddCommand(postFecBerCmd, sdFecBerRisingSamplesCmd)
+
+	// the formats supported by the optical modules of the selected platform are checked on run
+	mods := platform.ModulationNames()
 
 	modUsage := fmt.Sprintf("modulation-type [%s]", strings.Join(mods, "|"))
 
@@ -959,32 +1323,58 @@ func NewOpticalModuleCmd() *cobra.Command {
 			if mod == model.PacketTransport_OpticalModulationType_UNSET {
 				return fmt.Errorf("unknown modulation-type: %s", m)
 			}
+			module, err := platform.Current().OpticalModule(name)
+			if err != nil {
+				return err
+			}
+			if _, err = module.Modulation(mod); err != nil {
+				return err
+			}
 
 			current.OpticalModule[name].ModulationType = mod
 			return nil
 		},
 	}
 
+	getState := func() (*model.PacketTransponder_OpticalModule, error) {
+		o, err := ygot.DeepCopy(current.OpticalModule[name])
+		if err != nil {
+			return nil, err
+		}
+		module := o.(*model.PacketTransponder_OpticalModule)
+		if verbose {
+			if err := sonic.FillTransportDefaultConfig(module, current); err != nil {
+				return nil, err
+			}
+		}
+		if !dry {
+			err = sonic.FillTransportState(name, module)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return module, nil
+	}
+
+	var watchMode bool
+	var watchInterval time.Duration
 	stateCmd := &cobra.Command{
 		Use:  "state",
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			o, err := ygot.DeepCopy(current.OpticalModule[name])
+			if watchMode {
+				return watch(name, watchInterval, func() ([]*watchTable, error) {
+					module, err := getState()
+					if err != nil {
+						return nil, err
+					}
+					return opticalModuleWatchTables(module), nil
+				})
+			}
+			module, err := getState()
 			if err != nil {
 				return err
 			}
-			module := o.(*model.PacketTransponder_OpticalModule)
-			if verbose {
-				if err := sonic.FillTransportDefaultConfig(module, current); err != nil {
-					return err
-				}
-			}
-			if !dry {
-				err = sonic.FillTransportState(name, module)
-				if err != nil {
-					return err
-				}
-			}
 			json, err := ygot.EmitJSON(module, nil)
 			if err != nil {
 				return err
@@ -994,6 +1384,9 @@ func NewOpticalModuleCmd() *cobra.Command {
 		},
 	}
 
+	stateCmd.Flags().BoolVarP(&watchMode, "watch", "w", false, "redraw the state periodically")
+	stateCmd.Flags().DurationVarP(&watchInterval, "interval", "", DEFAULT_WATCH_INTERVAL, "interval of watch mode")
+
 	clearCmd := &cobra.Command{
 		Use:  "clear",
 		Args: cobra.NoArgs,
@@ -1022,6 +1415,7 @@ func NewOpticalModuleCmd() *cobra.Command {
 	descriptionCmd.AddCommand(clearCmd)
 
 	opticalModuleCmdImpl := &cobra.Command{
+		Annotations: map[string]string{COMPLETION_ANNOTATION: "optical-module"},
 		Dynamic: func(n string) (bool, error) {
 			name = n
 			return true, nil
@@ -1056,7 +1450,7 @@ func NewOpticalModuleCmd() *cobra.Command {
 		},
 		PersistentPostRunE: persistentPostRunE,
 	}
-	opticalModuleCmdImpl.AddCommand(frequencyCmd, berIntervalCmd, prbsCmd, losiCmd, modCmd, stateCmd, descriptionCmd, enableCmd, disableCmd, allowOversubscriptionCmd)
+	opticalModuleCmdImpl.AddCommand(frequencyCmd, berIntervalCmd, prbsCmd, losiCmd, modCmd, stateCmd, descriptionCmd, enableCmd, disableCmd, allowOversubscriptionCmd, alarmThresholdsCmd)
 
 	opticalModuleCmd := &cobra.Command{
 		Use:               "optical-module <module-name>",
@@ -1082,54 +1476,6 @@ func NewOpticalModuleCmd() *cobra.Command {
 	return opticalModuleCmd
 }
 
-func rebootSystem(config *model.PacketTransponder) error {
-	if dry {
-		return nil
-	}
-	log.Println("restarting redis pod")
-	err := RestartRedis()
-	if err != nil {
-		return err
-	}
-	// TODO wait redis boot up
-	time.Sleep(time.Second * 5)
-	err = sonic.ConfigureTransport(config)
-	if err != nil {
-		return err
-	}
-	log.Println("restarting transyncd pod")
-	err = RestartTransyncd()
-	if err != nil {
-		return err
-	}
-
-	if !virtual {
-		ofdpa, err := NewOFDPAConfigFromModel(config)
-		if err != nil {
-			return err
-		}
-		log.Println("restarting ofdpa pod")
-		err = RestartOFDPA(ofdpa)
-		if err != nil {
-			return err
-		}
-	}
-	sonicConfig, err := NewSONiCConfigFromModel(config)
-	if err != nil {
-		return err
-	}
-	err = sonicConfig.WriteToConfigDB()
-	if err != nil {
-		return err
-	}
-	bytes, err := json.Marshal(sonicConfig)
-	if err != nil {
-		return err
-	}
-	log.Println("restarting sonic pod")
-	return RestartSONiC(string(bytes), virtual)
-}
-
 func commit(commitMessage string, reboot bool) error {
 	err := validateFinal(current)
 	if err != nil {
@@ -1179,7 +1525,7 @@ func commit(commitMessage string, reboot bool) error {
 		return err
 	}
 	if reboot {
-		return rebootSystem(current)
+		return rollbackOnFailure(s, rebootSystem(current))
 	}
 
 	opt := &ygot.DiffPathOpt{
@@ -1192,9 +1538,12 @@ func commit(commitMessage string, reboot bool) error {
 	}
 	reboot, err = handleDiff(t, s, diff)
 	if reboot {
-		return rebootSystem(current)
+		return rollbackOnFailure(s, rebootSystem(current))
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	return recordAppliedCommit()
 }
 
 func NewCommitCmd() *cobra.Command {
@@ -1212,6 +1561,7 @@ func NewCommitCmd() *cobra.Command {
 	}
 	commitCmd.PersistentFlags().BoolVarP(&reboot, "reboot", "r", false, "always reboot")
 	commitCmd.PersistentFlags().StringVarP(&message, "message", "m", "", "git commit message")
+	addRebootFlags(commitCmd.PersistentFlags(), true)
 	return commitCmd
 }
 
@@ -1262,6 +1612,7 @@ func NewRollbackCmd() *cobra.Command {
 	rollbackCmd.PersistentFlags().BoolVarP(&reboot, "reboot", "r", false, "always reboot")
 	rollbackCmd.PersistentFlags().IntVarP(&number, "number", "n", 0, "configuration to return to")
 	rollbackCmd.PersistentFlags().StringVarP(&message, "message", "m", "", "git commit message")
+	addRebootFlags(rollbackCmd.PersistentFlags(), true)
 	return rollbackCmd
 }
 
@@ -1306,6 +1657,7 @@ func NewRebootCmd() *cobra.Command {
 			return rebootSystem(current)
 		},
 	}
+	addRebootFlags(rebootCmd.PersistentFlags(), false)
 	return rebootCmd
 }
 
@@ -1314,42 +1666,30 @@ func NewStopCmd() *cobra.Command {
 		Use:  "stop",
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := deletePod(TRANSYNCD_POD_NAME)
+			err := stopComponent(TRANSYNCD_POD_NAME)
 			if err != nil {
 				return err
 			}
-			err = deletePod(SONIC_POD_NAME)
+			err = stopComponent(SONIC_POD_NAME)
 			if err != nil {
 				return err
 			}
-			err = deletePod(OFDPA_POD_NAME)
+			err = stopComponent(OFDPA_POD_NAME)
 			if err != nil {
 				return err
 			}
-			return deletePod(REDIS_POD_NAME)
+			return stopComponent(REDIS_POD_NAME)
 		},
 	}
 	return stopCmd
 }
 
-func NewStatusCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:  "status",
-		Args: cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			c := exec.Command("kubectl", "get", "pod")
-			output, err := c.Output()
-			if err != nil {
-				return err
-			}
-			fmt.Printf("%s", output)
-			return nil
-		},
-	}
-}
-
 func NewRootCmd() *cobra.Command {
 	var gitDir string
+	var stateDir string
+	var platformName string
+	var deployConfig string
+	var databaseConfig string
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("oopt")
 	cobra.EnablePrefixMatching = true
@@ -1361,15 +1701,22 @@ func NewRootCmd() *cobra.Command {
 	rebootCmd := NewRebootCmd()
 	stopCmd := NewStopCmd()
 	statusCmd := NewStatusCmd()
+	completionCmd := NewCompletionCmd()
+	completeCmd := NewCompleteCmd()
 
 	dumpCmd := NewDumpCmd()
 	commitCmd := NewCommitCmd()
 	rollbackCmd := NewRollbackCmd()
 	diffCmd := NewDiffCmd()
+	planCmd := NewPlanCmd()
+	showCmd := NewShowCmd()
+	alarmsCmd := NewAlarmsCmd()
+	pmCmd := NewPMCmd()
 
 	portCmd := NewPortCmd()
 	interfaceCmd := NewInterfaceCmd()
 	opticalModuleCmd := NewOpticalModuleCmd()
+	systemCmd := NewSystemCmd()
 
 	allowOversubscriptionCmd := &cobra.Command{
 		Use:               "allow-oversubscription",
@@ -1390,16 +1737,41 @@ func NewRootCmd() *cobra.Command {
 		PersistentPostRunE: persistentPostRunE,
 	}
 
-	rootCmd.AddCommand(initCmd, dumpCmd, portCmd, interfaceCmd, opticalModuleCmd, commitCmd, rollbackCmd, rebootCmd, stopCmd, diffCmd, statusCmd, allowOversubscriptionCmd)
+	rootCmd.AddCommand(initCmd, dumpCmd, portCmd, interfaceCmd, opticalModuleCmd, commitCmd, rollbackCmd, rebootCmd, stopCmd, diffCmd, planCmd, showCmd, alarmsCmd, pmCmd, statusCmd, allowOversubscriptionCmd, systemCmd, completionCmd, completeCmd)
 	flags := rootCmd.PersistentFlags()
 	flags.BoolVarP(&virtual, "virtual", "", false, "virtual env")
 	flags.BoolVarP(&dry, "dry", "d", false, "dry run")
 	flags.StringVarP(&gitDir, "git-dir", "c", "/etc/oopt", "directory of git repo")
 	viper.BindPFlag("git_dir", flags.Lookup("git-dir"))
+	flags.StringVarP(&stateDir, "state-dir", "", "/var/lib/oopt", "directory to keep runtime state")
+	viper.BindPFlag("state_dir", flags.Lookup("state-dir"))
+	flags.StringVarP(&platformName, "platform", "", platform.DEFAULT_PLATFORM, fmt.Sprintf("hardware platform (%s)", strings.Join(platform.Names(), "|")))
+	viper.BindPFlag("platform", flags.Lookup("platform"))
+	flags.StringVarP(&deployConfig, "deploy-config", "", "", "deploy config file to override the deploy backend and the components")
+	viper.BindPFlag("deploy_config", flags.Lookup("deploy-config"))
+	flags.StringVarP(&databaseConfig, "database-config", "", sonic.DEFAULT_DATABASE_CONFIG_FILE, "SONiC database_config.json to locate the redis databases")
+	viper.BindPFlag("database_config", flags.Lookup("database-config"))
+	cobra.OnInitialize(func() {
+		if err := platform.Select(viper.GetString("platform")); err != nil {
+			log.Fatal(err)
+		}
+		config, err := sonic.LoadDatabaseConfig(viper.GetString("database_config"))
+		// older SONiC images don't ship the file. use the default layout unless it was given explicitly
+		if os.IsNotExist(err) && !flags.Changed("database-config") {
+			config, err = sonic.DefaultDatabaseConfig(), nil
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		sonic.SetDatabaseConfig(config)
+	})
 	return rootCmd
 }
 
 func main() {
-	NewRootCmd().GenBashCompletionFile("out.bash")
-	NewRootCmd().Execute()
+	err := NewRootCmd().Execute()
+	sonic.CloseClients()
+	if err != nil {
+		os.Exit(1)
+	}
 }
@@ -2,7 +2,12 @@ package main
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
 )
 
 func TestNewOFDPAConfigFromMode(t *testing.T) {
@@ -11,3 +16,103 @@ func TestNewOFDPAConfigFromMode(t *testing.T) {
 	fmt.Println(err)
 	fmt.Println(config)
 }
+
+func TestBreakout2x20G(t *testing.T) {
+	setupCommitTest(t)
+	for _, args := range [][]string{
+		{"Port2", "breakout-mode", "channel-speed", "SPEED_20GB"},
+		{"Port2", "breakout-mode", "num-channels", "2"},
+	} {
+		cmd := NewPortCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+	}
+	// the speed is saved to and loaded from config.json
+	if err := persistentPreRunE(nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateFinal(current); err != nil {
+		t.Fatal(err)
+	}
+	for i, name := range []string{"Ethernet2_1", "Ethernet2_2"} {
+		intf, ok := current.Interface[name]
+		if !ok {
+			t.Fatalf("%s is not created", name)
+		}
+		if intf.PortSpeed != model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_20GB {
+			t.Errorf("unexpected speed of %s: %v", name, intf.PortSpeed)
+		}
+		port, err := newSONiCPort(current, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lanes := fmt.Sprintf("%d,%d", 37+2*i, 38+2*i); port.Speed != "20000" || port.Lanes != lanes {
+			t.Errorf("unexpected SONiC port of %s: %+v", name, port)
+		}
+	}
+	if _, ok := current.Interface["Ethernet2"]; ok {
+		t.Error("Ethernet2 is left")
+	}
+
+	config, err := NewOFDPAConfigFromModel(current)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(config, "port_mode_72=2x20g # front port 2\n") {
+		t.Errorf("port mode of Port2 is not 2x20g:\n%s", config)
+	}
+	port, err := platform.Current().Port("Port2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmds, err := NewPort(port, 2, 20).portModeCommands()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"port 72 lanes=2 speed=20000 enable=true",
+		"port 73 enable=false",
+		"port 74 lanes=2 speed=20000 enable=true",
+		"port 75 enable=false",
+	}
+	if !reflect.DeepEqual(cmds, expected) {
+		t.Errorf("expected %v, got %v", expected, cmds)
+	}
+}
+
+func TestPortModeCommands(t *testing.T) {
+	for _, c := range []struct {
+		lanes        int
+		numChannels  int
+		channelSpeed int
+		expected     []string
+	}{
+		{2, 1, 50, []string{"port 10 lanes=2 speed=50000 enable=true", "port 11 enable=false"}},
+		{2, 2, 25, []string{"port 10 lanes=1 speed=25000 enable=true", "port 11 lanes=1 speed=25000 enable=true"}},
+		{8, 2, 200, []string{
+			"port 10 lanes=4 speed=200000 enable=true",
+			"port 11 enable=false",
+			"port 12 enable=false",
+			"port 13 enable=false",
+			"port 14 lanes=4 speed=200000 enable=true",
+			"port 15 enable=false",
+			"port 16 enable=false",
+			"port 17 enable=false",
+		}},
+	} {
+		port := &platform.Port{Name: "Port1", Lane: 1, BreakoutLanes: make([]int, c.lanes), ASICPort: 10}
+		cmds, err := NewPort(port, c.numChannels, c.channelSpeed).portModeCommands()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(cmds, c.expected) {
+			t.Errorf("%d lanes, %dx%dg: expected %v, got %v", c.lanes, c.numChannels, c.channelSpeed, c.expected, cmds)
+		}
+	}
+	port := &platform.Port{Name: "Port1", Lane: 1, BreakoutLanes: make([]int, 2), ASICPort: 10}
+	if _, err := NewPort(port, 4, 10).portModeCommands(); err == nil {
+		t.Error("expected error for 2 lanes broken out into 4")
+	}
+}
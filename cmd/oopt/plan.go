@@ -0,0 +1,298 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	git "gopkg.in/src-d/go-git.v4"
+	"sigs.k8s.io/yaml"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+const (
+	PLAN_REDIS_WRITES_FILE = "redis-writes.txt"
+)
+
+// artifact is a file generated by commit or reboot
+type artifact struct {
+	name    string
+	content string
+}
+
+// planBackend records the operations on the components instead of running them
+type planBackend struct {
+	actions []string
+}
+
+func (b *planBackend) SetConfig(c *Component, files map[string]string) error {
+	names := make([]string, 0, len(files))
+	for k := range files {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	b.actions = append(b.actions, fmt.Sprintf("%s: update config %s", c.Name, strings.Join(names, " ")))
+	return nil
+}
+
+func (b *planBackend) Start(c *Component) error {
+	b.actions = append(b.actions, fmt.Sprintf("%s: start", c.Name))
+	return nil
+}
+
+func (b *planBackend) Stop(c *Component) error {
+	b.actions = append(b.actions, fmt.Sprintf("%s: stop", c.Name))
+	return nil
+}
+
+func (b *planBackend) Status(c *Component) (*ComponentStatus, error) {
+	return &ComponentStatus{State: "Running", Ready: true}, nil
+}
+
+func (b *planBackend) Exec(c *Component, command ...string) ([]byte, error) {
+	b.actions = append(b.actions, fmt.Sprintf("%s: exec %s", c.Name, strings.Join(command, " ")))
+	return nil, nil
+}
+
+// manifestYAML converts a kubernetes object to YAML like kubectl get -o yaml
+func manifestYAML(obj interface{}) (string, error) {
+	out, err := yaml.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func planManifests(d *Deployment, configs map[string]map[string]string) ([]artifact, error) {
+	names := []string{REDIS_POD_NAME, TRANSYNCD_POD_NAME}
+	if !virtual {
+		names = append(names, OFDPA_POD_NAME)
+	}
+	names = append(names, SONIC_POD_NAME)
+	artifacts := []artifact{}
+	for _, name := range names {
+		c, err := d.component(name)
+		if err != nil {
+			return nil, err
+		}
+		if files, ok := configs[name]; ok {
+			y, err := manifestYAML(configMapManifest(c, files))
+			if err != nil {
+				return nil, err
+			}
+			artifacts = append(artifacts, artifact{fmt.Sprintf("k8s/%s.yaml", kubeConfigMapName(c)), y})
+		}
+		y, err := manifestYAML(podManifest(c))
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, artifact{fmt.Sprintf("k8s/%s.yaml", name), y})
+	}
+	return artifacts, nil
+}
+
+// planDiff returns the redis writes and the component operations
+// handleDiff would perform to apply the candidate config file over HEAD
+func planDiff() (string, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("%s/%s", viper.GetString("git_dir"), CONFIG_FILE))
+	if err != nil {
+		return "", err
+	}
+	candidate := &model.PacketTransponder{}
+	if err = model.Unmarshal(data, candidate); err != nil {
+		return "", err
+	}
+	repo, err := git.PlainOpen(viper.GetString("git_dir"))
+	if err != nil {
+		return "", err
+	}
+	ref, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+	head, err := repo.CommitObject(ref.Hash())
+	if err != nil {
+		return "", err
+	}
+	old, err := getPacketTransport(repo, head)
+	if err != nil {
+		return "", err
+	}
+	diff, err := ygot.Diff(old, candidate, &ygot.DiffPathOpt{MapToSinglePath: true})
+	if err != nil {
+		return "", err
+	}
+	if len(diff.Update) == 0 && len(diff.Delete) == 0 {
+		return "# no changes from HEAD\n", nil
+	}
+
+	orig := deployment
+	backend := &planBackend{}
+	d, err := getDeployment()
+	if err != nil {
+		return "", err
+	}
+	deployment = &Deployment{Backend: backend, Components: d.Components}
+	defer func() { deployment = orig }()
+
+	stop := sonic.RecordWrites()
+	reboot, err := handleDiff(candidate, old, diff)
+	writes := stop()
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	if reboot {
+		b.WriteString("# the changes require rebooting the system, commit will apply all the artifacts\n")
+	}
+	for _, w := range writes {
+		fmt.Fprintln(&b, w)
+	}
+	for _, a := range backend.actions {
+		fmt.Fprintln(&b, a)
+	}
+	return b.String(), nil
+}
+
+func buildPlan(candidate *model.PacketTransponder) ([]artifact, error) {
+	// generating the artifacts fills the default values into the model
+	clone := func() (*model.PacketTransponder, error) {
+		c, err := ygot.DeepCopy(candidate)
+		if err != nil {
+			return nil, err
+		}
+		return c.(*model.PacketTransponder), nil
+	}
+	artifacts := []artifact{}
+	configs := map[string]map[string]string{}
+
+	m, err := clone()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := sonic.TransportConfigEntries(m)
+	if err != nil {
+		return nil, err
+	}
+	transport, err := json.MarshalIndent(map[string]interface{}{sonic.CONFIG_TABLE: entries}, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	artifacts = append(artifacts, artifact{"transport_config.json", string(transport) + "\n"})
+
+	if !virtual {
+		if m, err = clone(); err != nil {
+			return nil, err
+		}
+		ofdpa, err := NewOFDPAConfigFromModel(m)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, artifact{"ofdpa.conf", ofdpa})
+		configs[OFDPA_POD_NAME] = map[string]string{"ofdpa.conf": ofdpa}
+	}
+
+	if m, err = clone(); err != nil {
+		return nil, err
+	}
+	sonicConfig, err := NewSONiCConfigFromModel(m)
+	if err != nil {
+		return nil, err
+	}
+	configDB, err := json.Marshal(sonicConfig)
+	if err != nil {
+		return nil, err
+	}
+	indented, err := json.MarshalIndent(sonicConfig, "", "    ")
+	if err != nil {
+		return nil, err
+	}
+	artifacts = append(artifacts, artifact{"config_db.json", string(indented) + "\n"})
+	configs[SONIC_POD_NAME] = map[string]string{"config_db.json": string(configDB)}
+
+	d, err := getDeployment()
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := planManifests(d, configs)
+	if err != nil {
+		return nil, err
+	}
+	artifacts = append(artifacts, manifests...)
+
+	// reads from redis are needed to plan the writes.
+	// the other artifacts are still useful without redis
+	writes, err := planDiff()
+	if err != nil {
+		log.Printf("failed to plan the redis writes: %v", err)
+		writes = fmt.Sprintf("# failed to plan the redis writes: %v\n", err)
+	}
+	return append(artifacts, artifact{PLAN_REDIS_WRITES_FILE, writes}), nil
+}
+
+func writePlan(w io.Writer, artifacts []artifact) error {
+	for i, a := range artifacts {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "==> %s <==\n", a.name)
+		if _, err := io.WriteString(w, a.content); err != nil {
+			return err
+		}
+		if !strings.HasSuffix(a.content, "\n") {
+			fmt.Fprintln(w)
+		}
+	}
+	return nil
+}
+
+func writePlanDir(dir string, artifacts []artifact) error {
+	for _, a := range artifacts {
+		name := filepath.Join(dir, a.name)
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(name, []byte(a.content), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewPlanCmd() *cobra.Command {
+	var output string
+	planCmd := &cobra.Command{
+		Use:               "plan",
+		Short:             "show the artifacts and the redis writes commit would generate from the candidate config",
+		Args:              cobra.NoArgs,
+		SilenceUsage:      true,
+		PersistentPreRunE: persistentPreRunE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateFinal(current); err != nil {
+				return err
+			}
+			artifacts, err := buildPlan(current)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				return writePlan(os.Stdout, artifacts)
+			}
+			return writePlanDir(output, artifacts)
+		},
+	}
+	planCmd.PersistentFlags().StringVarP(&output, "output", "o", "", "directory to write the artifacts to instead of stdout")
+	return planCmd
+}
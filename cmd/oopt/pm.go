@@ -0,0 +1,161 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/osrg/oopt/pkg/platform"
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+func pmDir() string {
+	return filepath.Join(viper.GetString("state_dir"), sonic.PM_DIR)
+}
+
+func formatPMValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', 4, 64)
+}
+
+// pmStatsCells returns min, avg and max of the statistics. none when there is no sample
+func pmStatsCells(s *sonic.PMStats, none string) []string {
+	if s == nil || s.Samples == 0 {
+		return []string{none, none, none}
+	}
+	return []string{formatPMValue(s.Min), formatPMValue(s.Avg()), formatPMValue(s.Max)}
+}
+
+func pmChannels(bins []sonic.PMBin) []string {
+	channels := map[string]bool{}
+	for _, b := range bins {
+		for ch := range b.Channels {
+			channels[ch] = true
+		}
+	}
+	names := make([]string, 0, len(channels))
+	for ch := range channels {
+		names = append(names, ch)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func pmStatus(b sonic.PMBin, now time.Time) string {
+	if b.Current(now) {
+		return "current"
+	} else if b.Suspect {
+		return "suspect"
+	}
+	return "complete"
+}
+
+// pmRows returns a row per bin and channel for CSV. the module-wide values are repeated in the rows of the channels
+func pmRows(bins []sonic.PMBin, now time.Time) [][]string {
+	channels := pmChannels(bins)
+	if len(channels) == 0 {
+		channels = []string{""}
+	}
+	rows := [][]string{}
+	for _, b := range bins {
+		common := []string{b.Module, b.Type, b.Start.Format(time.RFC3339), b.End.Format(time.RFC3339), pmStatus(b, now),
+			strconv.Itoa(b.Samples), strconv.Itoa(b.SyncErrorSeconds)}
+		for _, name := range sonic.PM_RMS_NAMES {
+			common = append(common, pmStatsCells(b.Rms[name], "")...)
+		}
+		for _, ch := range channels {
+			row := append(append([]string{}, common...), ch)
+			for _, name := range sonic.PM_BER_NAMES {
+				row = append(row, pmStatsCells(b.Channels[ch][name], "")...)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func pmHeader() []string {
+	header := []string{"module", "bin", "start", "end", "status", "samples", "sync-error-seconds"}
+	for _, name := range sonic.PM_RMS_NAMES {
+		header = append(header, "rms-"+name+"-min", "rms-"+name+"-avg", "rms-"+name+"-max")
+	}
+	header = append(header, "channel")
+	for _, name := range sonic.PM_BER_NAMES {
+		header = append(header, name+"-min", name+"-avg", name+"-max")
+	}
+	return header
+}
+
+func writePMCSV(w io.Writer, bins []sonic.PMBin, now time.Time) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(pmHeader()); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(pmRows(bins, now)); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// writePMTable shows the BER of the channels and sync-error seconds.
+// RMS is shown only in CSV not to make the table too wide
+func writePMTable(w io.Writer, bins []sonic.PMBin, now time.Time) error {
+	channels := pmChannels(bins)
+	if len(channels) == 0 {
+		channels = []string{"-"}
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "START\tSTATUS\tSYNC-ERR-SEC\tCH\tSD-FEC-BER(MIN/AVG/MAX)\tHD-FEC-BER(MIN/AVG/MAX)\tPOST-FEC-BER(MIN/AVG/MAX)")
+	for _, b := range bins {
+		for _, ch := range channels {
+			cells := []string{b.Start.Local().Format("2006-01-02 15:04"), pmStatus(b, now), strconv.Itoa(b.SyncErrorSeconds), ch}
+			for _, name := range sonic.PM_BER_NAMES {
+				cells = append(cells, strings.Join(pmStatsCells(b.Channels[ch][name], "-"), "/"))
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+	}
+	return tw.Flush()
+}
+
+func NewPMCmd() *cobra.Command {
+	var bin string
+	var csvFormat bool
+	showCmd := &cobra.Command{
+		Use:   "show <optical-module>",
+		Short: "show the PM bins of the optical module collected by the gnmi server",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if _, err := platform.Current().OpticalModule(name); err != nil {
+				return err
+			}
+			bins, err := sonic.LoadPMBins(pmDir(), name, bin)
+			if err != nil {
+				return err
+			}
+			if csvFormat {
+				return writePMCSV(os.Stdout, bins, time.Now())
+			}
+			return writePMTable(os.Stdout, bins, time.Now())
+		},
+	}
+	showCmd.Flags().StringVarP(&bin, "bin", "b", sonic.PM_BIN_15M, fmt.Sprintf("bin type [%s]", strings.Join(sonic.PM_BIN_TYPES, "|")))
+	showCmd.Flags().BoolVarP(&csvFormat, "csv", "", false, "export the bins in CSV including RMS")
+
+	pmCmd := &cobra.Command{
+		Use:   "pm",
+		Short: "performance monitoring",
+	}
+	pmCmd.AddCommand(showCmd)
+	return pmCmd
+}
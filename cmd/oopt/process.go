@@ -0,0 +1,282 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	PROCESS_STATE_DIR    = "deploy"
+	PROCESS_KILL_TIMEOUT = 10 * time.Second
+)
+
+// processBackend runs the containers of each component as processes on the host.
+// images are ignored. the pid and the log of each container are kept in
+// <dir>/<component>/ and the config files are written to ConfigPath of the component
+type processBackend struct {
+	dir string
+}
+
+func (b *processBackend) componentDir(c *Component) string {
+	return filepath.Join(b.dir, c.Name)
+}
+
+func (b *processBackend) pidFile(c *Component, container Container) string {
+	return filepath.Join(b.componentDir(c), fmt.Sprintf("%s.pid", container.Name))
+}
+
+func (b *processBackend) logFile(c *Component, container Container) string {
+	return filepath.Join(b.componentDir(c), fmt.Sprintf("%s.log", container.Name))
+}
+
+func (b *processBackend) SetConfig(c *Component, files map[string]string) error {
+	if c.ConfigPath == "" {
+		return fmt.Errorf("component %s doesn't take config files", c.Name)
+	}
+	if err := os.MkdirAll(c.ConfigPath, 0755); err != nil {
+		return err
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(c.ConfigPath, name), []byte(data), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *processBackend) command(c *Component, container Container) (*exec.Cmd, *os.File, error) {
+	if len(container.Command) == 0 {
+		return nil, nil, fmt.Errorf("no command for container %s of %s", container.Name, c.Name)
+	}
+	log, err := os.OpenFile(b.logFile(c, container), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+	cmd := exec.Command(container.Command[0], container.Command[1:]...)
+	cmd.Stdout = log
+	cmd.Stderr = log
+	return cmd, log, nil
+}
+
+func (b *processBackend) Start(c *Component) error {
+	if err := os.MkdirAll(b.componentDir(c), 0755); err != nil {
+		return err
+	}
+	for _, v := range c.InitContainers {
+		cmd, log, err := b.command(c, v)
+		if err != nil {
+			return err
+		}
+		err = cmd.Run()
+		log.Close()
+		if err != nil {
+			return fmt.Errorf("init container %s of %s failed: %v, see %s", v.Name, c.Name, err, b.logFile(c, v))
+		}
+	}
+	for _, v := range c.Containers {
+		cmd, log, err := b.command(c, v)
+		if err != nil {
+			return err
+		}
+		// detach from oopt so that the process keeps running after oopt exits
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+		err = cmd.Start()
+		log.Close()
+		if err != nil {
+			return fmt.Errorf("failed to start container %s of %s: %v", v.Name, c.Name, err)
+		}
+		// the start time is read before the process is reaped so that
+		// a process exited right away is still recorded
+		start, err := processStartTime(cmd.Process.Pid)
+		// reap the process if it exits while oopt is running
+		go cmd.Wait()
+		if err != nil {
+			return fmt.Errorf("failed to get the start time of container %s of %s: %v", v.Name, c.Name, err)
+		}
+		p := process{pid: cmd.Process.Pid, start: start}
+		if err = ioutil.WriteFile(b.pidFile(c, v), []byte(p.String()), 0644); err != nil {
+			return err
+		}
+	}
+	deadline := time.Now().Add(POD_READY_TIMEOUT)
+	for {
+		status, err := b.Status(c)
+		if err != nil {
+			return err
+		}
+		if status.Ready {
+			return nil
+		}
+		if status.State == "Failed" {
+			return fmt.Errorf("component %s failed: %s", c.Name, status.Reason)
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for component %s to be ready: %s", c.Name, status.Reason)
+		}
+		time.Sleep(podPollInterval)
+	}
+}
+
+// process identifies a process by the pid and the start time
+// so that a pid reused after the process exited isn't taken for it
+type process struct {
+	pid int
+	// start is the start time in clock ticks after the boot from /proc/<pid>/stat
+	start uint64
+}
+
+func (p process) String() string {
+	return fmt.Sprintf("%d %d", p.pid, p.start)
+}
+
+// processStat returns the state and the start time of the process.
+// it works for a zombie which isn't reaped yet
+func processStat(pid int) (string, uint64, error) {
+	data, err := ioutil.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return "", 0, err
+	}
+	// the command name in parentheses may have spaces
+	i := strings.LastIndexByte(string(data), ')')
+	if i < 0 {
+		return "", 0, fmt.Errorf("invalid stat of %d", pid)
+	}
+	// the fields after the command name start from the state which is the 3rd field.
+	// starttime is the 22nd field
+	fields := strings.Fields(string(data[i+1:]))
+	if len(fields) < 20 {
+		return "", 0, fmt.Errorf("invalid stat of %d", pid)
+	}
+	start, err := strconv.ParseUint(fields[19], 10, 64)
+	return fields[0], start, err
+}
+
+func processStartTime(pid int) (uint64, error) {
+	_, start, err := processStat(pid)
+	return start, err
+}
+
+// alive returns false when the process exited or the pid is reused by another process
+func (p process) alive() bool {
+	if p.pid <= 0 {
+		return false
+	}
+	// EPERM means the process exists but belongs to another user
+	if err := syscall.Kill(p.pid, 0); err != nil && err != syscall.EPERM {
+		return false
+	}
+	state, start, err := processStat(p.pid)
+	return err == nil && state != "Z" && start == p.start
+}
+
+// process returns nil when the container isn't started.
+// a pid file without the start time is taken as stale
+func (b *processBackend) process(c *Component, container Container) (*process, error) {
+	data, err := ioutil.ReadFile(b.pidFile(c, container))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	p := &process{}
+	if _, err = fmt.Sscanf(string(data), "%d %d", &p.pid, &p.start); err != nil {
+		return &process{}, nil
+	}
+	return p, nil
+}
+
+// waitExit returns false when the process is still alive after the timeout
+func (p process) waitExit(timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for p.alive() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(podPollInterval)
+	}
+	return true
+}
+
+func (b *processBackend) Stop(c *Component) error {
+	for _, v := range c.Containers {
+		p, err := b.process(c, v)
+		if err != nil {
+			return err
+		}
+		if p == nil {
+			continue
+		}
+		// the pid file is just removed when it is stale.
+		// otherwise the process is the leader of its own process group
+		if p.alive() {
+			syscall.Kill(-p.pid, syscall.SIGTERM)
+			if !p.waitExit(POD_DELETE_TIMEOUT) {
+				syscall.Kill(-p.pid, syscall.SIGKILL)
+				if !p.waitExit(PROCESS_KILL_TIMEOUT) {
+					return fmt.Errorf("container %s of %s (pid %d) doesn't exit after SIGKILL", v.Name, c.Name, p.pid)
+				}
+			}
+		}
+		if err = os.Remove(b.pidFile(c, v)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *processBackend) Status(c *Component) (*ComponentStatus, error) {
+	status := &ComponentStatus{State: "NotFound"}
+	running := 0
+	for _, v := range c.Containers {
+		p, err := b.process(c, v)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			continue
+		}
+		if !p.alive() {
+			status.State = "Failed"
+			status.Reason = fmt.Sprintf("%s exited, see %s", v.Name, b.logFile(c, v))
+			return status, nil
+		}
+		running++
+	}
+	if running == 0 {
+		return status, nil
+	}
+	if running < len(c.Containers) {
+		status.State = "Pending"
+		return status, nil
+	}
+	status.State = "Running"
+	for _, v := range c.Containers {
+		if len(v.Probe) == 0 {
+			continue
+		}
+		if err := exec.Command(v.Probe[0], v.Probe[1:]...).Run(); err != nil {
+			status.Reason = fmt.Sprintf("%s is not ready: %v", v.Name, err)
+			return status, nil
+		}
+	}
+	status.Ready = true
+	return status, nil
+}
+
+func (b *processBackend) Exec(c *Component, command ...string) ([]byte, error) {
+	if len(command) == 0 {
+		return nil, fmt.Errorf("no command")
+	}
+	output, err := exec.Command(command[0], command[1:]...).CombinedOutput()
+	if err != nil {
+		return output, fmt.Errorf("'%s' failed: %v: %s", strings.Join(command, " "), err, strings.TrimSpace(string(output)))
+	}
+	return output, nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestProcessBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oopt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	orig := podPollInterval
+	podPollInterval = 10 * time.Millisecond
+	defer func() { podPollInterval = orig }()
+
+	b := &processBackend{dir: filepath.Join(dir, PROCESS_STATE_DIR)}
+	c := &Component{
+		Name:           "test",
+		ConfigPath:     filepath.Join(dir, "config"),
+		InitContainers: []Container{{Name: "init", Command: []string{"true"}}},
+		Containers: []Container{
+			{Name: "daemon", Command: []string{"sleep", "60"}, Probe: []string{"test", "-f", filepath.Join(dir, "config", "daemon.conf")}},
+		},
+	}
+	if err = b.SetConfig(c, map[string]string{"daemon.conf": "config"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Start(c); err != nil {
+		t.Fatal(err)
+	}
+	status, err := b.Status(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != "Running" || !status.Ready {
+		t.Errorf("unexpected status: %+v", status)
+	}
+	if err = b.Stop(c); err != nil {
+		t.Fatal(err)
+	}
+	status, err = b.Status(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != "NotFound" {
+		t.Errorf("unexpected status after stop: %+v", status)
+	}
+}
+
+func TestProcessBackendInitFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oopt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b := &processBackend{dir: dir}
+	c := &Component{
+		Name:           "test",
+		InitContainers: []Container{{Name: "init", Command: []string{"false"}}},
+		Containers:     []Container{{Name: "daemon", Command: []string{"sleep", "60"}}},
+	}
+	if err = b.Start(c); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestProcessBackendStalePid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "oopt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b := &processBackend{dir: dir}
+	c := &Component{
+		Name:       "test",
+		Containers: []Container{{Name: "daemon", Command: []string{"sleep", "60"}}},
+	}
+	if err = os.MkdirAll(b.componentDir(c), 0755); err != nil {
+		t.Fatal(err)
+	}
+	start, err := processStartTime(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the pid of the daemon is reused by the test
+	for _, data := range []string{fmt.Sprintf("%d %d", os.Getpid(), start+1), strconv.Itoa(os.Getpid())} {
+		if err = ioutil.WriteFile(b.pidFile(c, c.Containers[0]), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+		status, err := b.Status(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.State != "Failed" {
+			t.Errorf("%s: unexpected status: %+v", data, status)
+		}
+		// the test process is killed if the stale pid is signalled
+		if err = b.Stop(c); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = os.Stat(b.pidFile(c, c.Containers[0])); !os.IsNotExist(err) {
+			t.Errorf("%s: the stale pid file is not removed", data)
+		}
+	}
+
+	if !(process{pid: os.Getpid(), start: start}).alive() {
+		t.Error("the test process is not alive")
+	}
+	// pid 1 is alive even when it can't be signalled
+	if start, err = processStartTime(1); err == nil && !(process{pid: 1, start: start}).alive() {
+		t.Error("pid 1 is not alive")
+	}
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/pflag"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+const (
+	DEFAULT_GATE_TIMEOUT = 5 * time.Minute
+)
+
+var gatePollInterval = 2 * time.Second
+
+// options of the commands which reboot the system
+var (
+	// 0 disables the health gates
+	gateTimeout time.Duration
+	// re-apply the previous commit when bring-up fails after a commit
+	autoRollback bool
+)
+
+func addRebootFlags(flags *pflag.FlagSet, rollback bool) {
+	flags.DurationVarP(&gateTimeout, "gate-timeout", "", DEFAULT_GATE_TIMEOUT, "time to wait for the system to become healthy after each reboot stage, 0 to skip the health checks")
+	if rollback {
+		flags.BoolVarP(&autoRollback, "auto-rollback", "", false, "re-apply the previous commit when bring-up fails")
+	}
+}
+
+// rebootStage is a step of the bring-up.
+// gate waits until the system is ready for the next stage, nil when there is nothing to wait for
+type rebootStage struct {
+	name string
+	run  func() error
+	gate *healthGate
+}
+
+// healthGate polls check until it returns true.
+// errors returned by check are regarded as not ready yet until the timeout
+type healthGate struct {
+	name  string
+	check func() (bool, string, error)
+}
+
+func (g *healthGate) wait(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		ok, state, err := g.check()
+		if err == nil && ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			if err != nil {
+				return fmt.Errorf("timed out waiting for %s: %v", g.name, err)
+			}
+			return fmt.Errorf("timed out waiting for %s: %s", g.name, state)
+		}
+		time.Sleep(gatePollInterval)
+	}
+}
+
+func runStages(stages []rebootStage, timeout time.Duration) error {
+	start := time.Now()
+	for i, s := range stages {
+		step := fmt.Sprintf("[%d/%d] %s", i+1, len(stages), s.name)
+		log.Printf("%s: started", step)
+		t := time.Now()
+		if err := s.run(); err != nil {
+			log.Printf("%s: failed after %s", step, time.Since(t).Round(time.Millisecond))
+			return fmt.Errorf("%s failed: %v", s.name, err)
+		}
+		if s.gate != nil && timeout > 0 {
+			log.Printf("%s: waiting for %s", step, s.gate.name)
+			if err := s.gate.wait(timeout); err != nil {
+				log.Printf("%s: failed after %s", step, time.Since(t).Round(time.Millisecond))
+				return fmt.Errorf("%s failed: %v", s.name, err)
+			}
+		}
+		log.Printf("%s: done in %s", step, time.Since(t).Round(time.Millisecond))
+	}
+	log.Printf("bring-up completed in %s", time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+var redisGate = &healthGate{
+	name: "redis",
+	check: func() (bool, string, error) {
+		// the gate polls, so a single check doesn't wait longer than the interval
+		ctx, cancel := context.WithTimeout(context.Background(), gatePollInterval)
+		defer cancel()
+		if _, err := sonic.GetClientContext(ctx, sonic.CONFIG_DB); err != nil {
+			return false, "", err
+		}
+		return true, "PONG", nil
+	},
+}
+
+func opticalModuleBooting(s model.E_PacketTransport_OpticalModuleStatusType) bool {
+	switch s {
+	case model.PacketTransport_OpticalModuleStatusType_UNSET,
+		model.PacketTransport_OpticalModuleStatusType_STATE_BOOTING_TOP_HALF,
+		model.PacketTransport_OpticalModuleStatusType_STATE_BOOTING_BOTTOM_HALF:
+		return true
+	}
+	return false
+}
+
+// opticalModulesGate waits until all enabled optical modules finish booting
+func opticalModulesGate(config *model.PacketTransponder) *healthGate {
+	return &healthGate{
+		name: "optical modules to boot",
+		check: func() (bool, string, error) {
+			booting := []string{}
+			for name, m := range config.OpticalModule {
+				if m.Enabled != nil && !*m.Enabled {
+					continue
+				}
+				o, err := ygot.DeepCopy(m)
+				if err != nil {
+					return false, "", err
+				}
+				module := o.(*model.PacketTransponder_OpticalModule)
+				if err = sonic.FillTransportState(name, module); err != nil {
+					return false, "", err
+				}
+				if opticalModuleBooting(module.OperationStatus) {
+					booting = append(booting, fmt.Sprintf("%s=%s", name, enumName(module.OperationStatus)))
+				}
+			}
+			sort.Strings(booting)
+			return len(booting) == 0, strings.Join(booting, " "), nil
+		},
+	}
+}
+
+// portsGate waits until the enabled interfaces connected to an optical module are up
+func portsGate(config *model.PacketTransponder) *healthGate {
+	return &healthGate{
+		name: "ports to be up",
+		check: func() (bool, string, error) {
+			down := []string{}
+			for name, i := range config.Interface {
+				if i.OpticalModuleConnection == nil || (i.Enabled != nil && !*i.Enabled) {
+					continue
+				}
+				intf := &model.PacketTransponder_Interface{}
+				if err := sonic.FillInterfaceState(name, intf); err != nil {
+					return false, "", err
+				}
+				if intf.OperStatus != model.OpenconfigInterfaces_Interface_OperStatus_UP {
+					down = append(down, fmt.Sprintf("%s=%s", name, enumName(intf.OperStatus)))
+				}
+			}
+			sort.Strings(down)
+			return len(down) == 0, strings.Join(down, " "), nil
+		},
+	}
+}
+
+func rebootStages(config *model.PacketTransponder) []rebootStage {
+	stages := []rebootStage{
+		{
+			name: "restart redis",
+			run:  RestartRedis,
+			gate: redisGate,
+		},
+		{
+			name: "configure transport",
+			run: func() error {
+				return sonic.ConfigureTransport(config)
+			},
+		},
+		{
+			name: "restart transyncd",
+			run:  RestartTransyncd,
+			gate: opticalModulesGate(config),
+		},
+	}
+	if !virtual {
+		stages = append(stages, rebootStage{
+			name: "restart ofdpa",
+			run: func() error {
+				ofdpa, err := NewOFDPAConfigFromModel(config)
+				if err != nil {
+					return err
+				}
+				return RestartOFDPA(ofdpa)
+			},
+		})
+	}
+	var sonicConfig *SONiCConfig
+	return append(stages,
+		rebootStage{
+			name: "write CONFIG_DB",
+			run: func() error {
+				var err error
+				sonicConfig, err = NewSONiCConfigFromModel(config)
+				if err != nil {
+					return err
+				}
+				return sonicConfig.WriteToConfigDB()
+			},
+		},
+		rebootStage{
+			name: "restart sonic",
+			run: func() error {
+				bytes, err := json.Marshal(sonicConfig)
+				if err != nil {
+					return err
+				}
+				return RestartSONiC(string(bytes))
+			},
+			gate: portsGate(config),
+		},
+	)
+}
+
+func rebootSystem(config *model.PacketTransponder) error {
+	if dry {
+		return nil
+	}
+	if err := runStages(rebootStages(config), gateTimeout); err != nil {
+		return err
+	}
+	return recordAppliedCommit()
+}
+
+// rollbackOnFailure commits and applies the previous configuration
+// when bring-up of the new one failed and --auto-rollback is given
+func rollbackOnFailure(previous *model.PacketTransponder, cause error) error {
+	if cause == nil || !autoRollback {
+		return cause
+	}
+	log.Printf("bring-up failed: %v", cause)
+	log.Println("rolling back to the previous configuration")
+	current = previous
+	if err := persistentPostRunE(nil, nil); err != nil {
+		return fmt.Errorf("%v, rollback failed: %v", cause, err)
+	}
+	// don't roll back the rollback
+	autoRollback = false
+	if err := commit(fmt.Sprintf("auto rollback %s", time.Now()), true); err != nil {
+		return fmt.Errorf("%v, rollback failed: %v", cause, err)
+	}
+	return fmt.Errorf("%v, the previous configuration was restored", cause)
+}
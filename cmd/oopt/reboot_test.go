@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunStages(t *testing.T) {
+	orig := gatePollInterval
+	gatePollInterval = time.Millisecond
+	defer func() { gatePollInterval = orig }()
+
+	ran := []string{}
+	polls := 0
+	stage := func(name string) func() error {
+		return func() error {
+			ran = append(ran, name)
+			return nil
+		}
+	}
+	gate := &healthGate{
+		name: "first",
+		check: func() (bool, string, error) {
+			polls++
+			if polls < 3 {
+				return false, "booting", fmt.Errorf("not yet")
+			}
+			return true, "ready", nil
+		},
+	}
+	err := runStages([]rebootStage{
+		{name: "first", run: stage("first"), gate: gate},
+		{name: "second", run: stage("second")},
+	}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(ran, ",") != "first,second" || polls != 3 {
+		t.Errorf("unexpected stages %v, polls %d", ran, polls)
+	}
+}
+
+func TestRunStagesFailure(t *testing.T) {
+	orig := gatePollInterval
+	gatePollInterval = time.Millisecond
+	defer func() { gatePollInterval = orig }()
+
+	ran := []string{}
+	err := runStages([]rebootStage{
+		{
+			name: "first",
+			run:  func() error { ran = append(ran, "first"); return nil },
+			gate: &healthGate{
+				name: "ports to be up",
+				check: func() (bool, string, error) {
+					return false, "Ethernet17=DOWN", nil
+				},
+			},
+		},
+		{name: "second", run: func() error { ran = append(ran, "second"); return nil }},
+	}, 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "Ethernet17=DOWN") {
+		t.Errorf("gate state is not in the error: %v", err)
+	}
+	if len(ran) != 1 {
+		t.Errorf("stages after the failure ran: %v", ran)
+	}
+
+	// gates are skipped when the timeout is 0
+	ran = ran[:0]
+	err = runStages([]rebootStage{
+		{
+			name: "first",
+			run:  func() error { ran = append(ran, "first"); return nil },
+			gate: &healthGate{
+				name:  "never",
+				check: func() (bool, string, error) { return false, "", nil },
+			},
+		},
+	}, 0)
+	if err != nil || len(ran) != 1 {
+		t.Errorf("unexpected result %v, %v", err, ran)
+	}
+}
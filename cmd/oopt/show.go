@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+func eventHistoryPath() string {
+	return filepath.Join(viper.GetString("state_dir"), sonic.EVENT_HISTORY_FILE)
+}
+
+func writeEvents(w io.Writer, events []sonic.Event) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tMODULE\tOP\tFIELDS")
+	for _, e := range events {
+		fields := make([]string, 0, len(e.Fields))
+		for k, v := range e.Fields {
+			fields = append(fields, fmt.Sprintf("%s=%s", k, v))
+		}
+		sort.Strings(fields)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", e.Time.Local().Format(time.RFC3339), e.Module, e.Op, strings.Join(fields, " "))
+	}
+	return tw.Flush()
+}
+
+func NewShowEventsCmd() *cobra.Command {
+	var module string
+	var since time.Duration
+	var size int
+	eventsCmd := &cobra.Command{
+		Use:   "events",
+		Short: "show the notifications from transyncd kept by the gnmi server",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			events, err := sonic.LoadEvents(eventHistoryPath(), size)
+			if err != nil {
+				return err
+			}
+			f := sonic.EventFilter{Module: module}
+			if since > 0 {
+				f.Since = time.Now().Add(-since)
+			}
+			selected := []sonic.Event{}
+			for _, e := range events {
+				if f.Match(e) {
+					selected = append(selected, e)
+				}
+			}
+			return writeEvents(os.Stdout, selected)
+		},
+	}
+	eventsCmd.Flags().StringVarP(&module, "module", "m", "", "show only the events of the optical module")
+	eventsCmd.Flags().DurationVarP(&since, "since", "s", 0, "show only the events in the duration, e.g. 1h")
+	// the file keeps up to twice the events until the gnmi server compacts it
+	eventsCmd.Flags().IntVarP(&size, "size", "n", sonic.DEFAULT_EVENT_HISTORY_SIZE, "number of the latest events to show. set it to -event-history-size of the gnmi server")
+	return eventsCmd
+}
+
+func NewShowCmd() *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Short: "show the runtime state",
+	}
+	showCmd.AddCommand(NewShowEventsCmd())
+	return showCmd
+}
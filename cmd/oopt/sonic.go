@@ -2,194 +2,28 @@ package main
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
+	"sort"
 	"strconv"
 	"strings"
-	"text/template"
-
-	"github.com/spf13/viper"
 
 	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
 	"github.com/osrg/oopt/pkg/sonic"
 )
 
 const (
-	REDIS_POD_NAME        = "redis"
-	TRANSYNCD_POD_NAME    = "transyncd"
-	SONIC_POD_NAME        = "sonic"
-	SONIC_CONFIG_MAP_NAME = "sonic-config"
-	REDIS_DIR             = "/var/run/redis"
+	REDIS_POD_NAME     = "redis"
+	TRANSYNCD_POD_NAME = "transyncd"
+	SONIC_POD_NAME     = "sonic"
+	REDIS_DIR          = "/var/run/redis"
 )
 
 const (
 	VLAN_TABLE            = "VLAN"
 	VLAN_MEMBER_TABLE     = "VLAN_MEMBER"
-	PORT_TABLE            = "PORT_TABLE"
+	PORT_TABLE            = "PORT"
 	DEVICE_METADATA_TABLE = "DEVICE_METADATA"
-)
-
-const (
-	REDIS_K8S_POD_CONFIG_NAME    = "redis.yml"
-	REDIS_K8S_POD_DEFAULT_CONFIG = `apiVersion: v1
-kind: Pod
-metadata:
-  name: redis
-spec:
-  hostNetwork: true
-  volumes:
-  - name: redis
-    hostPath:
-      path: /var/run/redis
-  containers:
-  - name: redis
-    image: redis
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    securityContext:
-      privileged: true
-    command: ['redis-server', '/usr/local/etc/redis/redis.conf']
-`
-	SONIC_K8S_POD_CONFIG_NAME     = "sonic.yml"
-	SONIC_K8S_POD_CONFIG_TEMPLATE = `apiVersion: v1
-kind: Pod
-metadata:
-  name: {{ .Name }}
-spec:
-  volumes:
-  - name: redis
-    hostPath:
-      path: /var/run/redis
-  - name: tmp
-    hostPath:
-      path: /tmp
-  - name: {{ .SonicConfigMapName }}
-    configMap:
-      name: {{ .SonicConfigMapName }}
-  initContainers:
-  - name: init-loglevel
-    image: redis
-    imagePullPolicy: Never
-    command: ['sh', '-c', 'for daemon in syncd:syncd intfmgrd:intfmgrd intfsyncd:intfsyncd orchagent:orchagent portsyncd:portsyncd neighsyncd:neighsyncd vlanmgrd:vlanmgrd;
-do
-  redis-cli -n 3 -s /var/run/redis/redis.sock hset $daemon LOGOUTPUT STDERR;
-done']
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-  - name: init-configdb
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    command: ['sonic-cfggen', '-s', '/var/run/redis/redis.sock', '-j', '/root/config/config_db.json', '--write-to-db']
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    - mountPath: /root/config
-      name: sonic-config
-  - name: init-configdb-done
-    image: redis
-    imagePullPolicy: Never
-    command: ['redis-cli', '-s', '/var/run/redis/redis.sock', '-n', '4', 'SET', 'CONFIG_DB_INITIALIZED', '1']
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-  containers:
-  - name: syncd
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    - mountPath: /tmp
-      name: tmp
-    securityContext:
-      privileged: true
-    command:
-    - syncd
-  - name: orchagent
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    securityContext:
-      privileged: true
-    command: ['sh', '-c', 'sleep 10 && platform=mellanox orchagent']
-  - name: portsyncd
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    securityContext:
-      privileged: true
-    command: ['sh', '-c', 'sleep 13 && portsyncd']
-  - name: vlanmgrd
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    securityContext:
-      privileged: true
-    command: ['sh', '-c', 'mount -o remount,rw /sys && sleep 15 && vlanmgrd']
-  - name: intfmgrd
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    securityContext:
-      privileged: true
-    command: ['sh', '-c', 'sleep 15 && intfmgrd']
-  - name: intfsyncd
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    securityContext:
-      privileged: true
-    command: ['sh', '-c', 'sleep 15 && intfsyncd']
-  - name: neighsyncd
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    securityContext:
-      privileged: true
-    command: ['sh', '-c', 'sleep 15 && neighsyncd']
-`
-	TRANSYNCD_K8S_POD_CONFIG_NAME     = "transyncd.yml"
-	TRANSYNCD_K8S_POD_CONFIG_TEMPLATE = `apiVersion: v1
-kind: Pod
-metadata:
-  name: {{ .Name }}
-spec:
-  volumes:
-  - name: redis
-    hostPath:
-      path: /var/run/redis
-  - name: tai
-    hostPath:
-      path: /etc/tai/
-  containers:
-  - name: transyncd
-    image: {{ .Image }}
-    imagePullPolicy: Never
-    volumeMounts:
-    - mountPath: /var/run/redis/
-      name: redis
-    - mountPath: /etc/tai/
-      name: tai
-    securityContext:
-      privileged: true
-    command:
-    - transyncd
-`
+	MGMT_INTERFACE_TABLE  = "MGMT_INTERFACE"
 )
 
 type DeviceMetadata struct {
@@ -210,124 +44,249 @@ func (d DeviceMetadata) ToMap() map[string]interface{} {
 	}
 }
 
-var deviceMetadata = map[string]DeviceMetadata{
-	// TODO make this configurable
-	"localhost": DeviceMetadata{
-		BGPAsn:   65100,
-		Hostname: "cassini",
-		Type:     "packet-transponder",
-		HWSKU:    "AS7716-24XC",
-		Mac:      "a8:2b:b5:b5:51:d5",
-	},
+func newDeviceMetadata(s *model.PacketTransponder_System) (map[string]DeviceMetadata, error) {
+	hostname, err := systemHostname(s)
+	if err != nil {
+		return nil, err
+	}
+	var mac string
+	if s != nil && s.MacAddress != nil {
+		mac = *s.MacAddress
+	} else if mac, err = defaultMacAddress(); err != nil {
+		return nil, err
+	}
+	asn := DEFAULT_BGP_ASN
+	if s != nil && s.BgpAsn != nil {
+		asn = int(*s.BgpAsn)
+	}
+	return map[string]DeviceMetadata{
+		"localhost": DeviceMetadata{
+			BGPAsn:   asn,
+			Hostname: hostname,
+			Type:     DEVICE_TYPE,
+			HWSKU:    platform.Current().HWSKU,
+			Mac:      mac,
+		},
+	}, nil
 }
 
-type Port struct {
-	Lanes int `json:"lanes"`
+type MgmtInterface struct {
+	GwAddr string `json:"gwaddr,omitempty"`
 }
 
-func (p Port) ToMap() map[string]interface{} {
-	return map[string]interface{}{
-		"lanes": p.Lanes,
+func (i MgmtInterface) ToMap() map[string]interface{} {
+	m := map[string]interface{}{}
+	if i.GwAddr != "" {
+		m["gwaddr"] = i.GwAddr
 	}
+	return m
 }
 
-type Vlan struct {
-	Members []string `json:"members"`
-	VID     int      `json:"vlanid"`
-}
-
-func (v Vlan) ToMap() map[string]interface{} {
-	return map[string]interface{}{
-		"members": v.Members,
-		"vlanid":  v.VID,
+// newMgmtInterface returns MGMT_INTERFACE entries keyed by "<interface>|<address>"
+func newMgmtInterface(s *model.PacketTransponder_System) map[string]MgmtInterface {
+	m := map[string]MgmtInterface{}
+	if s == nil || s.ManagementAddress == nil {
+		return m
+	}
+	i := MgmtInterface{}
+	if s.ManagementGateway != nil {
+		i.GwAddr = *s.ManagementGateway
 	}
+	m[fmt.Sprintf("%s|%s", MGMT_INTERFACE_NAME, *s.ManagementAddress)] = i
+	return m
 }
 
-type VlanMember struct {
-	Mode string `json:"tagging_mode"`
-}
+type Port = sonic.PortEntry
 
-func (v VlanMember) ToMap() map[string]interface{} {
-	return map[string]interface{}{
-		"tagging_mode": v.Mode,
-	}
-}
+type Vlan = sonic.VlanEntry
+
+type VlanMember = sonic.VlanMemberEntry
 
 type SONiCConfig struct {
 	DeviceMetadata map[string]DeviceMetadata `json:"DEVICE_METADATA"`
+	MgmtInterface  map[string]MgmtInterface  `json:"MGMT_INTERFACE"`
 	Ports          map[string]Port           `json:"PORT"`
 	Vlans          map[string]Vlan           `json:"VLAN"`
 	VlanMembers    map[string]VlanMember     `json:"VLAN_MEMBER"`
 }
 
+// WriteToConfigDB replaces the entries of the tables at once
 func (c *SONiCConfig) WriteToConfigDB() error {
-	client, err := sonic.NewSONiCDBClient("unix", sonic.DEFAULT_REDIS_UNIX_SOCKET, sonic.CONFIG_DB)
+	client, err := sonic.GetClient(sonic.CONFIG_DB)
 	if err != nil {
 		return err
 	}
+	b := client.Batch()
 	for k, v := range c.DeviceMetadata {
-		err = client.SetEntry(DEVICE_METADATA_TABLE, k, v.ToMap())
-		if err != nil {
+		if err = b.SetEntry(DEVICE_METADATA_TABLE, k, v.ToMap()); err != nil {
+			return err
+		}
+	}
+	for k, v := range c.MgmtInterface {
+		if err = b.SetEntry(MGMT_INTERFACE_TABLE, k, v.ToMap()); err != nil {
 			return err
 		}
 	}
 	for k, v := range c.Ports {
-		client.SetEntry(PORT_TABLE, k, v.ToMap())
-		if err != nil {
+		if err = b.StoreEntry(PORT_TABLE, k, v); err != nil {
 			return err
 		}
 	}
 	for k, v := range c.Vlans {
-		client.SetEntry(VLAN_TABLE, k, v.ToMap())
-		if err != nil {
+		if err = b.StoreEntry(VLAN_TABLE, k, v); err != nil {
 			return err
 		}
 	}
 	for k, v := range c.VlanMembers {
-		client.SetEntry(VLAN_MEMBER_TABLE, k, v.ToMap())
+		if err = b.StoreEntry(VLAN_MEMBER_TABLE, k, v); err != nil {
+			return err
+		}
+	}
+	return b.Commit()
+}
+
+// portLanes returns the SONiC lanes of the interface
+func portLanes(port *platform.Port, subIndex, numChannels int) (string, error) {
+	lanes, err := port.Lanes(subIndex, numChannels)
+	if err != nil {
+		return "", err
+	}
+	ss := make([]string, 0, len(lanes))
+	for _, l := range lanes {
+		ss = append(ss, strconv.Itoa(l))
+	}
+	return strings.Join(ss, ","), nil
+}
+
+func newSONiCPort(m *model.PacketTransponder, name string) (Port, error) {
+	p, subIndex, err := platform.Current().PortOf(name)
+	if err != nil {
+		return Port{}, err
+	}
+
+	c := sonic.PortConfigEntry(m.Interface[name])
+	port := Port{
+		Speed:       c["speed"],
+		AdminStatus: c["admin_status"],
+		MTU:         c["mtu"],
+		Autoneg:     c["autoneg"],
+		Description: c["description"],
+	}
+
+	numChannels := 1
+	if v, ok := m.Port[p.Name]; ok && v.BreakoutMode != nil && v.BreakoutMode.NumChannels != nil {
+		numChannels = int(*v.BreakoutMode.NumChannels)
+	}
+	port.Lanes, err = portLanes(p, subIndex, numChannels)
+	if err != nil {
+		return Port{}, err
+	}
+	return port, nil
+}
+
+// portInterfaces returns the interfaces of the front panel port
+func portInterfaces(m *model.PacketTransponder, portName string) ([]string, error) {
+	names := []string{}
+	for k := range m.Interface {
+		p, _, err := platform.Current().PortOf(k)
+		if err != nil {
+			return nil, err
+		}
+		if p.Name == portName {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// reconfigureSONiCPort removes the PORT entries of the interfaces which belonged to
+// the port and creates the ones for the new breakout mode.
+// PORT entries of the other ports are left untouched
+func reconfigureSONiCPort(newConfig, oldConfig *model.PacketTransponder, portName string) error {
+	oldNames, err := portInterfaces(oldConfig, portName)
+	if err != nil {
+		return err
+	}
+	newNames, err := portInterfaces(newConfig, portName)
+	if err != nil {
+		return err
+	}
+	// speed changes without changing num-channels are applied by sonic.HandleInterfaceDiff
+	if strings.Join(oldNames, ",") == strings.Join(newNames, ",") {
+		return nil
+	}
+	client, err := sonic.GetClient(sonic.CONFIG_DB)
+	if err != nil {
+		return err
+	}
+	// orchagent must not see both the old and the new ports
+	b := client.Batch()
+	for _, name := range oldNames {
+		b.ModEntry(PORT_TABLE, name, nil)
+	}
+	for _, name := range newNames {
+		port, err := newSONiCPort(newConfig, name)
 		if err != nil {
 			return err
 		}
+		if err = b.StoreEntry(PORT_TABLE, name, port); err != nil {
+			return err
+		}
+	}
+	return b.Commit()
+}
+
+// updateSystemConfig writes DEVICE_METADATA and MGMT_INTERFACE
+// and removes the MGMT_INTERFACE entry of the old management address
+func updateSystemConfig(newConfig, oldConfig *model.PacketTransponder) error {
+	deviceMetadata, err := newDeviceMetadata(newConfig.System)
+	if err != nil {
+		return err
+	}
+	client, err := sonic.GetClient(sonic.CONFIG_DB)
+	if err != nil {
+		return err
+	}
+	b := client.Batch()
+	for k, v := range deviceMetadata {
+		if err = b.SetEntry(DEVICE_METADATA_TABLE, k, v.ToMap()); err != nil {
+			return err
+		}
+	}
+	mgmt := newMgmtInterface(newConfig.System)
+	for k := range newMgmtInterface(oldConfig.System) {
+		if _, ok := mgmt[k]; ok {
+			continue
+		}
+		b.ModEntry(MGMT_INTERFACE_TABLE, k, nil)
+	}
+	for k, v := range mgmt {
+		if err = b.SetEntry(MGMT_INTERFACE_TABLE, k, v.ToMap()); err != nil {
+			return err
+		}
 	}
-	return nil
+	return b.Commit()
 }
 
 func NewSONiCConfigFromModel(m *model.PacketTransponder) (*SONiCConfig, error) {
+	deviceMetadata, err := newDeviceMetadata(m.System)
+	if err != nil {
+		return nil, err
+	}
 	config := &SONiCConfig{
 		DeviceMetadata: deviceMetadata,
+		MgmtInterface:  newMgmtInterface(m.System),
 		Ports:          make(map[string]Port),
 		Vlans:          make(map[string]Vlan),
 		VlanMembers:    make(map[string]VlanMember),
 	}
 
 	for k, v := range m.Interface {
-		if !strings.HasPrefix(k, "Ethernet") {
-			return nil, fmt.Errorf("invalid interface name: %s", k)
-		}
-		elems := strings.Split(k[len("Ethernet"):], "_")
-		if len(elems) == 0 || len(elems) > 2 {
-			return nil, fmt.Errorf("invalid interface name: %s", k)
-		}
-		mainIndex, err := strconv.Atoi(elems[0])
+		port, err := newSONiCPort(m, k)
 		if err != nil {
 			return nil, err
 		}
-		var subIndex int
-		if len(elems) == 2 {
-			subIndex, err = strconv.Atoi(elems[1])
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		port := Port{}
-
-		if subIndex == 0 {
-			port.Lanes = mainIndex
-		} else {
-			port.Lanes = 32 + 4*(mainIndex-1) + subIndex
-		}
-
 		config.Ports[k] = port
 
 		if v.OpticalModuleConnection != nil {
@@ -366,106 +325,100 @@ func NewSONiCConfigFromModel(m *model.PacketTransponder) (*SONiCConfig, error) {
 		}
 	}
 	// ports for optical modules
-	for i := 16; i < 32; i++ {
-		name := fmt.Sprintf("Ethernet%d", i+1)
-		if _, ok := config.Ports[name]; ok {
-			return nil, fmt.Errorf("port %s already exists", name)
+	for _, module := range platform.Current().OpticalModules {
+		for _, ch := range module.Channels {
+			if _, ok := config.Ports[ch.Interface]; ok {
+				return nil, fmt.Errorf("port %s already exists", ch.Interface)
+			}
+			config.Ports[ch.Interface] = Port{Lanes: strconv.Itoa(ch.Lane)}
 		}
-		config.Ports[name] = Port{Lanes: i + 1}
 	}
 
 	return config, nil
 }
 
-func createSONiCPod(virtual bool) error {
-	name := fmt.Sprintf("%s/%s", viper.GetString("git_dir"), SONIC_K8S_POD_CONFIG_NAME)
-	if _, err := os.Stat(name); err != nil {
-		f, err := os.Create(name)
-		defer f.Close()
-		if err != nil {
-			return err
-		}
-		t := template.Must(template.New("sonic.yml.tmpl").Parse(SONIC_K8S_POD_CONFIG_TEMPLATE))
-		m := struct {
-			Name               string
-			Image              string
-			SonicConfigMapName string
-		}{}
-		m.Name = "sonic"
-		m.Image = "sonic"
-		if virtual {
-			m.Image = "sonic:virtual"
-		}
-		m.SonicConfigMapName = SONIC_CONFIG_MAP_NAME
-		if err = t.Execute(f, m); err != nil {
-			return err
-		}
+const SONIC_INIT_LOGLEVEL = `for daemon in syncd:syncd intfmgrd:intfmgrd intfsyncd:intfsyncd orchagent:orchagent portsyncd:portsyncd neighsyncd:neighsyncd vlanmgrd:vlanmgrd;
+do
+  redis-cli -n 3 -s /var/run/redis/redis.sock hset $daemon LOGOUTPUT STDERR;
+done`
+
+var redisVolume = Volume{Name: "redis", HostPath: REDIS_DIR, MountPath: REDIS_DIR + "/"}
+
+func redisComponent() *Component {
+	return &Component{
+		Name:        REDIS_POD_NAME,
+		Image:       "redis",
+		Privileged:  true,
+		HostNetwork: true,
+		Volumes:     []Volume{redisVolume},
+		Containers: []Container{
+			{
+				Name:    "redis",
+				Command: []string{"redis-server", "/usr/local/etc/redis/redis.conf"},
+				Probe:   []string{"redis-cli", "ping"},
+			},
+		},
 	}
-	cmd := exec.Command("kubectl", "create", "-f", name)
-	return cmd.Run()
 }
 
-func RestartSONiC(config string, virtual bool) error {
-	err := createConfigMap(SONIC_CONFIG_MAP_NAME, map[string]string{"config_db.json": config})
-	if err != nil {
-		return err
+func sonicComponent() *Component {
+	image := "sonic"
+	if virtual {
+		image = "sonic:virtual"
 	}
-	err = deletePod(SONIC_POD_NAME)
-	if err != nil {
-		return err
+	return &Component{
+		Name:       SONIC_POD_NAME,
+		Image:      image,
+		Privileged: true,
+		Volumes: []Volume{
+			redisVolume,
+			{Name: "tmp", HostPath: "/tmp", MountPath: "/tmp"},
+		},
+		ConfigPath: "/root/config",
+		InitContainers: []Container{
+			{Name: "init-loglevel", Image: "redis", Command: []string{"sh", "-c", SONIC_INIT_LOGLEVEL}},
+			{Name: "init-configdb", Command: []string{"sonic-cfggen", "-s", sonic.DEFAULT_REDIS_UNIX_SOCKET, "-j", "/root/config/config_db.json", "--write-to-db"}},
+			{Name: "init-configdb-done", Image: "redis", Command: []string{"redis-cli", "-s", sonic.DEFAULT_REDIS_UNIX_SOCKET, "-n", "4", "SET", CONFIG_DB_INITIALIZED_KEY, "1"}},
+		},
+		Containers: []Container{
+			{Name: "syncd", Command: []string{"syncd"}},
+			{Name: "orchagent", Command: []string{"sh", "-c", "sleep 10 && platform=mellanox orchagent"}},
+			{Name: "portsyncd", Command: []string{"sh", "-c", "sleep 13 && portsyncd"}},
+			{Name: "vlanmgrd", Command: []string{"sh", "-c", "mount -o remount,rw /sys && sleep 15 && vlanmgrd"}},
+			{Name: "intfmgrd", Command: []string{"sh", "-c", "sleep 15 && intfmgrd"}},
+			{Name: "intfsyncd", Command: []string{"sh", "-c", "sleep 15 && intfsyncd"}},
+			{Name: "neighsyncd", Command: []string{"sh", "-c", "sleep 15 && neighsyncd"}},
+		},
 	}
-	return createSONiCPod(virtual)
 }
 
-func RestartRedis() error {
-	if err := deletePod(REDIS_POD_NAME); err != nil {
-		return err
+func transyncdComponent() *Component {
+	image := "transyncd"
+	if virtual {
+		image = "transyncd:virtual"
 	}
-
-	name := fmt.Sprintf("%s/%s", viper.GetString("git_dir"), REDIS_K8S_POD_CONFIG_NAME)
-	if _, err := os.Stat(name); err != nil {
-		f, err := os.Create(name)
-		defer f.Close()
-		if err != nil {
-			return err
-		}
-		if _, err := f.Write([]byte(REDIS_K8S_POD_DEFAULT_CONFIG)); err != nil {
-			return err
-		}
+	return &Component{
+		Name:       TRANSYNCD_POD_NAME,
+		Image:      image,
+		Privileged: true,
+		Volumes: []Volume{
+			redisVolume,
+			{Name: "tai", HostPath: "/etc/tai/", MountPath: "/etc/tai/"},
+		},
+		Containers: []Container{
+			{Name: "transyncd", Command: []string{"transyncd"}},
+		},
 	}
-
-	cmd := exec.Command("kubectl", "create", "-f", name)
-	return cmd.Run()
 }
 
-func RestartTransyncd() error {
-	err := deletePod(TRANSYNCD_POD_NAME)
-	if err != nil {
-		return err
-	}
+func RestartSONiC(config string) error {
+	return restartComponent(SONIC_POD_NAME, map[string]string{"config_db.json": config})
+}
 
-	name := fmt.Sprintf("%s/%s", viper.GetString("git_dir"), TRANSYNCD_K8S_POD_CONFIG_NAME)
-	if _, err := os.Stat(name); err != nil {
-		f, err := os.Create(name)
-		defer f.Close()
-		if err != nil {
-			return err
-		}
-		t := template.Must(template.New("transyncd.yml.tmpl").Parse(TRANSYNCD_K8S_POD_CONFIG_TEMPLATE))
-		m := struct {
-			Name  string
-			Image string
-		}{}
-		m.Name = "transyncd"
-		m.Image = "transyncd"
-		if virtual {
-			m.Image = "transyncd:virtual"
-		}
-		if err = t.Execute(f, m); err != nil {
-			return err
-		}
-	}
+func RestartRedis() error {
+	return restartComponent(REDIS_POD_NAME, nil)
+}
 
-	cmd := exec.Command("kubectl", "create", "-f", name)
-	return cmd.Run()
+func RestartTransyncd() error {
+	return restartComponent(TRANSYNCD_POD_NAME, nil)
 }
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/osrg/oopt/pkg/platform"
+)
+
+func TestPortLanes(t *testing.T) {
+	for _, c := range []struct {
+		mainIndex   int
+		subIndex    int
+		numChannels int
+		lanes       string
+	}{
+		{1, 0, 1, "1"},
+		{16, 0, 1, "16"},
+		{1, 1, 4, "33"},
+		{1, 4, 4, "36"},
+		{2, 1, 2, "37,38"},
+		{2, 2, 2, "39,40"},
+	} {
+		port, err := platform.Current().Port(fmt.Sprintf("Port%d", c.mainIndex))
+		if err != nil {
+			t.Fatal(err)
+		}
+		lanes, err := portLanes(port, c.subIndex, c.numChannels)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if lanes != c.lanes {
+			t.Errorf("Ethernet%d_%d (%d channels): got %s, expected %s", c.mainIndex, c.subIndex, c.numChannels, lanes, c.lanes)
+		}
+	}
+
+	for _, c := range [][3]int{{1, 0, 4}, {1, 3, 2}, {1, 1, 1}} {
+		port, err := platform.Current().Port(fmt.Sprintf("Port%d", c[0]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := portLanes(port, c[1], c[2]); err == nil {
+			t.Errorf("expected error for %v", c)
+		}
+	}
+}
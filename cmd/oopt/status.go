@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/src-d/go-git.v4"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+const (
+	CONFIG_DB_INITIALIZED_KEY = "CONFIG_DB_INITIALIZED"
+	// status reports redis down instead of waiting for it to come up
+	STATUS_REDIS_TIMEOUT = 3 * time.Second
+)
+
+type healthReport struct {
+	w       *tabwriter.Writer
+	healthy bool
+}
+
+func newHealthReport(w io.Writer) *healthReport {
+	return &healthReport{
+		w:       tabwriter.NewWriter(w, 0, 4, 2, ' ', 0),
+		healthy: true,
+	}
+}
+
+func (r *healthReport) section(name string) {
+	fmt.Fprintf(r.w, "%s:\n", name)
+}
+
+func (r *healthReport) add(ok bool, values ...string) {
+	mark := "OK"
+	if !ok {
+		mark = "NG"
+		r.healthy = false
+	}
+	fmt.Fprintf(r.w, "  %s\t%s\n", mark, strings.Join(values, "\t"))
+}
+
+func (r *healthReport) flush() error {
+	return r.w.Flush()
+}
+
+func enumName(e ygot.GoEnum) string {
+	name, err := ygot.EnumName(e)
+	if err != nil || name == "" {
+		return "UNKNOWN"
+	}
+	return name
+}
+
+func getHeadCommit() (string, error) {
+	repo, err := git.PlainOpen(viper.GetString("git_dir"))
+	if err != nil {
+		return "", err
+	}
+	ref, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+	return ref.Hash().String(), nil
+}
+
+// the applied commit is runtime state, so it is kept in the state directory
+// instead of the work tree of the config repo
+func appliedCommitPath() string {
+	return fmt.Sprintf("%s/%s", viper.GetString("state_dir"), APPLIED_COMMIT_FILE)
+}
+
+func getAppliedCommit() (string, error) {
+	data, err := ioutil.ReadFile(appliedCommitPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// recordAppliedCommit remembers the git HEAD as the configuration
+// running on the system. `oopt status` compares it with the current HEAD
+func recordAppliedCommit() error {
+	hash, err := getHeadCommit()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(viper.GetString("state_dir"), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(appliedCommitPath(), []byte(hash+"\n"), 0644)
+}
+
+func checkComponents(r *healthReport) {
+	r.section("components")
+	d, err := getDeployment()
+	if err != nil {
+		r.add(false, "deploy-config", err.Error())
+		return
+	}
+	names := []string{REDIS_POD_NAME, TRANSYNCD_POD_NAME, SONIC_POD_NAME}
+	if !virtual {
+		names = append(names, OFDPA_POD_NAME)
+	}
+	for _, name := range names {
+		c, err := d.component(name)
+		if err != nil {
+			r.add(false, name, err.Error())
+			continue
+		}
+		status, err := d.Backend.Status(c)
+		if err != nil {
+			r.add(false, name, err.Error())
+			continue
+		}
+		state := status.State
+		if status.Reason != "" {
+			state = fmt.Sprintf("%s(%s)", status.State, status.Reason)
+		}
+		r.add(status.State == "Running" && status.Ready, name, state, fmt.Sprintf("restarts=%d", status.Restarts))
+	}
+}
+
+func checkRedis(r *healthReport) bool {
+	r.section("redis")
+	ctx, cancel := context.WithTimeout(context.Background(), STATUS_REDIS_TIMEOUT)
+	defer cancel()
+	client, err := sonic.GetClientContext(ctx, sonic.CONFIG_DB)
+	if err != nil {
+		r.add(false, "ping", err.Error())
+		return false
+	}
+	r.add(true, "ping", "PONG")
+	v, err := client.GetKey(CONFIG_DB_INITIALIZED_KEY)
+	if err != nil {
+		r.add(false, CONFIG_DB_INITIALIZED_KEY, err.Error())
+		return true
+	}
+	r.add(v == "1", CONFIG_DB_INITIALIZED_KEY, fmt.Sprintf("%q", v))
+	return true
+}
+
+func checkOpticalModules(r *healthReport, config *model.PacketTransponder) {
+	r.section("optical-modules")
+	names := make([]string, 0, len(config.OpticalModule))
+	for k := range config.OpticalModule {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		o, err := ygot.DeepCopy(config.OpticalModule[name])
+		if err != nil {
+			r.add(false, name, err.Error())
+			continue
+		}
+		module := o.(*model.PacketTransponder_OpticalModule)
+		if err = sonic.FillTransportState(name, module); err != nil {
+			r.add(false, name, err.Error())
+			continue
+		}
+		if module.Enabled != nil && !*module.Enabled {
+			r.add(true, name, "disabled")
+			continue
+		}
+		syncError := "unknown"
+		if module.SyncError != nil {
+			syncError = fmt.Sprintf("%t", *module.SyncError)
+		}
+		ok := module.OperationStatus == model.PacketTransport_OpticalModuleStatusType_STATE_READY && syncError == "false"
+		r.add(ok, name, enumName(module.OperationStatus), fmt.Sprintf("sync-error=%s", syncError))
+	}
+}
+
+func checkInterfaces(r *healthReport, config *model.PacketTransponder) {
+	r.section("interfaces")
+	names := make([]string, 0, len(config.Interface))
+	for k := range config.Interface {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		intf := &model.PacketTransponder_Interface{}
+		if err := sonic.FillInterfaceState(name, intf); err != nil {
+			r.add(false, name, err.Error())
+			continue
+		}
+		// only client ports connected to an optical module are expected to be up
+		connected := config.Interface[name].OpticalModuleConnection != nil
+		ok := !connected || intf.OperStatus == model.OpenconfigInterfaces_Interface_OperStatus_UP
+		r.add(ok, name, enumName(intf.OperStatus))
+	}
+}
+
+func checkAppliedCommit(r *healthReport) {
+	r.section("config")
+	head, err := getHeadCommit()
+	if err != nil {
+		r.add(false, "HEAD", err.Error())
+		return
+	}
+	applied, err := getAppliedCommit()
+	if err != nil {
+		r.add(false, "applied", err.Error())
+		return
+	}
+	if applied == "" {
+		applied = "none"
+	}
+	r.add(head == applied, "HEAD", head, "applied", applied)
+}
+
+func NewStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:               "status",
+		Args:              cobra.NoArgs,
+		SilenceUsage:      true,
+		PersistentPreRunE: persistentPreRunE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r := newHealthReport(os.Stdout)
+			checkComponents(r)
+			if checkRedis(r) {
+				checkOpticalModules(r, current)
+				checkInterfaces(r, current)
+			}
+			checkAppliedCommit(r)
+			if err := r.flush(); err != nil {
+				return err
+			}
+			if !r.healthy {
+				return fmt.Errorf("system is unhealthy")
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/openconfig/ygot/ygot"
+	"github.com/spf13/cobra"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+const (
+	MGMT_INTERFACE_NAME = "eth0"
+	DEFAULT_BGP_ASN     = 65100
+	DEVICE_TYPE         = "packet-transponder"
+)
+
+// defaultMacAddress returns the MAC address of the management interface
+func defaultMacAddress() (string, error) {
+	intf, err := net.InterfaceByName(MGMT_INTERFACE_NAME)
+	if err != nil {
+		return "", fmt.Errorf("failed to get MAC address of %s: %v", MGMT_INTERFACE_NAME, err)
+	}
+	return intf.HardwareAddr.String(), nil
+}
+
+func parseMacAddress(s string) (string, error) {
+	mac, err := net.ParseMAC(s)
+	if err != nil {
+		return "", err
+	}
+	return mac.String(), nil
+}
+
+func parseCIDR(s string) (string, error) {
+	ip, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return "", err
+	}
+	ones, _ := n.Mask.Size()
+	return fmt.Sprintf("%s/%d", ip, ones), nil
+}
+
+func parseIP(s string) (string, error) {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return "", fmt.Errorf("invalid IP address: %s", s)
+	}
+	return ip.String(), nil
+}
+
+func parseHostname(s string) (string, error) {
+	if len(s) == 0 || len(s) > 63 {
+		return "", fmt.Errorf("hostname must be 1-63 characters")
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+			return "", fmt.Errorf("invalid character %q in hostname", c)
+		}
+	}
+	return s, nil
+}
+
+// validateSystem checks the system leaves which may be edited in the config file directly
+func validateSystem(s *model.PacketTransponder_System) error {
+	if s == nil {
+		return nil
+	}
+	for _, l := range []struct {
+		name  string
+		value *string
+		parse func(string) (string, error)
+	}{
+		{"hostname", s.Hostname, parseHostname},
+		{"mac-address", s.MacAddress, parseMacAddress},
+		{"management-address", s.ManagementAddress, parseCIDR},
+		{"management-gateway", s.ManagementGateway, parseIP},
+	} {
+		if l.value == nil {
+			continue
+		}
+		if _, err := l.parse(*l.value); err != nil {
+			return fmt.Errorf("system %s: %v", l.name, err)
+		}
+	}
+	return nil
+}
+
+func newSystemLeafCmd(use string, field func() **string, parse func(string) (string, error)) *cobra.Command {
+	clearCmd := &cobra.Command{
+		Use:  "clear",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			*field() = nil
+			return nil
+		},
+	}
+	leafCmd := &cobra.Command{
+		Use:  use,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if v := *field(); v != nil {
+					fmt.Println(*v)
+				}
+				return nil
+			}
+			v, err := parse(args[0])
+			if err != nil {
+				return err
+			}
+			*field() = ygot.String(v)
+			return nil
+		},
+	}
+	leafCmd.AddCommand(clearCmd)
+	return leafCmd
+}
+
+func NewSystemCmd() *cobra.Command {
+	hostnameCmd := newSystemLeafCmd("hostname", func() **string { return &current.System.Hostname }, parseHostname)
+	macAddressCmd := newSystemLeafCmd("mac-address", func() **string { return &current.System.MacAddress }, parseMacAddress)
+	managementAddressCmd := newSystemLeafCmd("management-address", func() **string { return &current.System.ManagementAddress }, parseCIDR)
+	managementGatewayCmd := newSystemLeafCmd("management-gateway", func() **string { return &current.System.ManagementGateway }, parseIP)
+
+	bgpAsnClearCmd := &cobra.Command{
+		Use:  "clear",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			current.System.BgpAsn = nil
+			return nil
+		},
+	}
+
+	bgpAsnCmd := &cobra.Command{
+		Use:  "bgp-asn",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				if asn := current.System.BgpAsn; asn != nil {
+					fmt.Println(*asn)
+				}
+				return nil
+			}
+			asn, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			if asn == 0 {
+				return fmt.Errorf("invalid ASN: %d", asn)
+			}
+			current.System.BgpAsn = ygot.Uint32(uint32(asn))
+			return nil
+		},
+	}
+	bgpAsnCmd.AddCommand(bgpAsnClearCmd)
+
+	systemCmd := &cobra.Command{
+		Use: "system",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			err := persistentPreRunE(cmd, args)
+			if err != nil {
+				return err
+			}
+			if current.System == nil {
+				current.System = &model.PacketTransponder_System{}
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			json, err := ygot.EmitJSON(current.System, nil)
+			if err != nil {
+				return err
+			}
+			fmt.Println(json)
+			return nil
+		},
+		PersistentPostRunE: persistentPostRunE,
+	}
+	systemCmd.AddCommand(hostnameCmd, macAddressCmd, bgpAsnCmd, managementAddressCmd, managementGatewayCmd)
+	return systemCmd
+}
+
+// systemHostname returns the configured hostname or the hostname of the host
+func systemHostname(s *model.PacketTransponder_System) (string, error) {
+	if s != nil && s.Hostname != nil {
+		return *s.Hostname, nil
+	}
+	return os.Hostname()
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
+)
+
+const (
+	ANSI_CLEAR     = "\033[H\033[2J"
+	ANSI_HIGHLIGHT = "\033[1;7m"
+	ANSI_RESET     = "\033[0m"
+)
+
+const (
+	DEFAULT_WATCH_INTERVAL = 5 * time.Second
+)
+
+// watchTable is a table shown in watch mode.
+// the first cell of each row is its name, and the other cells
+// are compared with the previous sample by "<row>.<column>"
+type watchTable struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *watchTable) addRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+func (t *watchTable) key(row []string, i int) string {
+	column := fmt.Sprintf("%d", i)
+	if i < len(t.header) {
+		column = t.header[i]
+	}
+	return fmt.Sprintf("%s.%s", row[0], column)
+}
+
+func (t *watchTable) values() map[string]string {
+	m := make(map[string]string)
+	for _, row := range t.rows {
+		for i := 1; i < len(row); i++ {
+			m[t.key(row, i)] = row[i]
+		}
+	}
+	return m
+}
+
+func (t *watchTable) render(w io.Writer, prev map[string]string) {
+	widths := make([]int, len(t.header))
+	for i, h := range t.header {
+		widths[i] = len(h)
+	}
+	for _, row := range t.rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			if len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	pad := func(s string, i int) string {
+		return s + strings.Repeat(" ", widths[i]-len(s)+2)
+	}
+	if len(t.header) > 0 {
+		for i, h := range t.header {
+			fmt.Fprint(w, pad(h, i))
+		}
+		fmt.Fprintln(w)
+	}
+	for _, row := range t.rows {
+		for i, cell := range row {
+			s := pad(cell, i)
+			if old, ok := prev[t.key(row, i)]; ok && i > 0 && old != cell {
+				s = ANSI_HIGHLIGHT + cell + ANSI_RESET + strings.Repeat(" ", widths[i]-len(cell)+2)
+			}
+			fmt.Fprint(w, s)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w)
+}
+
+// watch redraws the tables returned by sample every interval
+// values changed since the previous sample are highlighted
+func watch(title string, interval time.Duration, sample func() ([]*watchTable, error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("invalid interval: %s", interval)
+	}
+	var prev []map[string]string
+	for {
+		tables, err := sample()
+		if err != nil {
+			return err
+		}
+		buf := new(bytes.Buffer)
+		fmt.Fprint(buf, ANSI_CLEAR)
+		fmt.Fprintf(buf, "%s    every %s    %s\n\n", title, interval, time.Now().Format("2006-01-02 15:04:05"))
+		values := make([]map[string]string, 0, len(tables))
+		for i, t := range tables {
+			var p map[string]string
+			if i < len(prev) {
+				p = prev[i]
+			}
+			t.render(buf, p)
+			values = append(values, t.values())
+		}
+		os.Stdout.Write(buf.Bytes())
+		prev = values
+		time.Sleep(interval)
+	}
+}
+
+func stringOrNA(s *string) string {
+	if s == nil {
+		return "-"
+	}
+	return *s
+}
+
+func uint16OrNA(v *uint16) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *v)
+}
+
+func float64OrNA(v *float64) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f", *v)
+}
+
+func opticalModuleWatchTables(m *model.PacketTransponder_OpticalModule) []*watchTable {
+	syncError := "-"
+	if m.SyncError != nil {
+		syncError = fmt.Sprintf("%t", *m.SyncError)
+	}
+	status := &watchTable{}
+	status.addRow("operation-status", enumName(m.OperationStatus))
+	status.addRow("sync-error", syncError)
+	if f := m.OpticalModuleFrequency; f != nil && f.Frequency != nil && f.Wavelength != nil {
+		status.addRow("frequency", fmt.Sprintf("%gTHz (%.2fnm)", *f.Frequency, *f.Wavelength))
+	} else {
+		status.addRow("frequency", "-")
+	}
+	status.addRow("tx-power", float64OrNA(m.TxPower))
+	status.addRow("rx-power", float64OrNA(m.RxPower))
+	status.addRow("osnr", float64OrNA(m.Osnr))
+	status.addRow("chromatic-dispersion", float64OrNA(m.ChromaticDispersion))
+	status.addRow("dgd", float64OrNA(m.Dgd))
+	status.addRow("laser-frequency-offset", float64OrNA(m.LaserFrequencyOffset))
+	status.addRow("case-temperature", float64OrNA(m.CaseTemperature))
+
+	rms := &watchTable{
+		header: []string{"rms", "xi", "xq", "yi", "yq"},
+	}
+	if r := m.OpticalModuleRms; r != nil {
+		rms.addRow("", uint16OrNA(r.Xi), uint16OrNA(r.Xq), uint16OrNA(r.Yi), uint16OrNA(r.Yq))
+	} else {
+		rms.addRow("", "-", "-", "-", "-")
+	}
+
+	ber := &watchTable{
+		header: []string{"channel", "sd-fec-ber", "hd-fec-ber", "post-fec-ber", "q-factor"},
+	}
+	for _, ch := range moduleChannelNames(m) {
+		s, ok := m.ChannelStats[ch]
+		if !ok {
+			ber.addRow(ch, "-", "-", "-", "-")
+			continue
+		}
+		ber.addRow(ch, stringOrNA(s.SdFecBer), stringOrNA(s.HdFecBer), stringOrNA(s.PostFecBer), float64OrNA(s.QFactor))
+	}
+	return []*watchTable{status, rms, ber}
+}
+
+// moduleChannelNames returns the channels of the optical module on the platform.
+// the channels having the stats are used when the module isn't on the platform
+func moduleChannelNames(m *model.PacketTransponder_OpticalModule) []string {
+	if m.Name != nil {
+		if module, err := platform.Current().OpticalModule(*m.Name); err == nil {
+			return module.ChannelNames()
+		}
+	}
+	names := make([]string, 0, len(m.ChannelStats))
+	for name := range m.ChannelStats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func interfaceWatchTables(i *model.PacketTransponder_Interface) []*watchTable {
+	mtu := "-"
+	if i.Mtu != nil {
+		mtu = fmt.Sprintf("%d", *i.Mtu)
+	}
+	status := &watchTable{}
+	status.addRow("admin-status", enumName(i.AdminStatus))
+	status.addRow("oper-status", enumName(i.OperStatus))
+	status.addRow("mtu", mtu)
+	return []*watchTable{status, counterWatchTable(i.Counters)}
+}
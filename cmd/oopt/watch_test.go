@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+var highlighted = regexp.MustCompile(regexp.QuoteMeta(ANSI_HIGHLIGHT) + "(.*?)" + regexp.QuoteMeta(ANSI_RESET))
+
+// renderWatchTables renders the tables as watch does and returns the output and its highlighted cells
+func renderWatchTables(tables []*watchTable, prev []map[string]string) (string, []string, []map[string]string) {
+	buf := new(bytes.Buffer)
+	values := make([]map[string]string, 0, len(tables))
+	for i, t := range tables {
+		var p map[string]string
+		if i < len(prev) {
+			p = prev[i]
+		}
+		t.render(buf, p)
+		values = append(values, t.values())
+	}
+	cells := []string{}
+	for _, m := range highlighted.FindAllStringSubmatch(buf.String(), -1) {
+		cells = append(cells, m[1])
+	}
+	return buf.String(), cells, values
+}
+
+func TestWatchTableRender(t *testing.T) {
+	m := &model.PacketTransponder{}
+	o, err := m.NewOpticalModule("Opt1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.OperationStatus = model.PacketTransport_OpticalModuleStatusType_STATE_READY
+	o.RxPower = ygot.Float64(-3.5)
+	o.OpticalModuleRms = &model.PacketTransponder_OpticalModule_OpticalModuleRms{Xi: ygot.Uint16(10), Xq: ygot.Uint16(11), Yi: ygot.Uint16(12), Yq: ygot.Uint16(13)}
+	for _, ch := range []string{"A", "B"} {
+		s, err := o.NewChannelStats(ch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.SdFecBer = ygot.String("1.0e-03")
+		s.HdFecBer = ygot.String("2.0e-05")
+		s.PostFecBer = ygot.String("0.0e+00")
+	}
+
+	// nothing is highlighted without the previous sample
+	_, cells, prev := renderWatchTables(opticalModuleWatchTables(o), nil)
+	if len(cells) != 0 {
+		t.Errorf("unexpected highlight in the first sample: %v", cells)
+	}
+
+	o.RxPower = ygot.Float64(-4.25)
+	o.OpticalModuleRms.Yi = ygot.Uint16(120)
+	o.ChannelStats["B"].PostFecBer = ygot.String("3.0e-12")
+	second, cells, prev := renderWatchTables(opticalModuleWatchTables(o), prev)
+	expected := []string{"-4.25", "120", "3.0e-12"}
+	if !reflect.DeepEqual(cells, expected) {
+		t.Errorf("expected %v to be highlighted, got %v", expected, cells)
+	}
+	// channel A keeps the same post-fec-ber, which isn't highlighted
+	for _, line := range strings.Split(second, "\n") {
+		if strings.HasPrefix(line, "A ") && strings.Contains(line, ANSI_HIGHLIGHT) {
+			t.Errorf("unchanged channel is highlighted: %q", line)
+		}
+	}
+	// highlighting doesn't break the alignment
+	plain, _, _ := renderWatchTables(opticalModuleWatchTables(o), nil)
+	if stripped := highlighted.ReplaceAllString(second, "$1"); stripped != plain {
+		t.Errorf("highlighted output is not aligned:\n%s\nexpected:\n%s", stripped, plain)
+	}
+
+	// the same values are not highlighted again
+	if _, cells, _ = renderWatchTables(opticalModuleWatchTables(o), prev); len(cells) != 0 {
+		t.Errorf("unexpected highlight: %v", cells)
+	}
+}
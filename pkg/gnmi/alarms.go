@@ -0,0 +1,153 @@
+package gnmi
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+const (
+	ALARMS_PATH = "alarms"
+	ALARM_PATH  = "alarm"
+)
+
+// AlarmSource provides the alarms streamed to the subscribers of /alarms
+type AlarmSource interface {
+	Alarms(includeCleared bool) []sonic.Alarm
+	Subscribe() (<-chan sonic.Alarm, func())
+}
+
+// SetAlarmSource enables the subscription to /alarms
+func (srv *Server) SetAlarmSource(s AlarmSource) {
+	srv.alarms = s
+}
+
+type alarmFilter struct {
+	id       string
+	resource string
+}
+
+func (f alarmFilter) match(a sonic.Alarm) bool {
+	return (f.id == "" || a.ID == f.id) && (f.resource == "" || a.Resource == f.resource)
+}
+
+// parseAlarmFilter returns the filter of the subscription to /alarms,
+// /alarms/alarm[id=<id>] or /alarms/alarm[resource=<resource>]
+func parseAlarmFilter(path *pb.Path) (alarmFilter, bool) {
+	f := alarmFilter{}
+	elems := path.GetElem()
+	if len(elems) == 0 || len(elems) > 2 || elems[0].Name != ALARMS_PATH {
+		return f, false
+	}
+	if len(elems) == 2 {
+		if elems[1].Name != ALARM_PATH {
+			return f, false
+		}
+		f.id = elems[1].Key["id"]
+		f.resource = elems[1].Key["resource"]
+	}
+	return f, true
+}
+
+// alarmNotification updates the active alarm or deletes the cleared alarm like openconfig-alarms
+func alarmNotification(a sonic.Alarm) (*pb.SubscribeResponse, error) {
+	path := &pb.Path{Elem: []*pb.PathElem{{Name: ALARM_PATH, Key: map[string]string{"id": a.ID}}}}
+	n := &pb.Notification{
+		Timestamp: a.TimeCreated.UnixNano(),
+		Prefix:    &pb.Path{Elem: []*pb.PathElem{{Name: ALARMS_PATH}}},
+	}
+	if a.Active() {
+		val, err := json.Marshal(a)
+		if err != nil {
+			return nil, err
+		}
+		n.Update = []*pb.Update{{
+			Path: path,
+			Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: val}},
+		}}
+	} else {
+		n.Timestamp = a.TimeCleared.UnixNano()
+		n.Delete = []*pb.Path{path}
+	}
+	return &pb.SubscribeResponse{Response: &pb.SubscribeResponse_Update{Update: n}}, nil
+}
+
+// subscribeAlarms sends the active alarms, a sync response and, in STREAM mode, the raised and cleared alarms
+func (srv *Server) subscribeAlarms(stream pb.GNMI_SubscribeServer, list *pb.SubscriptionList) error {
+	if srv.alarms == nil {
+		return grpc.Errorf(codes.Unavailable, "alarms are not enabled")
+	}
+	mode := list.GetMode()
+	if mode == pb.SubscriptionList_POLL {
+		return grpc.Errorf(codes.Unimplemented, "POLL mode is not supported for %s", ALARMS_PATH)
+	}
+	filters := []alarmFilter{}
+	for _, s := range list.GetSubscription() {
+		f, ok := parseAlarmFilter(gnmiFullPath(list.GetPrefix(), s.GetPath()))
+		if !ok {
+			return grpc.Errorf(codes.Unimplemented, "subscriptions to /%s can't be mixed with the other paths", ALARMS_PATH)
+		}
+		filters = append(filters, f)
+	}
+	if len(filters) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "no subscription")
+	}
+	match := func(a sonic.Alarm) bool {
+		for _, f := range filters {
+			if f.match(a) {
+				return true
+			}
+		}
+		return false
+	}
+	send := func(a sonic.Alarm) error {
+		if !match(a) {
+			return nil
+		}
+		resp, err := alarmNotification(a)
+		if err != nil {
+			return err
+		}
+		return stream.Send(resp)
+	}
+
+	// subscribe before reading the active alarms not to miss changes in between
+	ch, cancel := srv.alarms.Subscribe()
+	defer cancel()
+	sent := map[string]bool{}
+	for _, a := range srv.alarms.Alarms(false) {
+		if err := send(a); err != nil {
+			return err
+		}
+		sent[a.ID] = true
+	}
+	if err := stream.Send(&pb.SubscribeResponse{Response: &pb.SubscribeResponse_SyncResponse{SyncResponse: true}}); err != nil {
+		return err
+	}
+	if mode == pb.SubscriptionList_ONCE {
+		return nil
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case a, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			// the alarm was already sent as an active alarm
+			if a.Active() && sent[a.ID] {
+				continue
+			}
+			delete(sent, a.ID)
+			if err := send(a); err != nil {
+				return err
+			}
+		}
+	}
+}
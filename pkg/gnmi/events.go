@@ -0,0 +1,139 @@
+package gnmi
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/openconfig/gnmi/proto/gnmi"
+
+	"github.com/osrg/oopt/pkg/sonic"
+)
+
+const (
+	EVENTS_PATH = "events"
+	EVENT_PATH  = "event"
+)
+
+// EventSource provides the events streamed to the subscribers of /events
+type EventSource interface {
+	Events(f sonic.EventFilter) []sonic.Event
+	Subscribe() (<-chan sonic.Event, func())
+}
+
+// SetEventSource enables the subscription to /events
+func (srv *Server) SetEventSource(s EventSource) {
+	srv.events = s
+}
+
+// eventFilter returns the filter of the subscription to /events or /events/event[module=<name>]
+func eventFilter(path *pb.Path) (sonic.EventFilter, bool) {
+	f := sonic.EventFilter{}
+	elems := path.GetElem()
+	if len(elems) == 0 || len(elems) > 2 || elems[0].Name != EVENTS_PATH {
+		return f, false
+	}
+	if len(elems) == 2 {
+		if elems[1].Name != EVENT_PATH {
+			return f, false
+		}
+		f.Module = elems[1].Key["module"]
+	}
+	return f, true
+}
+
+func eventNotification(e sonic.Event) (*pb.SubscribeResponse, error) {
+	val, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubscribeResponse{
+		Response: &pb.SubscribeResponse_Update{
+			Update: &pb.Notification{
+				Timestamp: e.Time.UnixNano(),
+				Prefix:    &pb.Path{Elem: []*pb.PathElem{{Name: EVENTS_PATH}}},
+				Update: []*pb.Update{{
+					Path: &pb.Path{Elem: []*pb.PathElem{{Name: EVENT_PATH, Key: map[string]string{"module": e.Module, "op": e.Op}}}},
+					Val:  &pb.TypedValue{Value: &pb.TypedValue_JsonIetfVal{JsonIetfVal: val}},
+				}},
+			},
+		},
+	}, nil
+}
+
+func matchAny(filters []sonic.EventFilter, e sonic.Event) bool {
+	for _, f := range filters {
+		if f.Match(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// subscribeEvents sends the kept events, a sync response and, in STREAM mode, the new events
+func (srv *Server) subscribeEvents(stream pb.GNMI_SubscribeServer, list *pb.SubscriptionList) error {
+	if srv.events == nil {
+		return grpc.Errorf(codes.Unavailable, "event history is not enabled")
+	}
+	mode := list.GetMode()
+	if mode == pb.SubscriptionList_POLL {
+		return grpc.Errorf(codes.Unimplemented, "POLL mode is not supported for %s", EVENTS_PATH)
+	}
+	filters := []sonic.EventFilter{}
+	for _, s := range list.GetSubscription() {
+		f, ok := eventFilter(gnmiFullPath(list.GetPrefix(), s.GetPath()))
+		if !ok {
+			return grpc.Errorf(codes.Unimplemented, "only subscriptions to /%s are supported", EVENTS_PATH)
+		}
+		filters = append(filters, f)
+	}
+	if len(filters) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "no subscription")
+	}
+
+	// subscribe before reading the history not to miss events added in between
+	ch, cancel := srv.events.Subscribe()
+	defer cancel()
+	var last int64
+	for _, e := range srv.events.Events(sonic.EventFilter{}) {
+		if !matchAny(filters, e) {
+			continue
+		}
+		resp, err := eventNotification(e)
+		if err != nil {
+			return err
+		}
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+		last = e.Time.UnixNano()
+	}
+	if err := stream.Send(&pb.SubscribeResponse{Response: &pb.SubscribeResponse_SyncResponse{SyncResponse: true}}); err != nil {
+		return err
+	}
+	if mode == pb.SubscriptionList_ONCE {
+		return nil
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			// the event was already sent from the history
+			if e.Time.UnixNano() <= last || !matchAny(filters, e) {
+				continue
+			}
+			resp, err := eventNotification(e)
+			if err != nil {
+				return err
+			}
+			if err = stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -52,6 +52,8 @@ type Server struct {
 	cMu      sync.Mutex
 	model    *Model
 	callback ConfigCallback
+	events   EventSource
+	alarms   AlarmSource
 }
 
 // NewServer returns an initialized server.
@@ -618,7 +620,21 @@ func (srv *Server) Set(ctx context.Context, req *pb.SetRequest) (*pb.SetResponse
 	}, nil
 }
 
-// Subscribe method is not implemented.
+// Subscribe streams the events or the alarms. subscriptions to the other paths are not implemented.
 func (srv *Server) Subscribe(stream pb.GNMI_SubscribeServer) error {
-	return grpc.Errorf(codes.Unimplemented, "Subscribe() is not implemented.")
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	list := req.GetSubscribe()
+	if list == nil {
+		return grpc.Errorf(codes.InvalidArgument, "the first request must be a subscription list")
+	}
+	if subs := list.GetSubscription(); len(subs) > 0 {
+		elems := gnmiFullPath(list.GetPrefix(), subs[0].GetPath()).GetElem()
+		if len(elems) > 0 && elems[0].Name == ALARMS_PATH {
+			return srv.subscribeAlarms(stream, list)
+		}
+	}
+	return srv.subscribeEvents(stream, list)
 }
@@ -6,11 +6,12 @@ in this case).
 
 This package was generated by /go/src/github.com/osrg/oopt/vendor/github.com/openconfig/ygot/ygen/commongen.go
 using the following YANG input files:
-	- ./yang/packet-transport.yang
+  - ./yang/packet-transport.yang
+
 Imported modules were sourced from:
-	- submodules/public/release/...
-	- submodules/pyang/modules/ietf/...
-	- submodules/pyang/modules/iana/...
+  - submodules/public/release/...
+  - submodules/pyang/modules/ietf/...
+  - submodules/pyang/modules/iana/...
 */
 package model
 
@@ -492,6 +493,7 @@ type PacketTransponder struct {
 	Interface             map[string]*PacketTransponder_Interface     `path:"interfaces/interface" module:"packet-transport"`
 	OpticalModule         map[string]*PacketTransponder_OpticalModule `path:"optical-modules/optical-module" module:"packet-transport"`
 	Port                  map[string]*PacketTransponder_Port          `path:"ports/port" module:"packet-transport"`
+	System                *PacketTransponder_System                   `path:"system" module:"packet-transport"`
 }
 
 // IsYANGGoStruct ensures that PacketTransponder implements the yang.GoStruct
@@ -644,24 +646,30 @@ func (t *PacketTransponder_Interface) ΛEnumTypeMap() map[string][]reflect.Type
 
 // PacketTransponder_Interface_Counters represents the /packet-transport/packet-transponder/interfaces/interface/state/counters YANG schema element.
 type PacketTransponder_Interface_Counters struct {
-	CarrierTransitions *uint64 `path:"carrier-transitions" module:"packet-transport"`
-	InBroadcastPkts    *uint64 `path:"in-broadcast-pkts" module:"packet-transport"`
-	InDiscards         *uint64 `path:"in-discards" module:"packet-transport"`
-	InErrors           *uint64 `path:"in-errors" module:"packet-transport"`
-	InFcsErrors        *uint64 `path:"in-fcs-errors" module:"packet-transport"`
-	InMulticastPkts    *uint64 `path:"in-multicast-pkts" module:"packet-transport"`
-	InOctets           *uint64 `path:"in-octets" module:"packet-transport"`
-	InPkts             *uint64 `path:"in-pkts" module:"packet-transport"`
-	InUnicastPkts      *uint64 `path:"in-unicast-pkts" module:"packet-transport"`
-	InUnknownProtos    *uint64 `path:"in-unknown-protos" module:"packet-transport"`
-	LastClear          *uint64 `path:"last-clear" module:"packet-transport"`
-	OutBroadcastPkts   *uint64 `path:"out-broadcast-pkts" module:"packet-transport"`
-	OutDiscards        *uint64 `path:"out-discards" module:"packet-transport"`
-	OutErrors          *uint64 `path:"out-errors" module:"packet-transport"`
-	OutMulticastPkts   *uint64 `path:"out-multicast-pkts" module:"packet-transport"`
-	OutOctets          *uint64 `path:"out-octets" module:"packet-transport"`
-	OutPkts            *uint64 `path:"out-pkts" module:"packet-transport"`
-	OutUnicastPkts     *uint64 `path:"out-unicast-pkts" module:"packet-transport"`
+	CarrierTransitions          *uint64 `path:"carrier-transitions" module:"packet-transport"`
+	InBps                       *uint64 `path:"in-bps" module:"packet-transport"`
+	InBroadcastPkts             *uint64 `path:"in-broadcast-pkts" module:"packet-transport"`
+	InDiscards                  *uint64 `path:"in-discards" module:"packet-transport"`
+	InErrors                    *uint64 `path:"in-errors" module:"packet-transport"`
+	InFcsErrors                 *uint64 `path:"in-fcs-errors" module:"packet-transport"`
+	InMulticastPkts             *uint64 `path:"in-multicast-pkts" module:"packet-transport"`
+	InOctets                    *uint64 `path:"in-octets" module:"packet-transport"`
+	InPkts                      *uint64 `path:"in-pkts" module:"packet-transport"`
+	InPps                       *uint64 `path:"in-pps" module:"packet-transport"`
+	InRsFecCorrectedCodewords   *uint64 `path:"in-rs-fec-corrected-codewords" module:"packet-transport"`
+	InRsFecUncorrectedCodewords *uint64 `path:"in-rs-fec-uncorrected-codewords" module:"packet-transport"`
+	InUnicastPkts               *uint64 `path:"in-unicast-pkts" module:"packet-transport"`
+	InUnknownProtos             *uint64 `path:"in-unknown-protos" module:"packet-transport"`
+	LastClear                   *uint64 `path:"last-clear" module:"packet-transport"`
+	OutBps                      *uint64 `path:"out-bps" module:"packet-transport"`
+	OutBroadcastPkts            *uint64 `path:"out-broadcast-pkts" module:"packet-transport"`
+	OutDiscards                 *uint64 `path:"out-discards" module:"packet-transport"`
+	OutErrors                   *uint64 `path:"out-errors" module:"packet-transport"`
+	OutMulticastPkts            *uint64 `path:"out-multicast-pkts" module:"packet-transport"`
+	OutOctets                   *uint64 `path:"out-octets" module:"packet-transport"`
+	OutPkts                     *uint64 `path:"out-pkts" module:"packet-transport"`
+	OutPps                      *uint64 `path:"out-pps" module:"packet-transport"`
+	OutUnicastPkts              *uint64 `path:"out-unicast-pkts" module:"packet-transport"`
 }
 
 // IsYANGGoStruct ensures that PacketTransponder_Interface_Counters implements the yang.GoStruct
@@ -735,19 +743,27 @@ func (t *PacketTransponder_Interface_OpticalModuleConnection_OpticalModule) ΛEn
 
 // PacketTransponder_OpticalModule represents the /packet-transport/packet-transponder/optical-modules/optical-module YANG schema element.
 type PacketTransponder_OpticalModule struct {
+	AlarmThresholds        *PacketTransponder_OpticalModule_AlarmThresholds         `path:"alarm-thresholds" module:"packet-transport"`
 	AllowOversubscription  *bool                                                    `path:"config/allow-oversubscription" module:"packet-transport"`
 	BerInterval            *uint32                                                  `path:"config/ber-interval" module:"packet-transport"`
+	CaseTemperature        *float64                                                 `path:"state/case-temperature" module:"packet-transport"`
 	ChannelStats           map[string]*PacketTransponder_OpticalModule_ChannelStats `path:"state/channel-stats" module:"packet-transport"`
+	ChromaticDispersion    *float64                                                 `path:"state/chromatic-dispersion" module:"packet-transport"`
 	Description            *string                                                  `path:"config/description" module:"packet-transport"`
+	Dgd                    *float64                                                 `path:"state/dgd" module:"packet-transport"`
 	Enabled                *bool                                                    `path:"config/enabled" module:"packet-transport"`
+	LaserFrequencyOffset   *float64                                                 `path:"state/laser-frequency-offset" module:"packet-transport"`
 	Losi                   *bool                                                    `path:"config/losi" module:"packet-transport"`
 	ModulationType         E_PacketTransport_OpticalModulationType                  `path:"config/modulation-type" module:"packet-transport"`
 	Name                   *string                                                  `path:"config/name|name" module:"packet-transport"`
 	OperationStatus        E_PacketTransport_OpticalModuleStatusType                `path:"state/operation-status" module:"packet-transport"`
 	OpticalModuleFrequency *PacketTransponder_OpticalModule_OpticalModuleFrequency  `path:"optical-module-frequency" module:"packet-transport"`
 	OpticalModuleRms       *PacketTransponder_OpticalModule_OpticalModuleRms        `path:"optical-module-rms" module:"packet-transport"`
+	Osnr                   *float64                                                 `path:"state/osnr" module:"packet-transport"`
 	Prbs                   *bool                                                    `path:"config/prbs" module:"packet-transport"`
+	RxPower                *float64                                                 `path:"state/rx-power" module:"packet-transport"`
 	SyncError              *bool                                                    `path:"state/sync-error" module:"packet-transport"`
+	TxPower                *float64                                                 `path:"state/tx-power" module:"packet-transport"`
 }
 
 // IsYANGGoStruct ensures that PacketTransponder_OpticalModule implements the yang.GoStruct
@@ -807,13 +823,42 @@ func (t *PacketTransponder_OpticalModule) ΛEnumTypeMap() map[string][]reflect.T
 	return ΛEnumTypes
 }
 
+// PacketTransponder_OpticalModule_AlarmThresholds represents the /packet-transport/packet-transponder/optical-modules/optical-module/alarm-thresholds YANG schema element.
+type PacketTransponder_OpticalModule_AlarmThresholds struct {
+	PostFecBer            *float64 `path:"config/post-fec-ber" module:"packet-transport"`
+	SdFecBerRisingSamples *uint32  `path:"config/sd-fec-ber-rising-samples" module:"packet-transport"`
+}
+
+// IsYANGGoStruct ensures that PacketTransponder_OpticalModule_AlarmThresholds implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*PacketTransponder_OpticalModule_AlarmThresholds) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *PacketTransponder_OpticalModule_AlarmThresholds) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["PacketTransponder_OpticalModule_AlarmThresholds"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *PacketTransponder_OpticalModule_AlarmThresholds) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
 // PacketTransponder_OpticalModule_ChannelStats represents the /packet-transport/packet-transponder/optical-modules/optical-module/state/channel-stats YANG schema element.
 type PacketTransponder_OpticalModule_ChannelStats struct {
-	HdFecBer   *string `path:"hd-fec-ber" module:"packet-transport"`
-	Name       *string `path:"name" module:"packet-transport"`
-	Occupancy  *string `path:"occupancy" module:"packet-transport"`
-	PostFecBer *string `path:"post-fec-ber" module:"packet-transport"`
-	SdFecBer   *string `path:"sd-fec-ber" module:"packet-transport"`
+	HdFecBer        *string  `path:"hd-fec-ber" module:"packet-transport"`
+	HdFecBerValue   *float64 `path:"hd-fec-ber-value" module:"packet-transport"`
+	Name            *string  `path:"name" module:"packet-transport"`
+	Occupancy       *string  `path:"occupancy" module:"packet-transport"`
+	PostFecBer      *string  `path:"post-fec-ber" module:"packet-transport"`
+	PostFecBerValue *float64 `path:"post-fec-ber-value" module:"packet-transport"`
+	QFactor         *float64 `path:"q-factor" module:"packet-transport"`
+	SdFecBer        *string  `path:"sd-fec-ber" module:"packet-transport"`
+	SdFecBerValue   *float64 `path:"sd-fec-ber-value" module:"packet-transport"`
 }
 
 // IsYANGGoStruct ensures that PacketTransponder_OpticalModule_ChannelStats implements the yang.GoStruct
@@ -848,8 +893,12 @@ func (t *PacketTransponder_OpticalModule_ChannelStats) ΛEnumTypeMap() map[strin
 
 // PacketTransponder_OpticalModule_OpticalModuleFrequency represents the /packet-transport/packet-transponder/optical-modules/optical-module/optical-module-frequency YANG schema element.
 type PacketTransponder_OpticalModule_OpticalModuleFrequency struct {
-	Channel *uint8                              `path:"config/channel" module:"packet-transport"`
-	Grid    E_PacketTransport_FrequencyGridType `path:"config/grid" module:"packet-transport"`
+	CentralFrequencyN *int16                              `path:"config/central-frequency-n" module:"packet-transport"`
+	Channel           *uint8                              `path:"config/channel" module:"packet-transport"`
+	Frequency         *float64                            `path:"state/frequency" module:"packet-transport"`
+	Grid              E_PacketTransport_FrequencyGridType `path:"config/grid" module:"packet-transport"`
+	SlotWidthM        *uint8                              `path:"config/slot-width-m" module:"packet-transport"`
+	Wavelength        *float64                            `path:"state/wavelength" module:"packet-transport"`
 }
 
 // IsYANGGoStruct ensures that PacketTransponder_OpticalModule_OpticalModuleFrequency implements the yang.GoStruct
@@ -958,6 +1007,34 @@ func (t *PacketTransponder_Port_BreakoutMode) ΛEnumTypeMap() map[string][]refle
 	return ΛEnumTypes
 }
 
+// PacketTransponder_System represents the /packet-transport/packet-transponder/system YANG schema element.
+type PacketTransponder_System struct {
+	BgpAsn            *uint32 `path:"config/bgp-asn" module:"packet-transport"`
+	Hostname          *string `path:"config/hostname" module:"packet-transport"`
+	MacAddress        *string `path:"config/mac-address" module:"packet-transport"`
+	ManagementAddress *string `path:"config/management-address" module:"packet-transport"`
+	ManagementGateway *string `path:"config/management-gateway" module:"packet-transport"`
+}
+
+// IsYANGGoStruct ensures that PacketTransponder_System implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*PacketTransponder_System) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *PacketTransponder_System) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["PacketTransponder_System"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *PacketTransponder_System) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
 // E_IETFInterfaces_InterfaceType is a derived int64 type which is used to represent
 // the enumerated node IETFInterfaces_InterfaceType. An additional value named
 // IETFInterfaces_InterfaceType_UNSET is added to the enumeration which is used as
@@ -1562,18 +1639,20 @@ const (
 	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 4
 	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB corresponds to the value SPEED_1GB of OpenconfigIfEthernet_ETHERNET_SPEED
 	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_1GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 5
+	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_20GB corresponds to the value SPEED_20GB of OpenconfigIfEthernet_ETHERNET_SPEED
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_20GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 6
 	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB corresponds to the value SPEED_2500MB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 6
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB E_OpenconfigIfEthernet_ETHERNET_SPEED = 7
 	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB corresponds to the value SPEED_25GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 7
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 8
 	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB corresponds to the value SPEED_40GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 8
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 9
 	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB corresponds to the value SPEED_50GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 9
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 10
 	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB corresponds to the value SPEED_5GB of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 10
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_5GB E_OpenconfigIfEthernet_ETHERNET_SPEED = 11
 	// OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN corresponds to the value SPEED_UNKNOWN of OpenconfigIfEthernet_ETHERNET_SPEED
-	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN E_OpenconfigIfEthernet_ETHERNET_SPEED = 11
+	OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN E_OpenconfigIfEthernet_ETHERNET_SPEED = 12
 )
 
 // E_OpenconfigIfEthernet_Interface_DuplexMode is a derived int64 type which is used to represent
@@ -1840,6 +1919,8 @@ const (
 	PacketTransport_FrequencyGridType_GRID_33GHZ E_PacketTransport_FrequencyGridType = 3
 	// PacketTransport_FrequencyGridType_GRID_25GHZ corresponds to the value GRID_25GHZ of PacketTransport_FrequencyGridType
 	PacketTransport_FrequencyGridType_GRID_25GHZ E_PacketTransport_FrequencyGridType = 4
+	// PacketTransport_FrequencyGridType_FLEXGRID corresponds to the value FLEXGRID of PacketTransport_FrequencyGridType
+	PacketTransport_FrequencyGridType_FLEXGRID E_PacketTransport_FrequencyGridType = 5
 )
 
 // E_PacketTransport_OpticalModulationType is a derived int64 type which is used to represent
@@ -1866,6 +1947,10 @@ const (
 	PacketTransport_OpticalModulationType_DP_QPSK E_PacketTransport_OpticalModulationType = 1
 	// PacketTransport_OpticalModulationType_DP_16QAM corresponds to the value DP_16QAM of PacketTransport_OpticalModulationType
 	PacketTransport_OpticalModulationType_DP_16QAM E_PacketTransport_OpticalModulationType = 2
+	// PacketTransport_OpticalModulationType_DP_8QAM corresponds to the value DP_8QAM of PacketTransport_OpticalModulationType
+	PacketTransport_OpticalModulationType_DP_8QAM E_PacketTransport_OpticalModulationType = 3
+	// PacketTransport_OpticalModulationType_DP_16QAM_200G corresponds to the value DP_16QAM_200G of PacketTransport_OpticalModulationType
+	PacketTransport_OpticalModulationType_DP_16QAM_200G E_PacketTransport_OpticalModulationType = 4
 )
 
 // E_PacketTransport_OpticalModuleStatusType is a derived int64 type which is used to represent
@@ -2195,12 +2280,13 @@ var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
 		3:  {Name: "SPEED_10GB", DefiningModule: "openconfig-if-ethernet"},
 		4:  {Name: "SPEED_10MB", DefiningModule: "openconfig-if-ethernet"},
 		5:  {Name: "SPEED_1GB", DefiningModule: "openconfig-if-ethernet"},
-		6:  {Name: "SPEED_2500MB", DefiningModule: "openconfig-if-ethernet"},
-		7:  {Name: "SPEED_25GB", DefiningModule: "openconfig-if-ethernet"},
-		8:  {Name: "SPEED_40GB", DefiningModule: "openconfig-if-ethernet"},
-		9:  {Name: "SPEED_50GB", DefiningModule: "openconfig-if-ethernet"},
-		10: {Name: "SPEED_5GB", DefiningModule: "openconfig-if-ethernet"},
-		11: {Name: "SPEED_UNKNOWN", DefiningModule: "openconfig-if-ethernet"},
+		6:  {Name: "SPEED_20GB", DefiningModule: "packet-transport"},
+		7:  {Name: "SPEED_2500MB", DefiningModule: "openconfig-if-ethernet"},
+		8:  {Name: "SPEED_25GB", DefiningModule: "openconfig-if-ethernet"},
+		9:  {Name: "SPEED_40GB", DefiningModule: "openconfig-if-ethernet"},
+		10: {Name: "SPEED_50GB", DefiningModule: "openconfig-if-ethernet"},
+		11: {Name: "SPEED_5GB", DefiningModule: "openconfig-if-ethernet"},
+		12: {Name: "SPEED_UNKNOWN", DefiningModule: "openconfig-if-ethernet"},
 	},
 	"E_OpenconfigIfEthernet_Interface_DuplexMode": {
 		1: {Name: "FULL"},
@@ -2265,10 +2351,13 @@ var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
 		2: {Name: "GRID_50GHZ"},
 		3: {Name: "GRID_33GHZ"},
 		4: {Name: "GRID_25GHZ"},
+		5: {Name: "FLEXGRID"},
 	},
 	"E_PacketTransport_OpticalModulationType": {
 		1: {Name: "DP_QPSK"},
 		2: {Name: "DP_16QAM"},
+		3: {Name: "DP_8QAM"},
+		4: {Name: "DP_16QAM_200G"},
 	},
 	"E_PacketTransport_OpticalModuleStatusType": {
 		1: {Name: "STATE_DOWN"},
@@ -2289,1085 +2378,391 @@ var (
 	// contents of a goyang yang.Entry struct, which defines the schema for the
 	// fields within the struct.
 	ySchema = []byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0xd9, 0x72, 0x1b, 0xb9,
-		0xb2, 0x36, 0x7a, 0xef, 0xa7, 0x50, 0x30, 0xfe, 0x8b, 0xbd, 0xe3, 0x6f, 0x2e, 0x73, 0x10, 0x35,
-		0xf8, 0xe6, 0x04, 0x45, 0x52, 0x6e, 0x46, 0x93, 0x12, 0x43, 0x64, 0xdb, 0xbd, 0xcf, 0x6a, 0x6f,
-		0x07, 0x58, 0x85, 0x22, 0x11, 0xaa, 0x02, 0xd0, 0x00, 0x4a, 0x43, 0xac, 0xe3, 0x77, 0x3f, 0xc1,
-		0xe2, 0xa0, 0x59, 0xac, 0x01, 0x94, 0x0a, 0xa9, 0xf4, 0x45, 0xab, 0x2d, 0xb3, 0xc0, 0xc2, 0x90,
-		0x99, 0x5f, 0x26, 0x32, 0xbf, 0xfc, 0xcf, 0xa7, 0xbd, 0xbd, 0xbd, 0xbd, 0xca, 0x19, 0x89, 0x68,
-		0xe5, 0xcb, 0x5e, 0xc5, 0xa7, 0x57, 0xcc, 0xa3, 0x95, 0xdf, 0x96, 0xbf, 0xfd, 0x83, 0x71, 0xbf,
-		0xf2, 0x65, 0xaf, 0xbe, 0xfa, 0x6b, 0x47, 0xf0, 0x80, 0xcd, 0x2a, 0x5f, 0xf6, 0x6a, 0xab, 0x5f,
-		0x74, 0x99, 0xaa, 0x7c, 0xd9, 0x5b, 0x0e, 0x91, 0xfc, 0x22, 0x0c, 0x7d, 0xf9, 0xe0, 0x37, 0x0f,
-		0x06, 0x4f, 0xfe, 0xf5, 0xb7, 0x87, 0xff, 0xf6, 0xf0, 0x2b, 0x36, 0xbf, 0x7e, 0xfc, 0x55, 0x9b,
-		0x7f, 0x18, 0x29, 0x1a, 0xb0, 0x9b, 0x27, 0xdf, 0xf1, 0xe0, 0x7b, 0x84, 0x57, 0x7d, 0xe6, 0xab,
-		0x92, 0x8f, 0x8c, 0x45, 0xac, 0x3c, 0xfa, 0xec, 0xe3, 0xcb, 0xd7, 0xa1, 0xb7, 0xd7, 0x42, 0x2d,
-		0xde, 0xa8, 0x22, 0x97, 0xdf, 0xf4, 0xdb, 0xf3, 0x1f, 0xfc, 0x9d, 0xe8, 0xb6, 0x9a, 0xc5, 0x11,
-		0xe5, 0xa6, 0xf2, 0x65, 0xcf, 0xa8, 0x98, 0xbe, 0xf0, 0xc1, 0x7b, 0x9f, 0xda, 0xbc, 0xd8, 0x93,
-		0x4f, 0xfe, 0x7a, 0xf0, 0x9b, 0x5f, 0x8f, 0xe6, 0xfc, 0x78, 0x99, 0x37, 0xff, 0xe0, 0xad, 0x57,
-		0xe9, 0x85, 0xd9, 0xac, 0x17, 0x64, 0xf5, 0xb9, 0x17, 0xde, 0xf0, 0xf9, 0x2d, 0xd8, 0xba, 0x15,
-		0x69, 0xb6, 0x24, 0xc3, 0xd6, 0xa4, 0xdd, 0xa2, 0xcc, 0x5b, 0x95, 0x79, 0xcb, 0xb2, 0x6d, 0xdd,
-		0xf3, 0x5b, 0xf8, 0xc2, 0x56, 0x6e, 0xdd, 0xd2, 0xbb, 0xad, 0x9d, 0x13, 0xad, 0x99, 0xae, 0x32,
-		0x7f, 0xfb, 0x4a, 0x6c, 0xb6, 0xf9, 0xee, 0x99, 0x2d, 0x33, 0x5b, 0x6d, 0x79, 0x6d, 0xcb, 0xc7,
-		0xb6, 0x6d, 0x7d, 0x96, 0x23, 0x90, 0xe3, 0x28, 0x64, 0x3d, 0x12, 0xb9, 0x8f, 0x46, 0xee, 0x23,
-		0x92, 0xef, 0xa8, 0xbc, 0x7e, 0x64, 0xb6, 0x1c, 0x9d, 0xcd, 0x57, 0x4e, 0x6e, 0x25, 0xcd, 0xb6,
-		0xe2, 0xda, 0x28, 0xc6, 0x67, 0x69, 0x16, 0x7c, 0xad, 0x12, 0x8e, 0x3e, 0xe5, 0x7b, 0xff, 0x57,
-		0xde, 0xfd, 0xde, 0x29, 0xad, 0x9a, 0x34, 0x53, 0x78, 0xe6, 0x78, 0x2f, 0x1f, 0xc4, 0x33, 0x8e,
-		0x67, 0xbc, 0xe0, 0x21, 0x79, 0x70, 0xd8, 0xf7, 0x53, 0x7c, 0xb6, 0xc7, 0xe3, 0x68, 0xf1, 0x42,
-		0xbf, 0x76, 0x20, 0x18, 0x94, 0x93, 0x69, 0x48, 0x33, 0xe8, 0xfb, 0xf5, 0x03, 0x5b, 0x16, 0xb1,
-		0x4b, 0x03, 0x12, 0x87, 0xc9, 0xb6, 0x2d, 0x76, 0x1b, 0xe5, 0x06, 0xe5, 0xe6, 0xe9, 0x8a, 0x4f,
-		0x85, 0x08, 0x29, 0xe1, 0x59, 0xe4, 0xa5, 0xbe, 0x03, 0x19, 0x98, 0xd3, 0x30, 0x14, 0x55, 0xc3,
-		0x22, 0xaa, 0xd2, 0xcb, 0xc1, 0xfd, 0x87, 0xf0, 0x70, 0xe3, 0xe1, 0x7e, 0xb2, 0xe2, 0x31, 0xe3,
-		0xe6, 0x60, 0x3f, 0xc3, 0xd9, 0x3e, 0x4a, 0xf1, 0xd1, 0x0b, 0xc2, 0x67, 0x8b, 0xd1, 0xff, 0x9d,
-		0x6a, 0xa1, 0xd2, 0x6d, 0x60, 0x32, 0xf0, 0x90, 0xf1, 0xd4, 0x3b, 0x9e, 0xf1, 0x60, 0x3f, 0x79,
-		0xec, 0x1b, 0x09, 0x63, 0x9a, 0xe3, 0xb9, 0x53, 0x45, 0x3c, 0xc3, 0x04, 0xef, 0xb2, 0x19, 0x33,
-		0x7a, 0x31, 0x40, 0xea, 0xe7, 0x7f, 0xfd, 0x96, 0x61, 0x29, 0xc8, 0xcd, 0x9b, 0x2f, 0x45, 0xfd,
-		0x68, 0x7f, 0xff, 0xe0, 0x70, 0x7f, 0xbf, 0x76, 0xd8, 0x3c, 0xac, 0x1d, 0xb7, 0x5a, 0xf5, 0x83,
-		0x7a, 0xeb, 0x0d, 0x57, 0xe7, 0x93, 0x9d, 0x4f, 0xfd, 0xd8, 0x81, 0x72, 0xd6, 0xb1, 0x94, 0x8a,
-		0x6a, 0x5d, 0x35, 0xe1, 0x55, 0x95, 0xf8, 0x57, 0x54, 0x19, 0xa6, 0xe9, 0x4a, 0xfa, 0x53, 0xea,
-		0xea, 0x57, 0xc6, 0x40, 0xd5, 0x8d, 0xaa, 0xfb, 0xc9, 0x8a, 0x33, 0x9f, 0x72, 0xc3, 0xcc, 0xad,
-		0xa2, 0x41, 0x16, 0x6c, 0x92, 0x42, 0x62, 0x2b, 0xfd, 0xd5, 0xd0, 0x27, 0x44, 0x67, 0xd8, 0xa7,
-		0xf5, 0x8b, 0x0d, 0x06, 0xdd, 0xd1, 0xcf, 0xc9, 0xe0, 0x5b, 0xda, 0x6d, 0x4a, 0xd4, 0x8b, 0x4e,
-		0x6d, 0x2f, 0xb2, 0xd9, 0x8c, 0x07, 0x6f, 0xd6, 0xf9, 0xbd, 0x3d, 0x1e, 0xf7, 0xc7, 0x3f, 0xfb,
-		0xdd, 0xca, 0x2e, 0x94, 0x72, 0xce, 0xb7, 0x1a, 0x9d, 0x5f, 0x4c, 0xca, 0xf8, 0x4a, 0xdd, 0xde,
-		0xb8, 0x73, 0xd1, 0x1f, 0x4d, 0xfa, 0xe7, 0x67, 0x65, 0x7a, 0xb7, 0xf1, 0xff, 0x8c, 0x27, 0xbd,
-		0x61, 0xc9, 0xdf, 0xae, 0xd3, 0x1e, 0xb5, 0x4f, 0xfa, 0x83, 0xfe, 0xa4, 0xdf, 0x1b, 0x97, 0xe9,
-		0xf5, 0x86, 0xed, 0xb3, 0xf6, 0xd7, 0xde, 0xb0, 0x77, 0x36, 0xf9, 0xd9, 0xee, 0x76, 0x2f, 0x7a,
-		0xe3, 0x71, 0x09, 0x17, 0xef, 0xac, 0x3d, 0xec, 0x55, 0x2c, 0xe3, 0x82, 0x1f, 0x3b, 0xd6, 0xdf,
-		0x03, 0xa6, 0x4d, 0xdb, 0x18, 0x95, 0x4e, 0x87, 0x0f, 0x19, 0xef, 0x85, 0x89, 0x6d, 0x5f, 0xe8,
-		0x3d, 0x1e, 0x87, 0x61, 0x0a, 0xad, 0x3c, 0x24, 0x37, 0xd9, 0x1f, 0x3a, 0x57, 0x3e, 0x55, 0xd4,
-		0x3f, 0xb9, 0x5d, 0x3d, 0xb2, 0x0b, 0xf0, 0x73, 0xab, 0x0d, 0x8d, 0xaa, 0x3e, 0xd5, 0x9e, 0x62,
-		0x72, 0x81, 0xee, 0x32, 0x80, 0x9e, 0xa7, 0xcf, 0x22, 0xd8, 0x41, 0xb0, 0x63, 0x23, 0x40, 0x9f,
-		0xe2, 0xb3, 0x03, 0xca, 0x67, 0x66, 0x8e, 0x9e, 0x2a, 0x38, 0x4f, 0xb5, 0xd1, 0x42, 0xc7, 0xf4,
-		0xbe, 0x6e, 0xe6, 0x4b, 0x31, 0xca, 0xa6, 0x94, 0x93, 0x87, 0x50, 0x1b, 0xa3, 0x36, 0x46, 0x6d,
-		0x8c, 0xda, 0x18, 0xb5, 0x71, 0x5a, 0x6d, 0x9c, 0x29, 0xdb, 0xa5, 0xcd, 0xb9, 0x30, 0x64, 0x2b,
-		0x66, 0xae, 0x68, 0x6f, 0x4e, 0x23, 0x22, 0x49, 0x22, 0x1f, 0x95, 0xcf, 0x42, 0x52, 0xbe, 0x4c,
-		0x5d, 0x4a, 0x34, 0xc4, 0xe7, 0xe4, 0x3f, 0xab, 0x5c, 0xa6, 0x4f, 0xe9, 0xde, 0xea, 0x99, 0x37,
-		0xaa, 0x30, 0x6e, 0xa8, 0x0a, 0x88, 0x97, 0x44, 0x63, 0xb6, 0x64, 0x4e, 0xdd, 0xfb, 0x2c, 0x66,
-		0x4f, 0xb9, 0x93, 0x3d, 0xb5, 0xd9, 0xb6, 0xf4, 0x70, 0xe0, 0xee, 0x91, 0x74, 0x60, 0xa0, 0x8e,
-		0x60, 0xc0, 0x5d, 0x30, 0xb0, 0xed, 0x00, 0x6d, 0x3e, 0xb8, 0x25, 0xc3, 0xf2, 0xc5, 0x0d, 0x7a,
-		0x35, 0xe3, 0x32, 0xe7, 0x91, 0xca, 0x7c, 0xb4, 0xf2, 0x1c, 0xb1, 0x02, 0x47, 0x2d, 0xef, 0x91,
-		0x2b, 0x7c, 0xf4, 0x0a, 0x1f, 0xc1, 0x62, 0x47, 0x31, 0xa3, 0x4d, 0x4e, 0xb9, 0x67, 0x69, 0x8f,
-		0xe8, 0xe6, 0x81, 0xb4, 0xe9, 0x43, 0x2f, 0xee, 0x74, 0xba, 0x74, 0xa2, 0xa7, 0x2f, 0x9a, 0x29,
-		0xbd, 0xc8, 0x16, 0xea, 0xca, 0x2a, 0x03, 0x45, 0x64, 0xc1, 0x82, 0x4c, 0x14, 0x95, 0x0d, 0x6b,
-		0x32, 0x62, 0x4d, 0x56, 0xec, 0xc8, 0x4c, 0x36, 0xd9, 0xc9, 0xe1, 0x0a, 0x64, 0xf3, 0xfd, 0x5e,
-		0xdc, 0xf1, 0xf4, 0xe9, 0x51, 0x2f, 0x2a, 0xf7, 0xfa, 0xa7, 0xdd, 0x2c, 0x48, 0x16, 0xbf, 0x28,
-		0x55, 0x84, 0xe4, 0xc5, 0x45, 0x48, 0x11, 0x2a, 0x41, 0xf1, 0x46, 0xf1, 0x76, 0x51, 0xbc, 0x89,
-		0xa6, 0xd5, 0x8d, 0x07, 0x50, 0x4d, 0x97, 0x6c, 0xf0, 0xa2, 0xa4, 0x1f, 0xe6, 0x78, 0x76, 0xb4,
-		0x71, 0x7a, 0xbd, 0x2a, 0x0b, 0xbe, 0xdc, 0xf9, 0x9d, 0x8f, 0x7f, 0xb1, 0xfa, 0x7b, 0x22, 0x8b,
-		0x25, 0xd0, 0x28, 0x9a, 0x0b, 0x21, 0x19, 0x9f, 0xe5, 0xd7, 0x2a, 0x9b, 0x11, 0xf2, 0xe3, 0x8e,
-		0x80, 0x84, 0xfa, 0xa3, 0x68, 0x26, 0x21, 0x4d, 0xd5, 0x50, 0x15, 0xa1, 0x76, 0x7a, 0x46, 0x3b,
-		0x6d, 0x16, 0x07, 0x01, 0xc8, 0x1b, 0x02, 0x10, 0xab, 0x7e, 0x4f, 0xca, 0x58, 0x61, 0xae, 0xd8,
-		0xe1, 0x3d, 0xad, 0x7a, 0xa7, 0x4f, 0x5f, 0x0b, 0x28, 0x66, 0x9f, 0x6a, 0x8a, 0x69, 0x66, 0x43,
-		0x61, 0x79, 0xd0, 0x57, 0x46, 0xdd, 0x86, 0x01, 0x85, 0x8f, 0x10, 0x50, 0xc8, 0xac, 0x83, 0xee,
-		0xea, 0xb6, 0x29, 0x09, 0xb2, 0x41, 0xa2, 0x3c, 0x50, 0x68, 0x03, 0x81, 0xfe, 0xf5, 0xaf, 0x95,
-		0x4c, 0x7e, 0x4e, 0x0f, 0x72, 0x2c, 0x09, 0x26, 0x65, 0xb3, 0xf9, 0x54, 0x28, 0x9d, 0x43, 0x3a,
-		0x37, 0x8f, 0xee, 0x38, 0xe6, 0xd7, 0x40, 0x11, 0xc5, 0x98, 0xdf, 0xe3, 0x03, 0x5b, 0xc0, 0xa7,
-		0x5f, 0x8f, 0x90, 0x0f, 0x3d, 0xd7, 0xd1, 0xaf, 0x47, 0xbf, 0x7e, 0x57, 0xa8, 0x39, 0xab, 0x38,
-		0x6c, 0x1e, 0xf4, 0x88, 0x24, 0x53, 0x16, 0x32, 0xc3, 0xa8, 0xce, 0xbf, 0x67, 0x9b, 0xbb, 0x9c,
-		0xfb, 0xa3, 0xe5, 0x5c, 0xed, 0x7c, 0xe2, 0x92, 0x5b, 0xfb, 0xdb, 0x14, 0x1f, 0x8b, 0x62, 0x64,
-		0x4b, 0x9c, 0xac, 0x8b, 0x95, 0x75, 0xf1, 0xb2, 0x2b, 0x66, 0xf9, 0xc4, 0x2d, 0xa7, 0xd8, 0x15,
-		0x16, 0xbf, 0xa7, 0x62, 0x78, 0x5b, 0x7c, 0xa7, 0x9f, 0x08, 0xe3, 0x6d, 0xd1, 0xad, 0x2e, 0x26,
-		0x92, 0x85, 0x2d, 0xda, 0x2e, 0x44, 0x74, 0x07, 0xa2, 0x6a, 0x5b, 0x64, 0x77, 0x26, 0xba, 0x3b,
-		0x13, 0xe1, 0xdd, 0x88, 0x72, 0x31, 0x91, 0x2e, 0x28, 0xda, 0xd6, 0x44, 0xfc, 0x4e, 0xd4, 0xb3,
-		0xe5, 0x49, 0xa4, 0x17, 0xf7, 0x2c, 0x79, 0x14, 0x6f, 0x24, 0xf2, 0xd6, 0x45, 0x7f, 0x17, 0x2a,
-		0x60, 0x87, 0xaa, 0x60, 0x57, 0x2a, 0x61, 0xe7, 0xaa, 0x61, 0xe7, 0x2a, 0x62, 0xb7, 0xaa, 0xc2,
-		0x8e, 0xca, 0xb0, 0xa4, 0x3a, 0x8a, 0xc6, 0x6b, 0xb7, 0x8e, 0x9b, 0x3b, 0x9e, 0xbb, 0x89, 0xc4,
-		0x6c, 0xfe, 0xef, 0xf3, 0x7d, 0x04, 0x7f, 0xf7, 0x97, 0xdb, 0x4c, 0xa1, 0xdf, 0xdd, 0xef, 0x8a,
-		0x85, 0x1d, 0xc9, 0x77, 0xc1, 0xbf, 0x3d, 0x48, 0x90, 0xfd, 0xe2, 0x7f, 0x9b, 0x0e, 0xae, 0xa1,
-		0x0e, 0x46, 0x1d, 0x8c, 0x3a, 0xd8, 0xce, 0x99, 0xcd, 0x7d, 0xbd, 0xb8, 0xf5, 0xc4, 0x66, 0x0f,
-		0xfd, 0xa7, 0x06, 0x61, 0x87, 0x16, 0xc7, 0xbc, 0x77, 0x75, 0xa0, 0x0d, 0x31, 0xf4, 0x73, 0xf6,
-		0xf4, 0x88, 0x72, 0xeb, 0xf5, 0x64, 0x56, 0xf6, 0x15, 0xfb, 0x72, 0xd8, 0x92, 0xa3, 0xeb, 0x06,
-		0x6a, 0x76, 0xd4, 0xec, 0x1f, 0x54, 0xb3, 0xdb, 0x72, 0xd0, 0x37, 0x03, 0xe6, 0xcd, 0x12, 0x4f,
-		0x2d, 0x09, 0xf9, 0xb2, 0xc8, 0xdf, 0x18, 0x36, 0xee, 0x0c, 0x3e, 0xee, 0x52, 0xd9, 0xbc, 0x81,
-		0xd2, 0xd9, 0xb5, 0xf2, 0x79, 0x33, 0x25, 0xf4, 0x66, 0xca, 0xe8, 0x6d, 0x94, 0x92, 0x5d, 0xe5,
-		0x64, 0x59, 0x49, 0xed, 0x0e, 0x86, 0x3e, 0x39, 0xf1, 0xf9, 0xb3, 0xe0, 0x52, 0xa3, 0x97, 0xfa,
-		0xa7, 0x72, 0x6e, 0x98, 0xc5, 0xcd, 0xb2, 0x1b, 0x25, 0xd8, 0x65, 0xb4, 0x00, 0xd5, 0x3f, 0xaa,
-		0x7f, 0x54, 0xff, 0xa8, 0xfe, 0x73, 0x91, 0x41, 0xe6, 0x36, 0x01, 0xad, 0x1d, 0x8c, 0x9d, 0x8b,
-		0x6c, 0x32, 0xf7, 0x42, 0x25, 0xe4, 0x94, 0x8f, 0x49, 0xfa, 0xfe, 0x67, 0x57, 0x42, 0x96, 0x83,
-		0xda, 0x32, 0xeb, 0x9f, 0xff, 0xec, 0x6c, 0xe4, 0x07, 0xeb, 0x36, 0xfe, 0xf9, 0x6d, 0xd0, 0x3e,
-		0xab, 0xec, 0xec, 0xcb, 0x7e, 0xfd, 0xe6, 0xfa, 0x0a, 0x75, 0xcf, 0x3b, 0xe3, 0xfe, 0xf8, 0x67,
-		0xa7, 0x7d, 0x32, 0xe8, 0xfd, 0xec, 0xf6, 0xbe, 0xf5, 0x3b, 0x3d, 0x5c, 0xae, 0x97, 0x97, 0xeb,
-		0xfb, 0xa0, 0x7d, 0xf6, 0xb3, 0xdd, 0xe9, 0xf4, 0xc6, 0xe3, 0x9f, 0xa3, 0xf3, 0xfe, 0xd9, 0x04,
-		0x17, 0xeb, 0x15, 0xe9, 0x9b, 0xb4, 0x27, 0xfd, 0xf3, 0xb3, 0x9f, 0xe7, 0x67, 0x83, 0xff, 0xc1,
-		0x75, 0x7a, 0x79, 0x9d, 0x26, 0xdf, 0xcf, 0x7f, 0x26, 0xdc, 0xb5, 0xc3, 0x76, 0xe7, 0xe7, 0x45,
-		0x6f, 0xd0, 0xc6, 0xd5, 0x7a, 0x65, 0xb5, 0x2e, 0x7a, 0xa3, 0x5e, 0x7b, 0xd2, 0xbb, 0xc0, 0x35,
-		0x7a, 0x65, 0x8d, 0xce, 0xff, 0xc4, 0x15, 0x7a, 0x75, 0x85, 0xce, 0x27, 0xbf, 0xe3, 0x02, 0xbd,
-		0xaa, 0x94, 0x7a, 0x83, 0xde, 0xe8, 0xf7, 0xf3, 0x33, 0x84, 0x03, 0xaf, 0x2c, 0x52, 0x07, 0xf1,
-		0xe5, 0x96, 0x15, 0x5a, 0x98, 0xb4, 0x93, 0x8b, 0x7e, 0xf7, 0xeb, 0x2e, 0xcf, 0xd1, 0x4e, 0x46,
-		0xfe, 0x51, 0x76, 0x77, 0x1f, 0x13, 0xd0, 0x32, 0x8d, 0xfb, 0x16, 0x09, 0x68, 0xcb, 0xfb, 0xf7,
-		0xb2, 0xe4, 0x29, 0xbc, 0x6b, 0x2a, 0xf2, 0x1f, 0xf4, 0xd6, 0x52, 0xf0, 0x38, 0x1b, 0x4b, 0xfd,
-		0xd6, 0xd1, 0xf2, 0xb0, 0xd8, 0x6f, 0x1f, 0x34, 0x07, 0xcb, 0xfd, 0xd6, 0x41, 0x33, 0xb1, 0xe0,
-		0xef, 0x7a, 0x3f, 0x2d, 0x8b, 0xe5, 0x5b, 0x88, 0x63, 0xc5, 0x4a, 0xa2, 0x8e, 0x8a, 0x3d, 0xc3,
-		0xd7, 0x71, 0xb8, 0xd0, 0x97, 0x3f, 0xfb, 0xeb, 0x57, 0xf9, 0x79, 0xb6, 0x7a, 0x81, 0x9f, 0x9d,
-		0xbb, 0xef, 0xfc, 0xf4, 0x3e, 0x22, 0xfb, 0xb6, 0xa5, 0x44, 0x96, 0x0e, 0xc3, 0xce, 0x0e, 0x41,
-		0xbe, 0x5d, 0xc8, 0xbe, 0x86, 0x39, 0xd6, 0xaf, 0x68, 0x69, 0x85, 0x9d, 0x52, 0x0a, 0x6b, 0x05,
-		0x8c, 0x35, 0x2c, 0x60, 0x7c, 0x64, 0xf5, 0xb0, 0x80, 0xf1, 0x43, 0x69, 0x9d, 0x02, 0x25, 0x07,
-		0x6f, 0xa4, 0x6f, 0x62, 0x6d, 0x44, 0x54, 0x35, 0xe1, 0x95, 0x8d, 0xda, 0xe9, 0x7b, 0x83, 0x61,
-		0xe9, 0x34, 0x6a, 0x9e, 0x0f, 0xa2, 0x79, 0x0a, 0x97, 0x4e, 0x9b, 0xf0, 0xca, 0x5e, 0xcd, 0xf4,
-		0x62, 0x30, 0x2c, 0x96, 0x7e, 0x03, 0xe1, 0xb4, 0x2d, 0xa4, 0x3b, 0x13, 0xd6, 0x9d, 0x09, 0xed,
-		0x6e, 0x84, 0xb7, 0x1c, 0x11, 0x0a, 0x2c, 0x96, 0x2e, 0x83, 0xe8, 0xef, 0x42, 0x05, 0xec, 0x50,
-		0x15, 0xec, 0x4a, 0x25, 0xec, 0x5c, 0x35, 0xec, 0x5c, 0x45, 0xec, 0x56, 0x55, 0xd8, 0x0b, 0x8b,
-		0xee, 0x7d, 0xb4, 0x58, 0xf5, 0x1d, 0x64, 0xff, 0x6c, 0xc2, 0x2b, 0x80, 0x45, 0xd2, 0x22, 0x66,
-		0xf6, 0x95, 0xef, 0x62, 0x50, 0x2c, 0x91, 0x46, 0xcd, 0x8b, 0x9a, 0xb7, 0x94, 0x9a, 0x17, 0x4b,
-		0xa4, 0x1f, 0x97, 0x48, 0x2f, 0x14, 0x16, 0x2c, 0xa5, 0x5e, 0xd5, 0xf1, 0xd4, 0xd8, 0xdc, 0xe7,
-		0xfb, 0xca, 0x7d, 0x33, 0x38, 0x2a, 0x79, 0x54, 0xf2, 0xa8, 0xe4, 0x51, 0xc9, 0xbb, 0xa2, 0xe4,
-		0x37, 0x8a, 0x0b, 0xe9, 0x30, 0xb6, 0xed, 0x32, 0xd2, 0x61, 0xa0, 0x82, 0x47, 0x05, 0x5f, 0x62,
-		0x05, 0x6f, 0x9d, 0x0e, 0xc3, 0x66, 0x28, 0x60, 0x87, 0x21, 0x81, 0x1d, 0xa1, 0xc6, 0x9d, 0xa1,
-		0xc7, 0x5d, 0x2a, 0x99, 0x37, 0x50, 0x36, 0xbb, 0x56, 0x3a, 0x6f, 0xa6, 0x7c, 0xde, 0x4c, 0x09,
-		0xbd, 0x8d, 0x32, 0xb2, 0xab, 0x94, 0x2c, 0x2b, 0xa7, 0xdd, 0xa1, 0xd0, 0x67, 0x70, 0x8a, 0xca,
-		0xde, 0x30, 0x2d, 0x13, 0x68, 0x39, 0xfa, 0x00, 0x2c, 0x18, 0xbb, 0x88, 0x18, 0xbc, 0x41, 0xe4,
-		0x00, 0x6d, 0x01, 0xda, 0x02, 0xb4, 0x05, 0x68, 0x0b, 0xd0, 0x16, 0x58, 0xb4, 0x05, 0xbb, 0x35,
-		0x02, 0xa8, 0xfd, 0x51, 0xfb, 0xa3, 0xf6, 0x47, 0xed, 0x6f, 0xff, 0xc4, 0x33, 0x6e, 0x9a, 0x8d,
-		0x1d, 0x2a, 0xff, 0xe6, 0x0e, 0x86, 0xbe, 0x20, 0x7c, 0x46, 0x77, 0xc6, 0x16, 0xb4, 0xbb, 0x3a,
-		0xe5, 0xca, 0x90, 0xf1, 0x9d, 0x29, 0x80, 0x27, 0x56, 0xf7, 0xb7, 0xdd, 0x7e, 0x4d, 0xc2, 0xd9,
-		0x54, 0xf9, 0xb2, 0xd7, 0xa8, 0xef, 0x1f, 0xee, 0x1f, 0x35, 0x0f, 0xf6, 0x8f, 0x76, 0xfc, 0x85,
-		0xa7, 0x8a, 0x78, 0x86, 0x09, 0xde, 0x65, 0x33, 0x96, 0x14, 0x3d, 0xd6, 0x5c, 0xac, 0x82, 0xaf,
-		0x0c, 0xc9, 0xcd, 0x9b, 0x9d, 0x81, 0xda, 0x5b, 0x9f, 0x81, 0x43, 0x40, 0x67, 0xe0, 0x93, 0x1b,
-		0xa3, 0xfe, 0xf8, 0x00, 0xe0, 0xfa, 0x6a, 0x75, 0xce, 0x76, 0x84, 0xae, 0x97, 0xc3, 0x23, 0xbc,
-		0x46, 0x78, 0x8d, 0xf0, 0x1a, 0xe1, 0xb5, 0xd5, 0x13, 0x3f, 0x65, 0x9c, 0xa8, 0xdb, 0x1d, 0xe2,
-		0xeb, 0x63, 0xa4, 0x78, 0x49, 0x73, 0xd6, 0x1d, 0x4c, 0x9b, 0x2f, 0x17, 0xb5, 0x8b, 0x85, 0x9c,
-		0x9b, 0xdd, 0x64, 0x56, 0x62, 0x4a, 0x65, 0x59, 0x6d, 0x33, 0x66, 0xdc, 0xbc, 0x8f, 0xed, 0xc5,
-		0x94, 0xca, 0xc2, 0xb1, 0x93, 0x9d, 0xa6, 0x54, 0x96, 0x2a, 0x97, 0xb2, 0x0c, 0x94, 0x5d, 0x8b,
-		0x05, 0xd9, 0x13, 0x31, 0xdb, 0xb3, 0x77, 0xd7, 0x8d, 0x14, 0x5e, 0x48, 0xe1, 0x95, 0x09, 0x6e,
-		0xbd, 0x1d, 0x75, 0xd7, 0x24, 0xbc, 0x42, 0xce, 0xae, 0x12, 0xec, 0x7a, 0x99, 0x29, 0x74, 0x98,
-		0x5f, 0x9c, 0x39, 0x87, 0xf9, 0x05, 0x09, 0x73, 0x6a, 0x48, 0xd5, 0xb5, 0x87, 0x84, 0x39, 0x8e,
-		0x28, 0x9b, 0xc2, 0x30, 0xd3, 0x22, 0xac, 0xb4, 0x01, 0x23, 0x9f, 0xc2, 0x46, 0xe6, 0x97, 0x59,
-		0x63, 0x15, 0x2b, 0xa8, 0xb1, 0x52, 0x40, 0x83, 0x44, 0x5f, 0xa8, 0xb7, 0x3e, 0x1e, 0xd1, 0x17,
-		0x99, 0x51, 0x7b, 0x44, 0x5f, 0x8b, 0xc1, 0xec, 0x10, 0x7d, 0xd5, 0x90, 0xe8, 0xeb, 0x3d, 0x62,
-		0x64, 0x48, 0xf4, 0x55, 0x86, 0xb8, 0x86, 0xb5, 0x98, 0xd7, 0xe6, 0xc4, 0xc5, 0x8c, 0x9b, 0x83,
-		0x7d, 0x1b, 0x07, 0x6e, 0x25, 0x9f, 0x16, 0xb2, 0x75, 0x2c, 0xe7, 0x61, 0x59, 0x8c, 0x10, 0xee,
-		0x22, 0xcf, 0x6a, 0x57, 0x17, 0xfa, 0xeb, 0x1c, 0x1a, 0xdb, 0xe3, 0xee, 0x30, 0x55, 0xc6, 0x66,
-		0x6a, 0xc7, 0x2e, 0xd2, 0xa1, 0x76, 0xbd, 0x55, 0xf5, 0xa3, 0xfd, 0xfd, 0x83, 0xc3, 0xfd, 0xfd,
-		0xda, 0x61, 0xf3, 0xb0, 0x76, 0xdc, 0x6a, 0xd5, 0x0f, 0x6c, 0x77, 0x7c, 0xdc, 0xe9, 0xee, 0x95,
-		0x24, 0xf4, 0xfc, 0xe3, 0xbd, 0x82, 0x60, 0x05, 0x20, 0xb5, 0x37, 0x27, 0x5a, 0x33, 0x5d, 0x65,
-		0xbe, 0x3d, 0xcc, 0x75, 0x6f, 0x4c, 0x84, 0x5e, 0x08, 0xbd, 0x10, 0x7a, 0x95, 0x0c, 0x7a, 0x59,
-		0xab, 0x8f, 0xb2, 0x54, 0x0f, 0xf5, 0xde, 0x9a, 0xaf, 0x6a, 0x25, 0x8f, 0xe3, 0x19, 0xf5, 0x57,
-		0xb5, 0x71, 0xef, 0x87, 0x3a, 0x10, 0x75, 0x20, 0xea, 0x40, 0xdb, 0x3a, 0xd0, 0xae, 0x90, 0x3e,
-		0x50, 0x86, 0xfb, 0x16, 0xc6, 0xea, 0xf1, 0x38, 0x5a, 0x4c, 0xf8, 0x97, 0x83, 0x8a, 0xd5, 0x26,
-		0x94, 0x44, 0x08, 0x89, 0xea, 0x13, 0xd5, 0x27, 0x42, 0xc8, 0x72, 0x6a, 0xba, 0x90, 0x68, 0x53,
-		0x8d, 0xa5, 0x6f, 0x83, 0x79, 0xef, 0xee, 0xb6, 0xf6, 0xde, 0xa0, 0xa8, 0xfb, 0x50, 0xf7, 0xa1,
-		0xee, 0x2b, 0x99, 0xee, 0xb3, 0x7d, 0x71, 0xb1, 0x8f, 0x17, 0x17, 0x39, 0x6d, 0xc6, 0x8e, 0xa2,
-		0xe1, 0xc7, 0x8d, 0x46, 0xb3, 0x79, 0xd8, 0xa8, 0x35, 0x0f, 0x8e, 0x5a, 0xfb, 0x87, 0x87, 0xad,
-		0xa3, 0xda, 0x11, 0x5e, 0x65, 0xd8, 0xde, 0xbc, 0xda, 0x9b, 0x6d, 0xde, 0x21, 0xde, 0x64, 0x64,
-		0xfd, 0xe3, 0xe2, 0x4d, 0x46, 0x44, 0x38, 0x99, 0x25, 0xc9, 0xe7, 0x55, 0xe2, 0xfb, 0x8a, 0x6a,
-		0x6d, 0x0f, 0x93, 0x3d, 0x33, 0x36, 0x42, 0x33, 0x84, 0x66, 0x08, 0xcd, 0xd0, 0x2d, 0x75, 0x42,
-		0x13, 0x5a, 0xbe, 0xe1, 0x78, 0xe9, 0x0b, 0x50, 0x27, 0xa2, 0x4e, 0x44, 0x9d, 0x88, 0x3a, 0xb1,
-		0x84, 0x3a, 0x51, 0x0a, 0x65, 0xaa, 0x3e, 0xd5, 0x9e, 0x62, 0xd2, 0x4a, 0xf9, 0xde, 0x66, 0x7d,
-		0x9f, 0x8c, 0x8c, 0x5a, 0x10, 0xb5, 0x20, 0x6a, 0x41, 0xd4, 0x82, 0x65, 0xd5, 0x82, 0x36, 0xef,
-		0x67, 0xd7, 0x03, 0xa2, 0xce, 0x43, 0x9d, 0x87, 0x3a, 0x0f, 0x75, 0x5e, 0x79, 0x75, 0x9e, 0x65,
-		0x17, 0xf8, 0xc1, 0xa8, 0xa8, 0xfd, 0x50, 0xfb, 0xa1, 0xf6, 0x2b, 0x99, 0xf6, 0xb3, 0x28, 0xa1,
-		0x7b, 0x98, 0xde, 0x77, 0xf7, 0xee, 0xfa, 0x56, 0x1b, 0x1a, 0xed, 0xc6, 0x97, 0x7e, 0x66, 0x6c,
-		0xd4, 0xad, 0xa8, 0x5b, 0x51, 0xb7, 0xc2, 0x47, 0x96, 0x16, 0xc6, 0x1a, 0x50, 0x3e, 0x4b, 0x28,
-		0x46, 0xb0, 0x7c, 0xb7, 0xc0, 0xb0, 0x58, 0xbe, 0xeb, 0xdc, 0x56, 0x35, 0x5a, 0x58, 0xad, 0x9b,
-		0xf9, 0xcf, 0x0f, 0x77, 0xb1, 0xd7, 0x8a, 0x90, 0xcf, 0x2e, 0xe8, 0x4a, 0x06, 0x45, 0xb4, 0x85,
-		0x68, 0x0b, 0xd1, 0x16, 0xa2, 0x2d, 0x44, 0x5b, 0x88, 0xb6, 0x10, 0x6d, 0x21, 0xda, 0xfa, 0xd0,
-		0x68, 0xcb, 0x98, 0xd0, 0x1e, 0xca, 0x5a, 0x0c, 0x86, 0xe8, 0x0a, 0xd1, 0x15, 0xa2, 0xab, 0x92,
-		0xa1, 0xab, 0x98, 0x71, 0x53, 0x3f, 0xb0, 0x88, 0xae, 0x0e, 0xb0, 0x9e, 0x0b, 0xb1, 0x15, 0x62,
-		0xab, 0x5c, 0x5b, 0x75, 0xd0, 0x6a, 0x35, 0x11, 0x5d, 0xb9, 0x83, 0xae, 0x3e, 0x7c, 0xfb, 0x85,
-		0x02, 0x3d, 0xcd, 0x72, 0xd0, 0x98, 0x7f, 0xda, 0xe1, 0x32, 0xaf, 0x7b, 0xd7, 0x64, 0xce, 0xe6,
-		0x2b, 0xd6, 0x99, 0xc6, 0x4a, 0x27, 0x1a, 0x2b, 0x9d, 0x67, 0x8a, 0x75, 0x9a, 0xc9, 0xba, 0xda,
-		0x05, 0x0f, 0xb3, 0xcd, 0x43, 0x5c, 0xc9, 0xc5, 0x8e, 0x9f, 0xa2, 0x33, 0x4c, 0x36, 0xb9, 0x48,
-		0x7f, 0xba, 0xd3, 0x7d, 0x32, 0xe5, 0x8e, 0xe4, 0xdd, 0x09, 0x0b, 0x3b, 0x90, 0x6e, 0x7d, 0xb6,
-		0xcf, 0x36, 0xc5, 0x4c, 0x33, 0xb6, 0x33, 0xc8, 0xd5, 0xbe, 0x20, 0x63, 0x5d, 0x7e, 0xe6, 0xf6,
-		0x04, 0x79, 0x1c, 0xce, 0x02, 0x8e, 0x65, 0x5e, 0x07, 0xb2, 0xb0, 0xa3, 0x58, 0xd8, 0x21, 0x2c,
-		0xe6, 0xf8, 0xd9, 0x95, 0xae, 0xac, 0xed, 0x00, 0x2a, 0x9e, 0x88, 0x17, 0x92, 0x92, 0xbd, 0x7a,
-		0xfb, 0x8e, 0xdb, 0x6d, 0x3d, 0x42, 0x56, 0x03, 0x98, 0x8b, 0x56, 0x22, 0x77, 0x3c, 0xa5, 0x48,
-		0xfc, 0xc4, 0x42, 0xbc, 0xa4, 0x68, 0x7c, 0xc4, 0x5a, 0x3c, 0xc4, 0x5a, 0xfc, 0xc3, 0x4e, 0xbc,
-		0x63, 0xb7, 0x20, 0x2b, 0x6f, 0x77, 0x8c, 0x4a, 0xa0, 0x48, 0x44, 0xab, 0x3e, 0xd3, 0x1e, 0x51,
-		0x16, 0x7a, 0x69, 0x3d, 0x1c, 0x0e, 0xdb, 0x6a, 0x61, 0x7b, 0x9a, 0x77, 0x0b, 0x2c, 0xba, 0xda,
-		0x56, 0x6b, 0x65, 0x66, 0x0a, 0x71, 0x41, 0x59, 0x68, 0x5e, 0x61, 0x29, 0x56, 0x68, 0xa7, 0xa7,
-		0xa8, 0xc5, 0x68, 0xbb, 0xdd, 0xce, 0xd5, 0x96, 0x63, 0x81, 0xbb, 0x08, 0x2a, 0xfd, 0xb2, 0xd3,
-		0x81, 0xb5, 0xf4, 0x5b, 0xb0, 0xbb, 0x66, 0x13, 0x3b, 0xd9, 0x95, 0x77, 0x0a, 0xad, 0xfd, 0x28,
-		0x71, 0x6b, 0xbc, 0x25, 0x78, 0xa0, 0x4a, 0x09, 0x55, 0x2d, 0x20, 0xf3, 0x8f, 0xc0, 0xc8, 0x66,
-		0x3c, 0x44, 0x23, 0x88, 0x46, 0x10, 0x8d, 0x20, 0x1a, 0x41, 0x34, 0x82, 0x68, 0x04, 0xd1, 0x08,
-		0xa2, 0x91, 0x0c, 0x68, 0x44, 0xc4, 0xc6, 0x2e, 0x1c, 0x59, 0x0c, 0x88, 0x78, 0x04, 0xf1, 0x08,
-		0xe2, 0x11, 0xc4, 0x23, 0x88, 0x47, 0x10, 0x8f, 0x20, 0x1e, 0x41, 0x3c, 0x92, 0x0a, 0x8f, 0xd8,
-		0x8b, 0x8b, 0x60, 0x44, 0x04, 0x11, 0x08, 0x22, 0x10, 0x44, 0x20, 0x88, 0x40, 0x10, 0x81, 0x20,
-		0x02, 0x41, 0x04, 0x92, 0x16, 0x81, 0x58, 0x8c, 0x85, 0x60, 0x14, 0x04, 0x31, 0x08, 0x62, 0x10,
-		0xc4, 0x20, 0x88, 0x41, 0x10, 0x83, 0x20, 0x06, 0x41, 0x0c, 0x92, 0x62, 0x99, 0x93, 0x36, 0xa6,
-		0x5e, 0x48, 0x89, 0x2a, 0x0e, 0x42, 0xee, 0x8d, 0x85, 0x28, 0x04, 0x51, 0x08, 0xa2, 0x90, 0x8c,
-		0x27, 0xc6, 0x27, 0x86, 0x56, 0x09, 0xf7, 0xab, 0x86, 0x15, 0xe2, 0xf8, 0xb2, 0xc1, 0x1f, 0x54,
-		0x19, 0x11, 0x63, 0xa8, 0xe2, 0x85, 0xc1, 0x48, 0xe5, 0xef, 0xbf, 0xfd, 0xff, 0xec, 0xff, 0xaa,
-		0x2e, 0x7e, 0x34, 0xd6, 0x3f, 0x26, 0xcb, 0x1f, 0x5f, 0x1e, 0xfc, 0xf8, 0xaf, 0xbf, 0xff, 0xfe,
-		0xd7, 0xdf, 0x7f, 0xfb, 0xff, 0xf7, 0xbf, 0xff, 0x9f, 0xff, 0xfa, 0x7f, 0xff, 0xbf, 0x7f, 0xff,
-		0xfd, 0xf7, 0xff, 0xfd, 0xfb, 0xef, 0xea, 0x8f, 0x07, 0x9f, 0xf8, 0xef, 0x0a, 0x48, 0x1d, 0x6c,
-		0xc2, 0x2b, 0x7b, 0x15, 0x03, 0xf7, 0x07, 0x43, 0x2d, 0x8c, 0x5a, 0x18, 0xb5, 0x30, 0xfa, 0x82,
-		0xe8, 0x0b, 0xa2, 0x2f, 0x88, 0xbe, 0x20, 0xfa, 0x82, 0x29, 0x70, 0x48, 0xcc, 0x2f, 0xb9, 0xb8,
-		0xe6, 0x76, 0x70, 0xc8, 0x7a, 0x30, 0xc4, 0x21, 0x88, 0x43, 0x10, 0x87, 0x20, 0x0e, 0x41, 0x1c,
-		0x82, 0x38, 0x04, 0x71, 0xc8, 0xc7, 0xc2, 0x21, 0x30, 0xd9, 0x99, 0x12, 0xbe, 0x9d, 0xcf, 0x39,
-		0x19, 0x4c, 0xf6, 0xb6, 0x32, 0x33, 0x75, 0xd6, 0x03, 0xef, 0x8a, 0x99, 0x29, 0x03, 0xbd, 0x0e,
-		0xe5, 0x64, 0x1a, 0x52, 0x3f, 0x3f, 0xcf, 0xcb, 0x7a, 0x80, 0xac, 0x14, 0x1c, 0x34, 0x20, 0x71,
-		0x98, 0x60, 0x80, 0x05, 0xa4, 0xc8, 0xc9, 0x12, 0x53, 0x43, 0x96, 0x98, 0x37, 0x05, 0x85, 0x1f,
-		0x8a, 0x25, 0x26, 0x37, 0xd8, 0xdb, 0xec, 0xf8, 0x54, 0x88, 0x90, 0x92, 0x3c, 0x1e, 0xd2, 0x26,
-		0xc8, 0x5f, 0x2f, 0x81, 0x8e, 0xc8, 0xd5, 0xe9, 0x64, 0xb3, 0x08, 0x39, 0x5a, 0x9a, 0xa0, 0x78,
-		0xa3, 0x78, 0xbb, 0x20, 0xde, 0x44, 0xd3, 0xea, 0x06, 0x35, 0x54, 0x15, 0x0d, 0x8a, 0x48, 0xfa,
-		0x61, 0x8e, 0x67, 0x47, 0x1b, 0x6c, 0xe3, 0x55, 0x59, 0xf0, 0xe5, 0x1e, 0x98, 0x79, 0xf4, 0x8b,
-		0xd5, 0xdf, 0x13, 0x59, 0x44, 0x3e, 0xc8, 0x17, 0x31, 0x9f, 0x35, 0x2e, 0xc8, 0x4f, 0x05, 0xd6,
-		0x60, 0xcd, 0x01, 0x9b, 0x42, 0x71, 0x66, 0x63, 0x7d, 0xcd, 0xc5, 0xf2, 0x9a, 0x8b, 0xd5, 0x35,
-		0x1b, 0x8b, 0xeb, 0xb6, 0xf5, 0xc8, 0x78, 0x16, 0x72, 0x9f, 0x81, 0x4a, 0x2a, 0x06, 0xcf, 0x97,
-		0x81, 0xfd, 0xeb, 0xa7, 0xe7, 0xe5, 0x33, 0xf1, 0xfc, 0xbf, 0xbc, 0xb0, 0x2a, 0x69, 0x57, 0x23,
-		0xe3, 0x2a, 0x3c, 0xff, 0xee, 0x4f, 0xdf, 0xec, 0x99, 0xb7, 0xda, 0xc2, 0x6a, 0x9a, 0x8a, 0xc5,
-		0x74, 0x0b, 0xed, 0xe3, 0x56, 0x96, 0xd2, 0x34, 0x16, 0x3c, 0x83, 0xa5, 0x4e, 0x6b, 0x91, 0x33,
-		0x5b, 0xde, 0xcc, 0x16, 0x36, 0x9b, 0x25, 0xcd, 0x76, 0x92, 0xb6, 0xd1, 0x20, 0x56, 0xbc, 0x39,
-		0xd1, 0x9a, 0xe9, 0x2a, 0xdb, 0xee, 0x1f, 0xde, 0x05, 0x36, 0xef, 0x9e, 0xd9, 0xa6, 0xe6, 0x52,
-		0x81, 0xbc, 0xd4, 0xa0, 0x2e, 0x0b, 0x88, 0xcb, 0x01, 0xda, 0xb2, 0x82, 0xb4, 0xdc, 0xa0, 0x2c,
-		0x37, 0x08, 0xcb, 0x07, 0xba, 0x8a, 0x99, 0xaa, 0xd4, 0x20, 0x2a, 0x7b, 0x1f, 0xb5, 0xbb, 0x3c,
-		0xa7, 0xbc, 0x6a, 0xf5, 0xb7, 0x34, 0x27, 0x7b, 0xd9, 0x9a, 0x3c, 0xc7, 0xf1, 0x4e, 0xd3, 0xd3,
-		0x1c, 0xcf, 0xf8, 0x87, 0x3c, 0xe3, 0xd9, 0x0e, 0xc9, 0x5e, 0xc6, 0xc6, 0xf6, 0x29, 0x1b, 0xd7,
-		0xe7, 0x14, 0x8c, 0xb4, 0xc4, 0xcf, 0x59, 0x89, 0x9e, 0x53, 0x12, 0x3b, 0xa3, 0x28, 0x94, 0x59,
-		0x14, 0xd2, 0x12, 0x27, 0x57, 0x28, 0x37, 0x8a, 0x51, 0x5d, 0x25, 0x33, 0xea, 0x67, 0xaa, 0x81,
-		0xbb, 0x17, 0x57, 0x7e, 0x34, 0x42, 0x36, 0xfa, 0xfb, 0x5a, 0x56, 0xfa, 0xfb, 0x1a, 0xd2, 0xdf,
-		0x5b, 0x0d, 0x05, 0x95, 0x89, 0xfe, 0x3e, 0x73, 0xa8, 0xa7, 0xd0, 0x35, 0x7d, 0x8e, 0x6b, 0xf9,
-		0x9c, 0xd7, 0xf0, 0xf9, 0x1a, 0xbd, 0x14, 0x88, 0x33, 0x16, 0x4b, 0xd9, 0x29, 0x78, 0x8d, 0x6e,
-		0xe3, 0x82, 0xf6, 0x57, 0xbe, 0xb6, 0x36, 0xef, 0xbe, 0x64, 0xf6, 0xae, 0xbd, 0xad, 0xac, 0xe2,
-		0x8e, 0x42, 0x88, 0x3f, 0xde, 0xb0, 0x15, 0x4b, 0x3e, 0x2a, 0xff, 0x42, 0xd4, 0xfd, 0x68, 0x9b,
-		0xd0, 0x36, 0xa1, 0x6d, 0x42, 0xdb, 0x84, 0xb6, 0x09, 0x6d, 0x53, 0x0a, 0xdb, 0x94, 0x99, 0xda,
-		0xbd, 0x18, 0x95, 0x3b, 0x5a, 0x27, 0xb4, 0x4e, 0x68, 0x9d, 0xd0, 0x3a, 0xa1, 0x75, 0x42, 0xeb,
-		0x94, 0xc2, 0x3a, 0xe5, 0xb7, 0x4b, 0x68, 0x91, 0xd0, 0x22, 0xa1, 0x45, 0x42, 0x8b, 0x84, 0x16,
-		0x09, 0x2d, 0x92, 0x4d, 0x8b, 0x94, 0xeb, 0x9a, 0x29, 0x2b, 0xb5, 0x22, 0xda, 0x24, 0xb4, 0x49,
-		0x68, 0x93, 0xd0, 0x26, 0xa1, 0x4d, 0x42, 0x9b, 0xf4, 0xea, 0x32, 0xe4, 0xa0, 0xde, 0xcb, 0x4f,
-		0xb5, 0x87, 0x56, 0x09, 0xad, 0x92, 0x45, 0xab, 0x94, 0x97, 0xaa, 0x2e, 0x0f, 0x35, 0x5d, 0x6e,
-		0x2a, 0xba, 0x77, 0xa2, 0x9e, 0x7b, 0x4b, 0x1d, 0x62, 0xc2, 0xab, 0x2a, 0xf1, 0x3c, 0x2a, 0x0d,
-		0xcd, 0x71, 0x45, 0xfd, 0xe0, 0x69, 0xd4, 0x23, 0xa8, 0x47, 0x10, 0xdd, 0x22, 0xba, 0x45, 0x74,
-		0x8b, 0xe8, 0xd6, 0x92, 0x65, 0xca, 0x9d, 0x3b, 0x95, 0x9d, 0xc4, 0x14, 0xed, 0x12, 0xda, 0x25,
-		0xb4, 0x4b, 0x68, 0x97, 0xd0, 0x2e, 0xa1, 0x5d, 0xda, 0x6a, 0x97, 0xb2, 0x92, 0x5c, 0x16, 0x20,
-		0xb5, 0x44, 0xbb, 0x84, 0x76, 0x09, 0xed, 0x12, 0xda, 0x25, 0xb4, 0x4b, 0x1f, 0xd9, 0x2e, 0x95,
-		0x8e, 0xdc, 0x24, 0x33, 0x7f, 0xe1, 0x73, 0xb4, 0x26, 0xe9, 0x58, 0x0a, 0xf3, 0x55, 0x19, 0xa7,
-		0x65, 0x1d, 0xcc, 0xc8, 0x32, 0x98, 0x91, 0x55, 0x10, 0xab, 0xf3, 0x2d, 0x5b, 0x63, 0x37, 0xaa,
-		0xf3, 0xd3, 0xb3, 0xf2, 0xa5, 0x64, 0xe1, 0xcb, 0x27, 0x03, 0x73, 0x1a, 0x86, 0x22, 0xb9, 0x61,
-		0x51, 0xe9, 0xe5, 0xe0, 0xfe, 0x43, 0x78, 0xb8, 0xf1, 0x70, 0x3f, 0x59, 0xf1, 0x98, 0x71, 0x93,
-		0x0a, 0x3f, 0x66, 0xc0, 0x8d, 0x19, 0xf1, 0x62, 0x06, 0xe0, 0x9b, 0x07, 0x1f, 0xe6, 0x25, 0x87,
-		0xcc, 0x89, 0x07, 0x8b, 0x20, 0x98, 0x2c, 0x24, 0x9b, 0x79, 0x70, 0x5f, 0xd1, 0xa5, 0x28, 0x8e,
-		0xf3, 0x0a, 0xad, 0x8e, 0x25, 0xfc, 0xf5, 0x63, 0x07, 0xca, 0x59, 0xc7, 0x52, 0x2a, 0xaa, 0x75,
-		0x35, 0xb9, 0x44, 0xf5, 0xaf, 0xa8, 0x32, 0x4c, 0xd3, 0x95, 0xf4, 0xa7, 0xd4, 0xd5, 0xaf, 0x8c,
-		0x81, 0xaa, 0x1b, 0x55, 0xf7, 0x93, 0x15, 0x67, 0x3e, 0xe5, 0x86, 0x99, 0xdb, 0x74, 0x3c, 0xa2,
-		0x1b, 0x6c, 0x92, 0x42, 0x62, 0x2b, 0xfd, 0xd5, 0xd0, 0x27, 0x44, 0xd3, 0xec, 0x71, 0xb1, 0xc1,
-		0xa0, 0x3b, 0xfa, 0x39, 0x19, 0x7c, 0x4b, 0xbb, 0x4d, 0x89, 0x7a, 0xd1, 0x99, 0xe2, 0x0b, 0x39,
-		0xb9, 0x85, 0x3b, 0xbf, 0xb7, 0xc7, 0xe3, 0xfe, 0xf8, 0x67, 0xbf, 0x5b, 0xd9, 0x85, 0x52, 0xce,
-		0xf9, 0x56, 0xa3, 0xf3, 0x8b, 0x49, 0x19, 0x5f, 0xa9, 0xdb, 0x1b, 0x77, 0x2e, 0xfa, 0xa3, 0x49,
-		0xff, 0xfc, 0xac, 0x4c, 0xef, 0x36, 0xfe, 0x9f, 0xf1, 0xa4, 0x37, 0x2c, 0xf9, 0xdb, 0x75, 0xda,
-		0xa3, 0xf6, 0x49, 0x7f, 0xd0, 0x9f, 0xf4, 0x7b, 0xe3, 0x32, 0xbd, 0xde, 0xb0, 0x7d, 0xd6, 0xfe,
-		0xda, 0x1b, 0xf6, 0xce, 0x26, 0x3f, 0xdb, 0xdd, 0xee, 0x45, 0x6f, 0x3c, 0x2e, 0xe1, 0xe2, 0x9d,
-		0xb5, 0x87, 0x3d, 0xdb, 0xd1, 0xd8, 0x1f, 0x3b, 0xd6, 0xdf, 0x30, 0x98, 0x75, 0xf3, 0x81, 0x9f,
-		0x5b, 0x6d, 0x68, 0x54, 0xf5, 0xa9, 0xf6, 0x14, 0x93, 0xa9, 0x42, 0x53, 0x77, 0xa0, 0xe7, 0xe9,
-		0xb3, 0x08, 0x76, 0x10, 0xec, 0x3c, 0x3d, 0x27, 0xd9, 0x69, 0x40, 0x53, 0x7c, 0x76, 0x40, 0xf9,
-		0x2c, 0x09, 0x8c, 0xa2, 0xa7, 0x0a, 0xcb, 0x53, 0x6d, 0xb4, 0xd0, 0x31, 0xbd, 0xaf, 0x9b, 0x53,
-		0xb5, 0xe4, 0x78, 0xac, 0x94, 0xd3, 0x10, 0xca, 0xa3, 0x36, 0x46, 0x6d, 0x8c, 0xda, 0x18, 0xb5,
-		0x31, 0x6a, 0xe3, 0xd7, 0xff, 0xe5, 0x2d, 0xba, 0x33, 0xbc, 0xd2, 0x92, 0xe4, 0x99, 0xc6, 0x0c,
-		0x9f, 0x5e, 0x79, 0xbd, 0x6d, 0xaf, 0x95, 0xe6, 0x75, 0x2a, 0xcf, 0x76, 0x7e, 0x78, 0x7c, 0x6d,
-		0xfc, 0xf0, 0x75, 0xef, 0x5e, 0xea, 0xde, 0x0b, 0x55, 0x24, 0xf1, 0x2e, 0xa9, 0xa9, 0x1a, 0x45,
-		0xb8, 0x96, 0x82, 0xfb, 0xcf, 0x5c, 0x80, 0x6d, 0x34, 0xd3, 0x33, 0x9f, 0x7d, 0x34, 0xb5, 0xe7,
-		0x79, 0xa5, 0x5f, 0xb4, 0x50, 0xaf, 0x59, 0xa4, 0xbb, 0x6f, 0xbd, 0xdc, 0x7c, 0xa5, 0x7a, 0x2e,
-		0x5a, 0xbb, 0xcd, 0xee, 0xa4, 0xb6, 0x33, 0xa9, 0xed, 0xca, 0x03, 0x3b, 0xf2, 0xf0, 0xf5, 0x32,
-		0x1e, 0x85, 0x97, 0x38, 0x9c, 0x2b, 0xde, 0x7a, 0xc5, 0xb6, 0xf4, 0xf1, 0x58, 0x7d, 0xae, 0x60,
-		0x23, 0x8f, 0x9a, 0x85, 0x46, 0x1e, 0xdb, 0xb6, 0x29, 0x2b, 0x4c, 0x78, 0xe3, 0x76, 0x1e, 0x5b,
-		0xb6, 0x31, 0x9f, 0x02, 0xda, 0xda, 0xd4, 0x83, 0x84, 0xa1, 0xb8, 0xae, 0x8a, 0x2b, 0xaa, 0x74,
-		0x3c, 0xcd, 0xe1, 0xe1, 0xbf, 0xf0, 0x7c, 0xfa, 0xcc, 0x8c, 0x80, 0x84, 0xda, 0x09, 0x1c, 0x9a,
-		0xf6, 0x78, 0x39, 0x8a, 0x46, 0x53, 0x1e, 0xbf, 0xf7, 0xc2, 0xa4, 0x6f, 0x99, 0xa6, 0xf1, 0x16,
-		0x26, 0xfe, 0xa1, 0x25, 0x53, 0xe6, 0xf3, 0x53, 0xd3, 0xf6, 0x79, 0xa5, 0x5a, 0x0b, 0xf4, 0x62,
-		0xba, 0xd7, 0xd1, 0x69, 0xab, 0x22, 0xbf, 0xf7, 0x59, 0x54, 0xe6, 0x6e, 0x2a, 0xf3, 0xbb, 0xde,
-		0x65, 0xa9, 0xf5, 0x77, 0xda, 0x76, 0x67, 0xa5, 0x68, 0xd8, 0x81, 0x2a, 0xd8, 0x9e, 0x0a, 0x4e,
-		0xdd, 0xbc, 0x63, 0x0b, 0x10, 0xcc, 0x07, 0x0c, 0x73, 0x1e, 0xaf, 0xcc, 0xc7, 0x2c, 0xcf, 0x71,
-		0x2b, 0x7c, 0xec, 0xf2, 0x1e, 0xbf, 0xc2, 0xc7, 0xb0, 0xf0, 0x71, 0xb4, 0x71, 0x2c, 0x33, 0x7a,
-		0xea, 0x29, 0xf7, 0x2f, 0xed, 0x71, 0xbd, 0x03, 0xb6, 0xb1, 0x11, 0x55, 0x4e, 0x67, 0xc2, 0xb0,
-		0xd7, 0xfa, 0x11, 0x6e, 0x07, 0xb8, 0x0f, 0xc7, 0xc1, 0xc6, 0xe6, 0x29, 0xe2, 0xb5, 0xd4, 0xcc,
-		0xb1, 0xf1, 0xf1, 0x33, 0xe1, 0xde, 0xc5, 0xba, 0x60, 0x5b, 0xf3, 0xac, 0x48, 0x3d, 0xff, 0x82,
-		0x64, 0x89, 0x9c, 0x66, 0xb9, 0xdf, 0x7e, 0x71, 0x2d, 0xd2, 0x5f, 0x74, 0x43, 0x11, 0x75, 0x16,
-		0xa0, 0xa4, 0x3f, 0x23, 0xe9, 0x2c, 0x80, 0x27, 0xe8, 0xa9, 0xaf, 0x85, 0x5e, 0x94, 0xf3, 0xa3,
-		0x32, 0xc8, 0x79, 0x2c, 0x43, 0x7a, 0x53, 0x8d, 0x84, 0x5f, 0x00, 0x14, 0xdc, 0x1f, 0x04, 0x4d,
-		0x3a, 0x9a, 0x74, 0x60, 0x92, 0x4e, 0x79, 0x1c, 0x51, 0x45, 0x72, 0x98, 0xb1, 0xbd, 0x8c, 0xdd,
-		0x4b, 0x9f, 0x3c, 0x9b, 0xae, 0x9b, 0xe9, 0xdb, 0xa8, 0x8a, 0x65, 0x99, 0x61, 0x35, 0x08, 0xc5,
-		0x75, 0xd5, 0x13, 0xdc, 0x28, 0x11, 0xe6, 0x57, 0x19, 0xcf, 0x0d, 0x96, 0xdf, 0x99, 0x48, 0x13,
-		0x35, 0x47, 0xd5, 0x83, 0xaa, 0x07, 0xbd, 0x89, 0xf7, 0x54, 0x1d, 0x7e, 0x51, 0x75, 0xe1, 0x63,
-		0xbc, 0x01, 0x9d, 0x10, 0x74, 0x42, 0xe0, 0xe9, 0x87, 0x50, 0x08, 0x39, 0x25, 0xde, 0x65, 0x41,
-		0x3f, 0xe4, 0xe1, 0x30, 0x08, 0x27, 0x50, 0x59, 0xa0, 0xb2, 0x80, 0xa7, 0x2c, 0x22, 0xe2, 0x55,
-		0x89, 0xef, 0x2b, 0xaa, 0x75, 0x7e, 0x55, 0x71, 0x7f, 0x10, 0xf4, 0x1b, 0xd0, 0x6f, 0x00, 0x26,
-		0xea, 0xf9, 0x8f, 0xf7, 0x5e, 0x4e, 0xaa, 0xfa, 0xbb, 0xe3, 0x9a, 0x93, 0xb2, 0x7e, 0x33, 0xc0,
-		0xff, 0xfe, 0xbb, 0x56, 0x3d, 0x26, 0xd5, 0xa0, 0x5d, 0x3d, 0xfd, 0xf1, 0x9f, 0xc6, 0xaf, 0xff,
-		0xfa, 0xf2, 0xf0, 0xef, 0xff, 0xfd, 0x9f, 0xd6, 0xaf, 0xff, 0x93, 0x7d, 0xc7, 0x7e, 0x94, 0x41,
-		0x73, 0x99, 0xb8, 0x80, 0xc6, 0x32, 0x31, 0x42, 0x12, 0x84, 0x24, 0xb0, 0xf4, 0x54, 0xcc, 0xb8,
-		0xa9, 0x1f, 0x14, 0x50, 0x51, 0x07, 0x39, 0x1e, 0xcd, 0xc7, 0xf0, 0xb8, 0xfe, 0x93, 0xef, 0x78,
-		0xed, 0x15, 0x65, 0x7c, 0x2c, 0x28, 0xd4, 0x4f, 0x86, 0x29, 0xc8, 0x00, 0xb9, 0x19, 0xc7, 0x02,
-		0x87, 0x61, 0xce, 0xe3, 0xf7, 0x70, 0x69, 0x0b, 0x30, 0x43, 0xee, 0x6a, 0x69, 0x0f, 0x5a, 0xad,
-		0x66, 0xab, 0x44, 0xcb, 0xfb, 0xe9, 0x6d, 0x9e, 0x2a, 0x83, 0xa5, 0x4d, 0x55, 0x02, 0xfa, 0xa2,
-		0x56, 0x4a, 0x51, 0x0b, 0x8a, 0xb6, 0x16, 0x6d, 0xad, 0x63, 0xb6, 0x16, 0x46, 0xc2, 0x82, 0x14,
-		0xca, 0x54, 0xb5, 0xa4, 0x45, 0x6e, 0x13, 0xee, 0x8d, 0x81, 0xbe, 0x3f, 0xfa, 0xfe, 0xc0, 0xe4,
-		0x3c, 0x1b, 0x55, 0xda, 0x8b, 0xc2, 0x9e, 0x03, 0xb9, 0xe4, 0xa3, 0x52, 0x7b, 0x71, 0x22, 0xbd,
-		0xc9, 0xef, 0xbd, 0x8b, 0xb3, 0xde, 0xe4, 0xe7, 0x78, 0xd4, 0xeb, 0x75, 0x2b, 0x45, 0x78, 0xbb,
-		0x75, 0x6e, 0xb8, 0x5f, 0x0c, 0xf2, 0x3f, 0x98, 0x4f, 0x32, 0x8d, 0x9f, 0x7f, 0x9e, 0xfd, 0x71,
-		0x76, 0xfe, 0xfd, 0xac, 0xf2, 0x1e, 0x30, 0xd9, 0xea, 0x44, 0xea, 0x5f, 0x4f, 0x00, 0x4c, 0xa2,
-		0x56, 0x83, 0x31, 0x0d, 0x08, 0xb3, 0x68, 0xb4, 0x60, 0xcc, 0xa2, 0x56, 0x1b, 0x02, 0x98, 0x07,
-		0x88, 0xcd, 0xd8, 0x07, 0x21, 0x18, 0x2d, 0x20, 0x4a, 0x6a, 0x08, 0xc3, 0x62, 0x14, 0x9a, 0x46,
-		0xae, 0x27, 0x7f, 0x94, 0xa6, 0x31, 0x48, 0x16, 0x5f, 0xcd, 0xe4, 0xc1, 0xb2, 0x77, 0x1d, 0xa7,
-		0x16, 0x4f, 0x63, 0x14, 0x06, 0xa3, 0x30, 0xe8, 0x9d, 0x95, 0xd1, 0x3b, 0xdb, 0x94, 0xca, 0x57,
-		0x73, 0x08, 0x6a, 0x09, 0xbd, 0x33, 0x46, 0x38, 0xa9, 0x5a, 0x99, 0x94, 0xcd, 0xc9, 0xd9, 0x99,
-		0xe4, 0x93, 0xc9, 0x06, 0x2a, 0xa8, 0x1f, 0x0c, 0x03, 0x75, 0x12, 0x73, 0x3f, 0xa4, 0x95, 0xc2,
-		0x83, 0xff, 0xfa, 0xad, 0x6c, 0x33, 0xa4, 0x32, 0xdc, 0xd6, 0x9e, 0xc9, 0xcd, 0x89, 0xb1, 0x60,
-		0x74, 0x9d, 0xa8, 0x21, 0x80, 0x73, 0xf3, 0x88, 0xbc, 0x26, 0xb2, 0x2b, 0x4c, 0xbd, 0x3e, 0x52,
-		0x22, 0x60, 0x30, 0x8f, 0x66, 0xe0, 0xfb, 0x0c, 0xe2, 0xbc, 0x7c, 0xdd, 0x04, 0x29, 0x70, 0x3c,
-		0x60, 0x9c, 0x4d, 0x09, 0xf7, 0x41, 0x8a, 0x9c, 0x08, 0x02, 0x0a, 0x52, 0xcc, 0xae, 0x04, 0xf3,
-		0xe8, 0xf9, 0x15, 0x55, 0x6d, 0x13, 0x41, 0x9c, 0xdf, 0xcc, 0x48, 0x88, 0xd3, 0xd2, 0x82, 0x53,
-		0xf3, 0x6d, 0x02, 0xf2, 0x44, 0xd6, 0xeb, 0x10, 0xa7, 0x35, 0x55, 0xcc, 0x9f, 0x81, 0x54, 0x21,
-		0xf3, 0x5b, 0x49, 0x95, 0x37, 0x27, 0x9c, 0xd3, 0x10, 0x24, 0x48, 0x16, 0x1c, 0xe4, 0xb6, 0x35,
-		0x1b, 0xcd, 0x91, 0x12, 0x37, 0xb7, 0x20, 0x27, 0x27, 0xb4, 0x19, 0x11, 0x90, 0x58, 0x64, 0x49,
-		0xab, 0xf5, 0xbb, 0x1f, 0x7a, 0x20, 0x61, 0x3f, 0xd1, 0x06, 0x24, 0x0e, 0x39, 0xac, 0x35, 0x89,
-		0x69, 0x44, 0x53, 0x90, 0x68, 0xc4, 0x10, 0xef, 0x72, 0x22, 0xc6, 0x8b, 0x1f, 0x20, 0xe7, 0xa7,
-		0x0f, 0xc7, 0x6c, 0x36, 0x60, 0x1c, 0xe4, 0xec, 0x22, 0xe2, 0x8d, 0xa9, 0xf7, 0x27, 0x5f, 0x31,
-		0x4c, 0x84, 0xd4, 0xef, 0x9f, 0x42, 0x9c, 0xa7, 0xa2, 0xb3, 0x38, 0x24, 0xaa, 0x7e, 0xd4, 0x68,
-		0x80, 0x34, 0x79, 0x4c, 0x52, 0x15, 0x12, 0x0e, 0x72, 0x72, 0x44, 0x79, 0x9c, 0x9a, 0x51, 0x18,
-		0xc3, 0x8c, 0x56, 0x4e, 0xa3, 0xe6, 0x61, 0x4d, 0x12, 0xd5, 0x99, 0x13, 0x90, 0x58, 0x53, 0xfb,
-		0x1e, 0x03, 0x1d, 0x3d, 0xe9, 0x90, 0x29, 0xd0, 0x1b, 0x02, 0x33, 0xa7, 0x8a, 0x53, 0xd3, 0xd1,
-		0x11, 0xf1, 0x7c, 0xc8, 0x33, 0x6c, 0x0e, 0xa7, 0x0c, 0x24, 0xf4, 0x8c, 0x98, 0xb8, 0x69, 0xb4,
-		0xa0, 0x3a, 0x0b, 0xbd, 0xc5, 0xf6, 0x9d, 0xfe, 0x05, 0xd2, 0xe8, 0x99, 0xe8, 0x9b, 0xc7, 0x7a,
-		0xdc, 0x1f, 0x81, 0x3c, 0x97, 0x8a, 0xf8, 0x1a, 0x64, 0xc0, 0xe8, 0xa6, 0xd1, 0x8a, 0x42, 0x90,
-		0x61, 0x67, 0x8f, 0x82, 0xc4, 0x5f, 0x52, 0xf0, 0x03, 0x98, 0x4e, 0x01, 0x93, 0x09, 0x3a, 0x09,
-		0xc9, 0x35, 0x48, 0x0d, 0x12, 0x78, 0xf5, 0xfd, 0xa3, 0x26, 0xcc, 0x28, 0x5f, 0xad, 0x76, 0x04,
-		0xd4, 0xa8, 0x5d, 0x10, 0x9f, 0x09, 0x88, 0x73, 0x13, 0xd2, 0x30, 0x8f, 0x84, 0x9d, 0xe5, 0x5d,
-		0xc8, 0x57, 0x25, 0x62, 0x90, 0x56, 0x40, 0x69, 0x05, 0x32, 0x8c, 0xe9, 0x0b, 0x4f, 0x27, 0x8e,
-		0xdc, 0x90, 0x78, 0x21, 0xb9, 0xa5, 0x0a, 0xa4, 0x45, 0xd0, 0x30, 0x0f, 0xe5, 0x5c, 0x6b, 0x06,
-		0x33, 0xf5, 0x24, 0x92, 0x42, 0x33, 0x43, 0xa1, 0x86, 0x9f, 0x03, 0x36, 0x55, 0xb4, 0x03, 0xf7,
-		0xfa, 0x38, 0x92, 0xa1, 0x86, 0x39, 0x2f, 0x3a, 0x9b, 0x64, 0x6f, 0xf1, 0xe3, 0xce, 0x04, 0x93,
-		0xe0, 0xde, 0xe9, 0xd7, 0xee, 0xf9, 0x18, 0xa4, 0xcf, 0xa3, 0x84, 0xfc, 0xc6, 0x94, 0x89, 0x49,
-		0x08, 0x76, 0xf3, 0x7a, 0x27, 0x20, 0xb7, 0x6e, 0x76, 0x7c, 0x7c, 0x54, 0x87, 0x3a, 0x31, 0x90,
-		0xde, 0x1c, 0x09, 0xe3, 0x9e, 0x14, 0xfc, 0x9c, 0xc7, 0x20, 0x65, 0x0d, 0x68, 0x1c, 0xcf, 0xd7,
-		0x35, 0x90, 0x1e, 0x00, 0xa5, 0xf4, 0xa8, 0xd6, 0xa8, 0x1f, 0x7c, 0x1f, 0xb6, 0xcf, 0x00, 0x4b,
-		0xdb, 0x40, 0xcc, 0x16, 0xde, 0x38, 0x54, 0xdc, 0xec, 0x5f, 0x4d, 0x2f, 0x3c, 0xef, 0x4f, 0xa9,
-		0x8d, 0xa2, 0x24, 0x82, 0x09, 0x51, 0x58, 0x44, 0xd4, 0x6d, 0x7f, 0xdc, 0x05, 0x79, 0x4c, 0x35,
-		0xbb, 0x99, 0x88, 0x53, 0x11, 0x2b, 0xb8, 0x49, 0x45, 0x1d, 0xe0, 0x29, 0x45, 0x73, 0x26, 0x25,
-		0xeb, 0x6f, 0xda, 0x21, 0x83, 0xcd, 0x5b, 0x3c, 0xd8, 0x87, 0x19, 0x7a, 0x50, 0x24, 0xa2, 0x17,
-		0x34, 0x24, 0xb7, 0x63, 0xaa, 0xae, 0x18, 0xcc, 0x1d, 0x24, 0xbe, 0x0e, 0x1b, 0x40, 0x6f, 0x12,
-		0xba, 0x37, 0x20, 0x63, 0x7e, 0x37, 0x47, 0x07, 0x03, 0x22, 0x61, 0x46, 0x8d, 0x34, 0x50, 0x67,
-		0x41, 0x2a, 0x21, 0x4f, 0xbe, 0xb7, 0x65, 0x63, 0x28, 0x81, 0xa2, 0xcd, 0x89, 0x1f, 0xc1, 0xbc,
-		0x10, 0xf1, 0xb9, 0x06, 0xaa, 0x1f, 0x57, 0x2e, 0x10, 0x64, 0x2f, 0xb6, 0xb5, 0x0f, 0x12, 0x58,
-		0x02, 0x2d, 0xef, 0x59, 0x3a, 0xad, 0x5d, 0x71, 0xcd, 0xe1, 0xba, 0xad, 0xcb, 0x7b, 0x83, 0xbf,
-		0xce, 0x41, 0x27, 0x3c, 0xf7, 0x25, 0xcc, 0x3b, 0x2d, 0x90, 0x52, 0x27, 0x7d, 0x9e, 0xa4, 0xc9,
-		0x0e, 0x84, 0x90, 0x20, 0x5d, 0x81, 0xa9, 0x06, 0xbf, 0x6f, 0x75, 0x98, 0xd8, 0x4b, 0x1c, 0x1d,
-		0xd5, 0x1a, 0x8d, 0x10, 0x68, 0x31, 0xab, 0xc7, 0x24, 0xd4, 0xf0, 0xf3, 0xb2, 0x22, 0x0b, 0xaa,
-		0x27, 0x37, 0x12, 0x8c, 0x9b, 0x89, 0x48, 0x7e, 0x8c, 0xa9, 0x62, 0x40, 0x31, 0xb4, 0xf6, 0x39,
-		0xd4, 0x0b, 0x49, 0x90, 0x76, 0x2e, 0x24, 0x12, 0x64, 0x0d, 0xd6, 0x4c, 0x35, 0x6b, 0xcd, 0x8b,
-		0x2e, 0x48, 0x3e, 0x1b, 0x8f, 0x69, 0x4f, 0xf4, 0xc7, 0x83, 0xab, 0x10, 0x66, 0x6d, 0xa7, 0xaf,
-		0x21, 0x43, 0x93, 0x56, 0xe7, 0xe2, 0x74, 0xd4, 0xe7, 0x30, 0x13, 0xdc, 0x9a, 0x87, 0x20, 0xd3,
-		0xd3, 0x25, 0xcc, 0xc4, 0xed, 0xe0, 0x5b, 0xc0, 0xa0, 0x72, 0x5a, 0x6a, 0x26, 0xc1, 0xde, 0x0f,
-		0x03, 0x8d, 0xe3, 0xb5, 0x35, 0x3b, 0x8f, 0x41, 0xea, 0xc5, 0x7f, 0x1a, 0xc7, 0x4d, 0x90, 0x36,
-		0x2d, 0x12, 0x1e, 0xf9, 0x46, 0x95, 0x66, 0x82, 0xd7, 0xc1, 0xb2, 0x22, 0x42, 0x75, 0x49, 0x87,
-		0x71, 0x68, 0x98, 0x0c, 0xe9, 0x8d, 0x80, 0x69, 0xde, 0x80, 0xde, 0x0b, 0x27, 0x67, 0xf2, 0xfc,
-		0x8a, 0xaa, 0x39, 0x25, 0x3e, 0xe4, 0x32, 0x97, 0x5b, 0xc5, 0x00, 0x4b, 0x5f, 0x57, 0x78, 0xfa,
-		0x3b, 0x53, 0x34, 0xa4, 0x5a, 0xc3, 0xbe, 0xbe, 0xf2, 0x85, 0xa7, 0xcf, 0x03, 0x3f, 0x82, 0x3d,
-		0xcb, 0xa4, 0x87, 0x41, 0x48, 0xc9, 0x15, 0x05, 0x5a, 0x18, 0x7f, 0x74, 0x78, 0x08, 0x94, 0xf5,
-		0x05, 0x6e, 0x29, 0xe4, 0x94, 0x19, 0x90, 0x99, 0x28, 0x71, 0x68, 0x14, 0x01, 0x69, 0xdc, 0x23,
-		0x5f, 0x03, 0xcd, 0x41, 0x9c, 0x43, 0x8d, 0xa1, 0x0b, 0xc3, 0xcf, 0xfd, 0x18, 0xb2, 0xe5, 0x26,
-		0x90, 0x8b, 0x42, 0x38, 0xd0, 0x50, 0xd1, 0x1d, 0xf9, 0x44, 0x27, 0x32, 0xc0, 0x21, 0x66, 0x14,
-		0x00, 0xa6, 0xd3, 0xbd, 0x5a, 0x16, 0x55, 0xf7, 0x65, 0xdb, 0xf7, 0x15, 0xd5, 0x20, 0x0d, 0xba,
-		0x01, 0x9a, 0x9e, 0x28, 0x95, 0x30, 0x54, 0xf0, 0xa3, 0x1a, 0x54, 0x2e, 0xc8, 0x0d, 0x63, 0x22,
-		0xc8, 0x9b, 0xd6, 0x69, 0xf8, 0x8d, 0x7a, 0x86, 0x8c, 0x0d, 0x01, 0x39, 0xbf, 0xab, 0xe8, 0x9a,
-		0x28, 0xba, 0x22, 0x6d, 0x38, 0x63, 0x1e, 0xd0, 0xda, 0xdd, 0xaf, 0x52, 0xf0, 0xd1, 0xfc, 0x56,
-		0x33, 0x8f, 0x84, 0x7f, 0x72, 0x06, 0x39, 0x79, 0xbd, 0x01, 0x78, 0x0b, 0x81, 0x92, 0x1d, 0x68,
-		0x11, 0x98, 0x85, 0x18, 0x0e, 0x84, 0x90, 0x53, 0xa0, 0xfd, 0x0e, 0x78, 0x40, 0x60, 0xc6, 0x21,
-		0x34, 0x48, 0xde, 0xba, 0x90, 0x71, 0x3a, 0x83, 0x4a, 0x36, 0x68, 0xa8, 0x8a, 0x80, 0x76, 0xf2,
-		0x09, 0x9b, 0x4c, 0x42, 0xcd, 0x0a, 0xdb, 0x14, 0x8a, 0x9c, 0x6e, 0x6a, 0xac, 0xe1, 0xd2, 0x4c,
-		0x0d, 0x4f, 0xbf, 0x76, 0x41, 0x5a, 0x72, 0xcf, 0xd0, 0x90, 0xe9, 0x21, 0x35, 0x64, 0x70, 0x7e,
-		0x3e, 0x02, 0x89, 0xc4, 0xa0, 0xca, 0x5f, 0xb3, 0x05, 0x9b, 0xb1, 0x61, 0x38, 0xea, 0xc3, 0x6c,
-		0x52, 0x44, 0xbc, 0x79, 0x77, 0x3c, 0x00, 0xd9, 0x69, 0xa3, 0x0e, 0xb4, 0xcc, 0x1a, 0x64, 0x4a,
-		0x7e, 0x00, 0x12, 0x4e, 0x4a, 0xb9, 0x4c, 0xa4, 0x0a, 0x19, 0xbf, 0x84, 0xdb, 0xd7, 0x3f, 0xa9,
-		0xa7, 0xe8, 0xc3, 0xac, 0xa7, 0xd0, 0x40, 0x63, 0xcf, 0x49, 0xa2, 0xd8, 0x88, 0x98, 0x39, 0xd0,
-		0x96, 0x28, 0xf3, 0x98, 0x1b, 0xb0, 0x94, 0xf8, 0x61, 0x24, 0x81, 0xd6, 0x9b, 0x05, 0x30, 0xdb,
-		0xa2, 0xc0, 0xcc, 0x6c, 0x00, 0x7a, 0xb1, 0x43, 0x48, 0xd8, 0x82, 0x5b, 0x45, 0xd1, 0xe7, 0x90,
-		0xeb, 0xe7, 0x26, 0xe2, 0x92, 0xf2, 0x0b, 0xc6, 0x67, 0x20, 0x2b, 0x45, 0x80, 0x12, 0x17, 0xb0,
-		0xa0, 0x3a, 0xd3, 0x1c, 0x76, 0x00, 0x21, 0xa1, 0xed, 0xf4, 0x04, 0xe7, 0xd4, 0x03, 0x79, 0xd5,
-		0x7f, 0x7d, 0x4d, 0xf8, 0x68, 0xd4, 0x00, 0x9d, 0x2b, 0xb5, 0x4e, 0x74, 0xbb, 0x08, 0x46, 0x40,
-		0x9b, 0x50, 0xcc, 0xa0, 0x36, 0xc4, 0x27, 0xf5, 0xc6, 0x50, 0xca, 0xf1, 0x35, 0x33, 0xde, 0x1c,
-		0x26, 0xc4, 0x3c, 0x15, 0xea, 0x9a, 0x28, 0x1f, 0xb0, 0x71, 0xdf, 0x4f, 0x8c, 0xfb, 0x09, 0xcc,
-		0xa6, 0xdc, 0x92, 0xc0, 0xcc, 0x59, 0x5f, 0xb7, 0xb5, 0xe9, 0xb5, 0xcf, 0xda, 0x20, 0xf7, 0x0d,
-		0xa6, 0x73, 0x07, 0x96, 0x37, 0x17, 0x26, 0x82, 0x5e, 0x5d, 0x0c, 0x03, 0x4e, 0x5e, 0x6b, 0x12,
-		0x7f, 0x40, 0x66, 0x40, 0xc9, 0x28, 0x26, 0x20, 0x27, 0x46, 0xb5, 0x27, 0xf8, 0x87, 0x70, 0x08,
-		0x40, 0x1e, 0xcb, 0x39, 0x50, 0x13, 0xf0, 0x0f, 0xd0, 0x12, 0xe3, 0x25, 0x48, 0x3e, 0x18, 0xc2,
-		0xcc, 0x30, 0x91, 0x4a, 0xc8, 0x0e, 0x87, 0xd9, 0xdb, 0xf2, 0x71, 0xc5, 0x3f, 0xe4, 0x86, 0xc0,
-		0x04, 0xa8, 0x56, 0x21, 0x26, 0xea, 0x47, 0x04, 0xe8, 0x75, 0x48, 0x03, 0x66, 0xc8, 0xe4, 0xfc,
-		0x8a, 0xaa, 0x36, 0xcc, 0x7c, 0x9a, 0x88, 0xfa, 0x8c, 0x0c, 0x09, 0x0b, 0xe1, 0xb2, 0xa7, 0xaf,
-		0x0c, 0x5e, 0xb3, 0xa3, 0x23, 0xe2, 0x81, 0x8c, 0x7b, 0x49, 0xc1, 0xeb, 0x2d, 0x90, 0x57, 0x91,
-		0xe4, 0x8a, 0x79, 0xec, 0x5c, 0x1a, 0xe6, 0x91, 0x10, 0x6c, 0x81, 0xe0, 0x4d, 0xa3, 0x25, 0x61,
-		0x26, 0x7c, 0x85, 0xc2, 0x23, 0xe1, 0x84, 0x84, 0x97, 0x1f, 0xc2, 0xbb, 0x03, 0x4c, 0xa3, 0x95,
-		0xc4, 0x8d, 0xba, 0xfd, 0x2e, 0xd4, 0xbe, 0xac, 0x83, 0x11, 0xcc, 0x5b, 0xe5, 0x6e, 0xe8, 0xb1,
-		0x1e, 0xf7, 0x47, 0x06, 0x68, 0x40, 0x6c, 0xcc, 0x40, 0x46, 0xc4, 0xa6, 0x44, 0x33, 0x0f, 0x6a,
-		0x83, 0xd9, 0x55, 0x1f, 0x64, 0xe0, 0xb5, 0xd4, 0x52, 0x4a, 0xa8, 0x34, 0x45, 0x26, 0x86, 0x4b,
-		0x63, 0x70, 0xc6, 0xbc, 0x09, 0xd0, 0x40, 0xed, 0x74, 0x26, 0xa5, 0x08, 0x99, 0x77, 0x4b, 0x3c,
-		0x4f, 0xc4, 0xdc, 0x00, 0x4d, 0x7e, 0x4b, 0x7a, 0xb9, 0xe9, 0x21, 0xf1, 0x06, 0x50, 0x43, 0x63,
-		0x73, 0x7f, 0x5e, 0x3f, 0x6a, 0x34, 0x20, 0x33, 0x50, 0xd4, 0x61, 0xfa, 0x79, 0xf5, 0x26, 0xc8,
-		0xe2, 0x40, 0xdd, 0x68, 0x36, 0xc0, 0xf6, 0xa8, 0x96, 0x61, 0xfc, 0x21, 0x2e, 0x13, 0x20, 0xdf,
-		0x51, 0x7a, 0x44, 0x5e, 0x13, 0xd9, 0x15, 0xa6, 0x5e, 0x3f, 0x81, 0x49, 0x7d, 0xb6, 0x0c, 0x52,
-		0x77, 0xa0, 0xf6, 0x67, 0x5d, 0x87, 0x5a, 0x60, 0x13, 0x10, 0x86, 0x4d, 0x26, 0x6f, 0xc0, 0x36,
-		0x64, 0xf2, 0xf9, 0x5f, 0x30, 0x99, 0x9f, 0x7d, 0x0f, 0xe4, 0x86, 0xe9, 0x10, 0x28, 0xa5, 0xa9,
-		0xae, 0xc1, 0x2d, 0xb1, 0x8e, 0xae, 0x40, 0xc6, 0x9f, 0xc5, 0xf2, 0x0a, 0x68, 0xa2, 0x08, 0xd7,
-		0x12, 0x68, 0x4d, 0x05, 0x09, 0x42, 0xc2, 0x93, 0x14, 0x46, 0xc0, 0xed, 0xc7, 0xc7, 0x50, 0x1b,
-		0x96, 0x82, 0x8c, 0x89, 0x11, 0x7d, 0xcb, 0x41, 0x02, 0xca, 0x99, 0x84, 0x99, 0x71, 0x1a, 0x12,
-		0x39, 0x05, 0x9a, 0x35, 0x35, 0x8e, 0xa7, 0x49, 0x6d, 0x64, 0x40, 0x3c, 0x0a, 0x36, 0x6e, 0xe9,
-		0x41, 0x8e, 0x5b, 0x12, 0x13, 0xad, 0x58, 0x82, 0x41, 0x1a, 0x81, 0xc8, 0x00, 0xbe, 0xad, 0x03,
-		0x4c, 0xab, 0x5e, 0x07, 0x4b, 0xab, 0xbe, 0x26, 0x3b, 0xe8, 0x1a, 0x05, 0x37, 0xaa, 0xf7, 0xdd,
-		0xc8, 0x95, 0x56, 0xb9, 0x20, 0x3e, 0x13, 0x20, 0x27, 0x0a, 0x37, 0xb1, 0xc8, 0xd7, 0xf5, 0xd3,
-		0xee, 0x00, 0x70, 0xc3, 0x0d, 0xa0, 0x25, 0x5b, 0x1e, 0xd4, 0x9e, 0xa6, 0x9e, 0x67, 0x7a, 0x51,
-		0x0c, 0x52, 0xd6, 0x66, 0x6c, 0x46, 0xa6, 0x6c, 0xd9, 0x68, 0x03, 0xe8, 0xee, 0xf9, 0x6c, 0xc6,
-		0x0c, 0x09, 0xbf, 0x2b, 0x22, 0x25, 0x55, 0x1f, 0xa0, 0xbf, 0xa9, 0x8e, 0x7c, 0xdd, 0xf7, 0x18,
-		0xe4, 0xc4, 0xfd, 0xd6, 0x29, 0x9b, 0xc2, 0x74, 0x88, 0xc2, 0x06, 0xd8, 0xcb, 0x9e, 0x24, 0x09,
-		0x67, 0xe2, 0xc3, 0xac, 0x74, 0xba, 0x6a, 0x1e, 0x00, 0xf5, 0xcf, 0x4f, 0x63, 0x98, 0xd9, 0x98,
-		0x26, 0x86, 0x6a, 0x00, 0x18, 0xa5, 0xb4, 0xde, 0x3c, 0xde, 0x07, 0x99, 0xe8, 0x26, 0x22, 0x2a,
-		0x39, 0x81, 0x4d, 0xf8, 0x06, 0x18, 0x86, 0x8d, 0xc4, 0x35, 0x55, 0x21, 0xe3, 0x30, 0xe3, 0xb5,
-		0xa1, 0xbe, 0x86, 0x9a, 0x17, 0xb6, 0xce, 0x09, 0x1b, 0x35, 0x40, 0xd6, 0xc5, 0x10, 0x45, 0x60,
-		0x92, 0x31, 0x29, 0x09, 0xf8, 0xf2, 0x1f, 0xb0, 0x17, 0x17, 0xc9, 0x50, 0x4f, 0xc0, 0x42, 0x14,
-		0x62, 0xa2, 0xa9, 0xe0, 0x20, 0x2b, 0xaf, 0x67, 0xc7, 0xc7, 0x47, 0x0d, 0xb8, 0x44, 0x61, 0xdc,
-		0x83, 0xa9, 0x28, 0x15, 0xf1, 0x99, 0x18, 0xb6, 0x3b, 0x30, 0x6f, 0x45, 0x1e, 0xe4, 0xc9, 0xc2,
-		0xe5, 0xa0, 0x8d, 0x84, 0x4f, 0x41, 0xa6, 0x01, 0xcf, 0x9b, 0x8d, 0xe6, 0x57, 0x62, 0xe8, 0x25,
-		0xa5, 0x12, 0x66, 0xe0, 0x6b, 0x49, 0x02, 0x0d, 0x12, 0x82, 0x19, 0xa2, 0x06, 0x40, 0x63, 0x7a,
-		0x30, 0x19, 0x70, 0x16, 0xfe, 0x0e, 0x50, 0x72, 0x9f, 0x4d, 0x46, 0x29, 0xd0, 0xa6, 0x7b, 0x90,
-		0xa9, 0xac, 0x01, 0x73, 0x90, 0x7b, 0x5c, 0xc1, 0x64, 0x61, 0xb2, 0x51, 0x59, 0x56, 0x68, 0x84,
-		0x1f, 0x9f, 0xde, 0x61, 0x39, 0x8b, 0x2d, 0xe3, 0x3d, 0x71, 0x0e, 0xea, 0x07, 0xc3, 0x40, 0x15,
-		0xad, 0xcd, 0x78, 0xff, 0x99, 0x50, 0x19, 0x2a, 0xed, 0xf2, 0x04, 0x58, 0x30, 0xba, 0x9e, 0xdc,
-		0x4a, 0xa7, 0x37, 0xe1, 0x5e, 0xc9, 0xe7, 0x48, 0x89, 0x80, 0xb9, 0x7d, 0xa4, 0x02, 0xdf, 0x67,
-		0x2e, 0xbf, 0xbf, 0xaf, 0x9b, 0x4e, 0x0b, 0x04, 0x0f, 0x18, 0x67, 0x53, 0x52, 0x24, 0x88, 0x55,
-		0x02, 0x91, 0x10, 0x41, 0x40, 0x9d, 0x16, 0x83, 0x4d, 0xfb, 0xf6, 0x42, 0x80, 0xfd, 0xfd, 0xe7,
-		0x31, 0x33, 0xd2, 0xe5, 0xd7, 0x4f, 0x7a, 0x82, 0x7e, 0x9b, 0x38, 0x7d, 0x92, 0x8a, 0x50, 0x8c,
-		0xbc, 0xff, 0xeb, 0x4f, 0x15, 0xf3, 0x67, 0x4e, 0x8b, 0xf2, 0xfc, 0x56, 0x52, 0x55, 0x38, 0xb1,
-		0xb9, 0x04, 0x60, 0xaf, 0x48, 0x6d, 0x44, 0x09, 0xb6, 0xa1, 0xd9, 0x68, 0x8e, 0x94, 0xb8, 0xb9,
-		0x75, 0x7a, 0x12, 0x42, 0x9b, 0x11, 0x71, 0xda, 0x36, 0x9b, 0xa4, 0xb2, 0xf9, 0xf7, 0x42, 0xfc,
-		0x1d, 0x25, 0x80, 0xa9, 0x85, 0xba, 0xc2, 0x95, 0xc0, 0x2e, 0x1f, 0xd6, 0x9a, 0xc4, 0x34, 0xa2,
-		0xa9, 0xd3, 0xd6, 0xd9, 0x10, 0xef, 0x72, 0x22, 0xc6, 0x8b, 0x1f, 0x4e, 0xcf, 0x43, 0x1f, 0x8e,
-		0xd9, 0x6c, 0xc0, 0xb8, 0xd3, 0xb3, 0x88, 0x88, 0x37, 0xa6, 0xde, 0x9f, 0xdc, 0x13, 0xdc, 0x28,
-		0x11, 0x86, 0xd4, 0xef, 0x9f, 0xba, 0x3c, 0x1f, 0x45, 0x67, 0x71, 0x48, 0x54, 0x31, 0x02, 0xba,
-		0x12, 0x98, 0x0c, 0x26, 0xa9, 0x0a, 0x09, 0x77, 0x7a, 0x12, 0x44, 0x79, 0x9c, 0x9a, 0x51, 0x21,
-		0x82, 0xb2, 0x12, 0x38, 0xd7, 0xd3, 0xa8, 0x79, 0x58, 0x93, 0x44, 0x75, 0xe6, 0xc4, 0x69, 0x2c,
-		0xa5, 0x7d, 0x8f, 0x81, 0xf0, 0xae, 0x13, 0xaa, 0x2d, 0xa7, 0x41, 0xf9, 0xaa, 0xc4, 0xa9, 0x68,
-		0xcb, 0x87, 0xf2, 0xcc, 0xa4, 0x59, 0xac, 0x46, 0xb9, 0x04, 0x76, 0x90, 0x89, 0x9b, 0x22, 0xf7,
-		0x90, 0xe5, 0x00, 0xb7, 0x49, 0xed, 0xdc, 0xe9, 0x5f, 0x4e, 0x1b, 0x0d, 0x13, 0x7d, 0x2b, 0x4c,
-		0x0a, 0x5f, 0x02, 0x1c, 0x52, 0xac, 0x41, 0xd4, 0xfb, 0x4f, 0xe0, 0xa6, 0xd1, 0x8a, 0x42, 0xa7,
-		0xc3, 0x80, 0x1e, 0x75, 0x1a, 0x77, 0x48, 0xc1, 0x0f, 0xdc, 0x06, 0xb1, 0x2b, 0xd2, 0xcf, 0x90,
-		0x5c, 0x3b, 0x2d, 0xc9, 0x81, 0x57, 0xdf, 0x3f, 0x6a, 0xba, 0x1d, 0xbd, 0xa9, 0xd5, 0x8e, 0x1c,
-		0x37, 0x0a, 0x05, 0xd9, 0x33, 0xde, 0x7f, 0x0e, 0x0f, 0xcb, 0x04, 0xbe, 0x2a, 0x11, 0x3b, 0xad,
-		0x5d, 0x95, 0x56, 0x4e, 0x87, 0xa1, 0x36, 0x9c, 0xbd, 0xc5, 0xbb, 0x54, 0x96, 0x40, 0xd3, 0x6a,
-		0xb7, 0x0f, 0xd3, 0x5c, 0x6b, 0xe6, 0xf6, 0xd5, 0x75, 0x24, 0x85, 0x66, 0x86, 0xba, 0x1e, 0x0e,
-		0x0c, 0xd8, 0x54, 0xd1, 0x8e, 0xfb, 0xd7, 0x5e, 0x91, 0x0c, 0xb5, 0xdb, 0xef, 0x4f, 0x67, 0x16,
-		0xd8, 0x64, 0x4b, 0x12, 0xb4, 0x39, 0xfd, 0xda, 0x3d, 0x1f, 0x3b, 0x8d, 0xc5, 0x95, 0x90, 0x85,
-		0xb9, 0x01, 0x4b, 0xb2, 0x19, 0xbd, 0x13, 0xa7, 0xb7, 0x62, 0x76, 0x7c, 0x7c, 0x54, 0x77, 0x7d,
-		0x02, 0x4e, 0x7b, 0x13, 0x2b, 0xca, 0xc5, 0xf3, 0x22, 0x9c, 0xc2, 0x25, 0x90, 0x05, 0xc7, 0xe3,
-		0x33, 0xbe, 0xae, 0x39, 0x8d, 0x58, 0x57, 0x9d, 0x94, 0x0e, 0xbe, 0x0f, 0xdb, 0x67, 0x00, 0xa4,
-		0x61, 0x20, 0x66, 0x0b, 0xef, 0xce, 0x75, 0xfc, 0xb7, 0x64, 0xf9, 0x2d, 0xde, 0x6b, 0xa7, 0x0c,
-		0x26, 0x9b, 0x45, 0x44, 0xdd, 0x16, 0xeb, 0x52, 0x59, 0x82, 0x4b, 0x3b, 0x76, 0x33, 0x11, 0xa7,
-		0x22, 0x56, 0xee, 0x27, 0x19, 0x74, 0x80, 0xa4, 0x18, 0xcc, 0x99, 0x94, 0xcc, 0x02, 0xd7, 0x77,
-		0x59, 0xf2, 0x89, 0x0e, 0xf6, 0xdd, 0x76, 0x59, 0x37, 0x54, 0x45, 0x63, 0xaa, 0xae, 0x98, 0xdb,
-		0x3b, 0x92, 0xb4, 0x74, 0x73, 0x3c, 0x52, 0xdb, 0xbd, 0x71, 0x3a, 0x96, 0x73, 0x73, 0x74, 0x30,
-		0x20, 0xd2, 0xed, 0xe8, 0x81, 0x76, 0x1c, 0xdc, 0x2e, 0xfc, 0xed, 0x93, 0xef, 0x6d, 0xd9, 0x18,
-		0x4a, 0xc7, 0xd1, 0xd4, 0xc4, 0x8f, 0xdc, 0x0e, 0x2c, 0xfb, 0x5c, 0x3b, 0xae, 0x8f, 0x56, 0xd0,
-		0x1c, 0x82, 0xb7, 0xd4, 0xda, 0x77, 0x1a, 0x38, 0x39, 0x9e, 0x3e, 0xbe, 0x74, 0x8e, 0x6c, 0xf4,
-		0x78, 0x2c, 0x4b, 0x5c, 0xf6, 0xaf, 0x73, 0x10, 0x09, 0x81, 0x7d, 0xe9, 0x76, 0xac, 0xdf, 0x69,
-		0xa9, 0x90, 0x3e, 0x4f, 0xd2, 0xce, 0x06, 0x42, 0x48, 0xa7, 0xa1, 0xeb, 0x54, 0x83, 0xd9, 0x87,
-		0xba, 0xdb, 0x98, 0x23, 0xe1, 0x55, 0x6f, 0x84, 0x8e, 0x17, 0x1b, 0x79, 0x4c, 0xba, 0x1e, 0x0e,
-		0x5c, 0x66, 0xf0, 0xbb, 0xee, 0x49, 0x8c, 0x04, 0xe3, 0x66, 0x22, 0x92, 0x1f, 0x63, 0xaa, 0x98,
-		0xe3, 0x58, 0x50, 0xfb, 0xdc, 0xf5, 0x8b, 0x17, 0xa7, 0xed, 0x44, 0x48, 0xa4, 0xd3, 0x39, 0xfb,
-		0x33, 0xd5, 0xac, 0x35, 0x2f, 0xba, 0x4e, 0xd7, 0xc7, 0x7b, 0x4c, 0x7b, 0xa2, 0x3f, 0x1e, 0x14,
-		0x6b, 0x50, 0x51, 0x86, 0x5b, 0x3c, 0x08, 0xa6, 0xba, 0xd5, 0xb9, 0x38, 0x1d, 0xf5, 0xb9, 0xdb,
-		0x89, 0x2a, 0xcd, 0x43, 0xa7, 0xd3, 0x31, 0xa5, 0xdb, 0x09, 0x8c, 0xc1, 0xb7, 0x80, 0xb9, 0xce,
-		0xe9, 0xa4, 0x99, 0x74, 0xfe, 0x5e, 0xcb, 0xf1, 0xf8, 0x4c, 0x5b, 0xb3, 0xf3, 0xd8, 0x69, 0x3d,
-		0xf4, 0x4f, 0xe3, 0xb8, 0xe9, 0xb4, 0x4d, 0x88, 0x84, 0x47, 0xbe, 0x51, 0xa5, 0x99, 0xe0, 0x75,
-		0xe7, 0x59, 0x84, 0x5c, 0x77, 0x7d, 0x86, 0x71, 0x68, 0x98, 0x0c, 0xe9, 0x8d, 0x70, 0xdb, 0x3c,
-		0x38, 0x7e, 0x9f, 0x95, 0x9c, 0x25, 0x6b, 0xed, 0xfe, 0x4a, 0x20, 0xe3, 0xb7, 0x8a, 0x01, 0x90,
-		0x8e, 0xae, 0xf0, 0xf4, 0xba, 0x97, 0x0c, 0x8c, 0xb0, 0xbe, 0x2f, 0x3c, 0x7d, 0x1e, 0xf8, 0x11,
-		0x8c, 0xd9, 0x30, 0x6e, 0xa8, 0x0a, 0x29, 0xb9, 0xa2, 0x8e, 0x17, 0x10, 0x1e, 0x1d, 0x1e, 0x3a,
-		0x5e, 0x5d, 0xee, 0x7e, 0x69, 0xcb, 0x94, 0x19, 0xa7, 0x6f, 0xb2, 0xe3, 0xd0, 0x28, 0xe2, 0xb4,
-		0x11, 0x8c, 0x7c, 0xed, 0x78, 0x6e, 0xd0, 0xdc, 0xf5, 0xd8, 0xa5, 0x30, 0xfc, 0xdc, 0x8f, 0x21,
-		0x58, 0x38, 0x02, 0x21, 0x49, 0x99, 0x3b, 0x1e, 0x32, 0xb8, 0x2b, 0xa2, 0xed, 0x44, 0x06, 0x08,
-		0x84, 0x8a, 0x02, 0x00, 0xb4, 0x6e, 0xab, 0xee, 0xfe, 0x7d, 0xd9, 0xf6, 0x7d, 0x45, 0xb5, 0xd3,
-		0x86, 0xcf, 0x38, 0x9e, 0x36, 0x24, 0x95, 0x30, 0x54, 0xf0, 0xa3, 0x9a, 0xeb, 0x1c, 0x49, 0x1b,
-		0x86, 0x21, 0xa7, 0x6f, 0x8e, 0xa6, 0xe1, 0x37, 0xea, 0x19, 0x32, 0x36, 0xc4, 0xe9, 0x79, 0x5c,
-		0x45, 0xd7, 0x44, 0xd1, 0x55, 0x51, 0xea, 0x19, 0xf3, 0x1c, 0xaf, 0xa1, 0xfa, 0x2a, 0x05, 0x1f,
-		0xcd, 0x6f, 0x35, 0xf3, 0x48, 0xf8, 0x27, 0x67, 0x10, 0x92, 0x35, 0x1b, 0x00, 0xb6, 0xc4, 0xf1,
-		0x22, 0x4f, 0x2d, 0x02, 0xb3, 0x10, 0x93, 0x81, 0x10, 0x72, 0xea, 0x38, 0xdf, 0x2c, 0x0f, 0x88,
-		0xdb, 0xfe, 0xab, 0x76, 0x9a, 0x2f, 0x26, 0x64, 0x9c, 0xce, 0x5c, 0x27, 0xed, 0x31, 0x54, 0x45,
-		0x8e, 0x33, 0x91, 0x87, 0x4d, 0x26, 0x5d, 0xcf, 0xfa, 0xd8, 0x24, 0x2e, 0x9f, 0x5a, 0x68, 0xb3,
-		0x5f, 0x16, 0x5a, 0x89, 0xe1, 0xe9, 0xd7, 0xae, 0xd3, 0x16, 0xcf, 0x33, 0x34, 0x64, 0x7a, 0x48,
-		0x0d, 0x19, 0x9c, 0x9f, 0x8f, 0x9c, 0x46, 0x20, 0xae, 0xcb, 0x47, 0xb3, 0x05, 0xa3, 0x22, 0x75,
-		0x38, 0xea, 0xbb, 0x4d, 0xa6, 0x4e, 0xbc, 0x79, 0x77, 0x3c, 0x70, 0x9a, 0xa1, 0xb8, 0xee, 0x78,
-		0xf9, 0x9a, 0xd3, 0xa9, 0xa6, 0x81, 0xd3, 0x70, 0x49, 0xca, 0x65, 0x02, 0x45, 0xc8, 0xf8, 0xa5,
-		0xfb, 0x7d, 0x1f, 0x93, 0xbc, 0xdf, 0xbe, 0xdb, 0x79, 0xbf, 0xda, 0xf1, 0x58, 0x60, 0x92, 0x08,
-		0x32, 0x22, 0x66, 0xee, 0x38, 0x35, 0xf4, 0x3c, 0xe6, 0xc6, 0x79, 0x0a, 0xd3, 0x30, 0x92, 0x8e,
-		0xd7, 0x21, 0x04, 0x6e, 0xd3, 0x43, 0xbb, 0x7d, 0x93, 0xea, 0x78, 0x20, 0x9c, 0x90, 0xb0, 0xe5,
-		0x7e, 0xb6, 0x6f, 0x9f, 0x43, 0xa8, 0x9f, 0x98, 0x88, 0x4b, 0xca, 0x2f, 0x18, 0x9f, 0x39, 0x9d,
-		0xb9, 0xec, 0x78, 0xc1, 0x26, 0x0b, 0xaa, 0x33, 0xcd, 0x61, 0x38, 0x9e, 0x09, 0x3d, 0x95, 0x27,
-		0x38, 0xa7, 0x9e, 0xd3, 0x57, 0x8e, 0xd7, 0xd7, 0x84, 0x8f, 0x46, 0x0d, 0x10, 0x39, 0x12, 0xeb,
-		0x84, 0x95, 0x8b, 0x60, 0xe4, 0x38, 0xa9, 0xef, 0xcc, 0xf5, 0x46, 0x8a, 0xa4, 0xde, 0x18, 0x4a,
-		0x39, 0xbe, 0x66, 0xc6, 0x9b, 0xbb, 0x0d, 0xa1, 0x4e, 0x85, 0xba, 0x26, 0xca, 0x07, 0x60, 0x04,
-		0xf7, 0x13, 0x23, 0x78, 0xe2, 0x76, 0xb3, 0x38, 0x49, 0xdc, 0xce, 0xd1, 0x5c, 0xd3, 0x75, 0xf7,
-		0xda, 0x67, 0x6d, 0xa7, 0xf7, 0xc1, 0x6d, 0xe7, 0xc2, 0x79, 0xfe, 0x36, 0xb7, 0x91, 0xe0, 0xea,
-		0x42, 0x0b, 0x40, 0x12, 0x4a, 0x93, 0xf8, 0x03, 0x32, 0x73, 0xbc, 0xa8, 0x76, 0xe2, 0xf4, 0x04,
-		0xa8, 0xf6, 0xdc, 0xee, 0xc1, 0xfe, 0x04, 0xc0, 0x3a, 0x7d, 0x9c, 0xe6, 0x8e, 0xab, 0xd6, 0x7f,
-		0x1c, 0x2f, 0xe9, 0x5a, 0x82, 0xbd, 0x83, 0xa1, 0xdb, 0x37, 0xd4, 0x52, 0x09, 0xd9, 0xe1, 0x6e,
-		0xf7, 0x98, 0x79, 0x5c, 0x01, 0x09, 0xa1, 0x11, 0x96, 0xeb, 0x5d, 0x37, 0x89, 0x89, 0xfa, 0x11,
-		0x71, 0x3c, 0xac, 0x0c, 0xa0, 0x69, 0x65, 0xdb, 0xed, 0x7b, 0xf7, 0x88, 0xfa, 0x8c, 0x0c, 0x09,
-		0x0b, 0xdd, 0x67, 0xc7, 0x5c, 0x19, 0x8c, 0xa6, 0xfb, 0xbd, 0xb2, 0xa5, 0xe0, 0xf5, 0x96, 0xd3,
-		0x57, 0x2e, 0xe4, 0x8a, 0x79, 0xec, 0x7c, 0xd9, 0xc7, 0xd2, 0xf9, 0x42, 0x90, 0x9b, 0x46, 0x4b,
-		0xba, 0x9d, 0xd0, 0x11, 0x0a, 0x8f, 0x84, 0x13, 0x12, 0x5e, 0x82, 0xf2, 0x2e, 0x00, 0xd0, 0x63,
-		0x24, 0xf1, 0x83, 0x6e, 0xbf, 0xeb, 0x7a, 0x5f, 0xa3, 0xc1, 0xc8, 0xed, 0xdb, 0xb0, 0x6e, 0x08,
-		0xa0, 0x93, 0xbc, 0x66, 0x72, 0xcc, 0x9c, 0x8e, 0x80, 0x4c, 0x89, 0x66, 0x9e, 0xeb, 0x8d, 0x98,
-		0x56, 0x7d, 0xbe, 0x80, 0xd4, 0xa8, 0x49, 0x29, 0x5d, 0xa7, 0x31, 0x30, 0xb1, 0xfb, 0xe5, 0x9b,
-		0x67, 0xcc, 0x9b, 0x38, 0x1e, 0x50, 0x9b, 0xce, 0xa4, 0x14, 0x21, 0xf3, 0x6e, 0x89, 0xe7, 0x89,
-		0x98, 0x1b, 0xc7, 0x93, 0x58, 0x92, 0x1e, 0x0f, 0x7a, 0x48, 0xbc, 0x81, 0xeb, 0xa1, 0x90, 0xb9,
-		0x3f, 0xaf, 0x1f, 0x35, 0x1a, 0x10, 0x2a, 0x69, 0xeb, 0x6e, 0xfb, 0x19, 0xf5, 0xa6, 0xdb, 0x8d,
-		0xfe, 0x1b, 0xcd, 0x86, 0xf3, 0x3d, 0xd5, 0x64, 0x18, 0x83, 0x0a, 0xd6, 0x42, 0xb8, 0x8b, 0xf1,
-		0x88, 0xbc, 0x26, 0xb2, 0x2b, 0x4c, 0xbd, 0x7e, 0xe2, 0x36, 0x45, 0xc9, 0x32, 0x68, 0xd8, 0x71,
-		0xbd, 0xbf, 0xd1, 0xda, 0x15, 0x87, 0x41, 0xe4, 0x13, 0x36, 0x99, 0xbc, 0x71, 0x9e, 0x20, 0xde,
-		0xe7, 0x7f, 0xb9, 0xcd, 0x1c, 0xe8, 0x7b, 0x4e, 0x6f, 0x80, 0x0e, 0x1d, 0xa7, 0xe8, 0xd2, 0x35,
-		0xf7, 0x4b, 0xd7, 0xa2, 0x2b, 0xa7, 0xe3, 0x81, 0x62, 0x19, 0x32, 0x9f, 0x28, 0xc2, 0xb5, 0x74,
-		0x3c, 0xf7, 0x97, 0x04, 0x21, 0xe1, 0x49, 0x6a, 0x11, 0x80, 0xf6, 0x77, 0x63, 0xd7, 0x1b, 0x01,
-		0x39, 0x1d, 0x03, 0x21, 0xfa, 0x96, 0x3b, 0x0d, 0x98, 0x66, 0xd2, 0xed, 0xcc, 0xae, 0x90, 0xc8,
-		0xa9, 0xe3, 0xd9, 0x12, 0xe3, 0x78, 0x0a, 0xa2, 0x15, 0xfb, 0xb2, 0xb7, 0x28, 0x84, 0xb8, 0x13,
-		0x31, 0xd1, 0x8a, 0x95, 0xce, 0x69, 0xe5, 0x1a, 0x19, 0x00, 0xb7, 0x15, 0x00, 0x68, 0x33, 0xeb,
-		0xce, 0xd3, 0x66, 0xae, 0x8b, 0x3c, 0xbb, 0x46, 0xb9, 0x1f, 0xad, 0xf9, 0x6e, 0xe4, 0x4a, 0xba,
-		0x2f, 0x88, 0xcf, 0x84, 0xd3, 0x13, 0x72, 0x3f, 0xd1, 0xc0, 0xd7, 0xf5, 0xd3, 0xee, 0x00, 0x00,
-		0x51, 0xb1, 0xe3, 0x29, 0xfe, 0x9e, 0xeb, 0xbd, 0x82, 0x3c, 0xcf, 0xf4, 0xa2, 0xd8, 0x69, 0x59,
-		0x98, 0xb1, 0x19, 0x99, 0xb2, 0x25, 0x41, 0xb1, 0xe3, 0xbb, 0xe1, 0xb3, 0x19, 0x33, 0x24, 0xfc,
-		0xae, 0x88, 0x94, 0x54, 0x01, 0xea, 0x1b, 0xa4, 0x23, 0x5f, 0xf7, 0x3d, 0x06, 0x21, 0x21, 0xb5,
-		0x75, 0xca, 0xa6, 0x6e, 0x03, 0xf5, 0xb0, 0xe1, 0x7c, 0x70, 0x3c, 0xb9, 0xac, 0x9f, 0xf8, 0x6e,
-		0x67, 0xcc, 0x5f, 0x35, 0x0f, 0x1c, 0xf7, 0xf7, 0x4e, 0x63, 0xb7, 0xb3, 0xa1, 0x4c, 0xec, 0xba,
-		0x62, 0x65, 0x94, 0xd2, 0x7a, 0xf3, 0x78, 0xdf, 0xe9, 0x84, 0x15, 0x11, 0x51, 0xc9, 0x09, 0x0c,
-		0x02, 0x16, 0x00, 0xf0, 0x63, 0x24, 0xae, 0xa9, 0x0a, 0x19, 0x77, 0x3b, 0xae, 0x16, 0xea, 0x6b,
-		0xd7, 0xf3, 0x3e, 0xd6, 0x39, 0x1f, 0xa3, 0x86, 0xd3, 0xf9, 0xd8, 0x44, 0x11, 0xb7, 0x49, 0x19,
-		0x94, 0x04, 0x70, 0x09, 0x09, 0xa1, 0xfb, 0xa8, 0x0c, 0xf5, 0xc4, 0x79, 0x93, 0x4d, 0x4c, 0x34,
-		0x15, 0xdc, 0xe9, 0x8a, 0xb6, 0xd9, 0xf1, 0xf1, 0x51, 0xc3, 0x7d, 0xa2, 0x0f, 0xee, 0xb9, 0xad,
-		0x98, 0x14, 0xf1, 0x99, 0x18, 0xb6, 0x3b, 0x6e, 0x47, 0x97, 0x1f, 0xe4, 0x9d, 0xb9, 0xcf, 0x91,
-		0x16, 0x09, 0x9f, 0x3a, 0x9d, 0x3e, 0x37, 0x6f, 0x36, 0x9a, 0x5f, 0x89, 0xa1, 0x97, 0x94, 0x4a,
-		0xb7, 0x03, 0x1d, 0x4b, 0x12, 0x41, 0xa7, 0xa1, 0x87, 0x21, 0x6a, 0xe0, 0x78, 0xac, 0xc6, 0xed,
-		0x8a, 0xfa, 0x05, 0x0e, 0x77, 0x9c, 0x14, 0x60, 0x93, 0xb9, 0xe5, 0x78, 0x73, 0x0d, 0x08, 0x94,
-		0x87, 0x00, 0xb8, 0x27, 0x3d, 0xae, 0xdc, 0x66, 0x63, 0x28, 0x52, 0x71, 0x90, 0xeb, 0xc9, 0x1f,
-		0x9f, 0x76, 0xfb, 0x3d, 0xe9, 0x3f, 0x9d, 0xee, 0x93, 0x29, 0x37, 0xa9, 0xd2, 0xe6, 0x5c, 0x18,
-		0x62, 0x98, 0xe0, 0x95, 0x2f, 0x19, 0xb6, 0xa7, 0xa2, 0xbd, 0x39, 0x8d, 0x88, 0x24, 0x66, 0xbe,
-		0xd8, 0x90, 0xcf, 0x92, 0x78, 0x97, 0xd4, 0x54, 0xcd, 0x3a, 0x4b, 0xf6, 0xd1, 0x2f, 0xb8, 0x4f,
-		0xd5, 0x67, 0xb6, 0xce, 0xf5, 0xd2, 0x77, 0xff, 0xfb, 0xd9, 0x13, 0x3c, 0x48, 0x5b, 0x21, 0xbd,
-		0x7d, 0xe6, 0x29, 0x66, 0x5d, 0xe1, 0xcb, 0x43, 0x94, 0x6e, 0xae, 0x77, 0x1d, 0xfc, 0x16, 0x3f,
-		0x53, 0xae, 0xe9, 0x1f, 0x8c, 0xfb, 0x95, 0x2f, 0x7b, 0xb5, 0x94, 0x1f, 0xef, 0x2c, 0x97, 0x20,
-		0xfd, 0x03, 0x23, 0x45, 0x03, 0x76, 0x93, 0x6d, 0xbf, 0x36, 0xa2, 0x73, 0x79, 0x6f, 0x97, 0x2a,
-		0xe9, 0x25, 0xb9, 0x32, 0x16, 0xb1, 0xf2, 0x68, 0xa6, 0x2f, 0x5d, 0x2e, 0x06, 0xbd, 0xbd, 0x16,
-		0xca, 0x5f, 0xda, 0xe1, 0xe4, 0xbd, 0xb3, 0xa9, 0x8f, 0xca, 0xef, 0x44, 0xb7, 0xd5, 0x2c, 0x8e,
-		0x28, 0x37, 0x95, 0x2f, 0x7b, 0x46, 0xc5, 0x34, 0xe3, 0x00, 0xf7, 0x9e, 0x7e, 0x34, 0xfd, 0x77,
-		0x92, 0xb9, 0xc9, 0xad, 0xa4, 0xf9, 0x76, 0x2f, 0xa4, 0x24, 0x50, 0x34, 0xc8, 0xb2, 0x6f, 0xab,
-		0xc3, 0x58, 0x3f, 0xcc, 0xf0, 0xcc, 0x68, 0x25, 0xd6, 0xff, 0xfa, 0xd7, 0x4a, 0x3e, 0x3f, 0x27,
-		0xc7, 0xff, 0x0d, 0x85, 0x74, 0x15, 0xf0, 0xaa, 0x46, 0xc2, 0x8f, 0x43, 0x5a, 0x5d, 0xb1, 0xa1,
-		0x67, 0xd1, 0x52, 0x8f, 0x63, 0x67, 0xcf, 0x0c, 0x95, 0x4d, 0x9c, 0xeb, 0x28, 0xce, 0x28, 0xce,
-		0xcf, 0xbd, 0x50, 0x97, 0xa9, 0x6c, 0x9b, 0xe7, 0xad, 0x4f, 0x48, 0xc6, 0xb5, 0xdf, 0xa0, 0xb7,
-		0xe5, 0xf3, 0x19, 0xd7, 0x2d, 0xdb, 0x31, 0xce, 0x7d, 0x9c, 0x8b, 0x1c, 0x6b, 0x6b, 0xc7, 0xbb,
-		0xe8, 0x31, 0xb7, 0x76, 0xdc, 0xad, 0x1d, 0x7b, 0x9b, 0xc7, 0x3f, 0x27, 0x42, 0xcd, 0xb8, 0xff,
-		0x59, 0xc5, 0x62, 0xf3, 0x20, 0xf3, 0xf3, 0xef, 0xd7, 0xc6, 0x49, 0xf3, 0xf3, 0x6e, 0x54, 0x36,
-		0xf0, 0x66, 0x4d, 0x5c, 0x6c, 0x88, 0x8d, 0x75, 0xf1, 0xb1, 0x25, 0x46, 0xd6, 0xc5, 0xc9, 0xba,
-		0x58, 0xed, 0x42, 0xbc, 0x8a, 0x39, 0x9c, 0x39, 0xbd, 0xec, 0xec, 0xe0, 0xf2, 0xc5, 0xd3, 0x13,
-		0x33, 0x6e, 0x9a, 0x8d, 0x22, 0xc7, 0x66, 0x25, 0x4b, 0x87, 0x05, 0x86, 0xb8, 0x20, 0x7c, 0xb6,
-		0x78, 0x9b, 0x7f, 0x17, 0xda, 0xd6, 0x62, 0xc7, 0x36, 0x79, 0x91, 0x21, 0xe3, 0x85, 0xcf, 0xbf,
-		0x25, 0x25, 0xf3, 0x64, 0xb8, 0x6f, 0x24, 0x8c, 0xa9, 0xc5, 0xf1, 0x4e, 0x15, 0x49, 0x10, 0x72,
-		0x97, 0xcd, 0x98, 0xd1, 0x8b, 0x81, 0x0b, 0x8f, 0xfb, 0xeb, 0x37, 0x0b, 0x5b, 0x40, 0x6e, 0x4a,
-		0xbf, 0x05, 0xfb, 0x8d, 0xe3, 0xfd, 0xe3, 0x83, 0xc3, 0xc6, 0x71, 0xab, 0xc4, 0x7b, 0xf1, 0xe9,
-		0x7d, 0x9e, 0xfe, 0xf1, 0xe9, 0x6d, 0xbe, 0x6f, 0xb7, 0xb8, 0x26, 0x67, 0xc4, 0xcc, 0x7a, 0xe4,
-		0xec, 0x45, 0xbf, 0x36, 0x53, 0x4c, 0x2d, 0x47, 0xfc, 0x31, 0x83, 0x97, 0xf9, 0xf0, 0x1d, 0xf3,
-		0x7b, 0x3c, 0x8f, 0xc6, 0x41, 0xcf, 0x07, 0x3d, 0x1f, 0x88, 0x9e, 0x4f, 0xce, 0xc0, 0x80, 0x9d,
-		0x00, 0x41, 0x41, 0x71, 0x41, 0x0f, 0x08, 0x3d, 0xa0, 0x72, 0x78, 0x40, 0x79, 0xc5, 0xef, 0x4e,
-		0x0c, 0x57, 0x69, 0x96, 0x5f, 0x6c, 0x57, 0xab, 0x16, 0xdc, 0x19, 0x3b, 0x90, 0xb5, 0xb0, 0x80,
-		0xda, 0x14, 0xd4, 0x9d, 0x09, 0xac, 0x6d, 0xc1, 0xdd, 0x99, 0x00, 0xef, 0x4c, 0x90, 0x77, 0x29,
-		0xd0, 0x96, 0x3c, 0x89, 0x82, 0xe7, 0xaf, 0x70, 0xa8, 0xe3, 0xc9, 0xe9, 0xd3, 0x46, 0x31, 0x3e,
-		0xb3, 0x71, 0xec, 0xd6, 0x46, 0xf4, 0xe8, 0x9d, 0x7c, 0xad, 0x02, 0x6b, 0x9b, 0xed, 0x86, 0x7c,
-		0xeb, 0x9a, 0x66, 0xb8, 0x39, 0x47, 0xcd, 0x87, 0x9a, 0x0f, 0x35, 0x1f, 0x6a, 0xbe, 0x62, 0x9a,
-		0xef, 0x4d, 0xc1, 0x66, 0xc1, 0x68, 0xd0, 0x1b, 0x46, 0x85, 0x1e, 0xfe, 0x4b, 0xae, 0x20, 0x51,
-		0x81, 0x90, 0x5c, 0x8e, 0x08, 0x86, 0x36, 0xc4, 0xd0, 0xe2, 0xce, 0xf0, 0x72, 0x98, 0x77, 0xf6,
-		0x85, 0x1b, 0xe8, 0x0b, 0xa3, 0x2f, 0x8c, 0xbe, 0x30, 0xfa, 0xc2, 0x88, 0x08, 0x11, 0x11, 0x22,
-		0x22, 0x44, 0x5f, 0x18, 0x35, 0x1f, 0x6a, 0x3e, 0xd4, 0x7c, 0xa8, 0xf9, 0xd0, 0x17, 0x2e, 0x81,
-		0x2f, 0xbc, 0x74, 0x11, 0x31, 0x3b, 0xe5, 0x1d, 0xd6, 0xbe, 0x92, 0x2b, 0x30, 0xa0, 0x62, 0xcf,
-		0xac, 0x2c, 0x73, 0x65, 0x94, 0xbc, 0xd1, 0xe4, 0xee, 0x85, 0x7e, 0x6e, 0x48, 0xc5, 0x7f, 0xae,
-		0xfa, 0x1d, 0x0f, 0x93, 0xaf, 0xea, 0x6c, 0xde, 0xe1, 0xe1, 0xef, 0xcb, 0x90, 0x28, 0x93, 0x2f,
-		0xd6, 0x51, 0x28, 0xc6, 0x51, 0x38, 0x2d, 0xa6, 0x81, 0x69, 0x31, 0x98, 0x16, 0xf3, 0x06, 0xb1,
-		0x08, 0x2c, 0x08, 0xc0, 0x10, 0x20, 0x86, 0x00, 0xb1, 0x20, 0x60, 0x39, 0x04, 0x16, 0x04, 0xa4,
-		0x18, 0x0e, 0x0b, 0x02, 0x4a, 0xb3, 0x05, 0x58, 0x10, 0xf0, 0xf2, 0x1f, 0x2c, 0x08, 0x78, 0x1b,
-		0x97, 0x2b, 0x87, 0x7f, 0xfb, 0x51, 0xf9, 0x48, 0x8a, 0xb2, 0x05, 0xd8, 0x71, 0x50, 0xdf, 0x92,
-		0x6a, 0x21, 0x9b, 0xeb, 0x99, 0xcb, 0xe5, 0x44, 0x0a, 0x05, 0x9b, 0x98, 0x17, 0x29, 0x14, 0xf6,
-		0x2a, 0xc4, 0x8f, 0x18, 0xaf, 0x2e, 0xce, 0x60, 0xac, 0xf3, 0x87, 0x4d, 0x1e, 0x8c, 0x92, 0x2f,
-		0x7a, 0x52, 0x73, 0xa9, 0xa8, 0x48, 0x78, 0x55, 0x16, 0x60, 0xd4, 0xe4, 0xb1, 0x08, 0x2c, 0x97,
-		0xa5, 0x64, 0xa8, 0x22, 0xb7, 0xdb, 0x76, 0xd7, 0xd9, 0x88, 0xc7, 0x11, 0x55, 0x24, 0xa3, 0xf1,
-		0x7a, 0xa2, 0xb3, 0xf7, 0x73, 0x3c, 0xdb, 0xe3, 0x71, 0xb4, 0x78, 0xf9, 0x5f, 0x25, 0x08, 0xad,
-		0x92, 0xd8, 0x88, 0x2a, 0xa7, 0x33, 0x61, 0x58, 0xa1, 0x18, 0xeb, 0xa3, 0x71, 0xb2, 0x06, 0xbf,
-		0x68, 0x40, 0xe2, 0x30, 0x39, 0x6e, 0x8b, 0x53, 0xfb, 0x41, 0xb4, 0x0d, 0x35, 0x73, 0x54, 0x37,
-		0xcf, 0xa8, 0x9b, 0xc5, 0xba, 0x80, 0xd3, 0x37, 0x53, 0x21, 0x42, 0x4a, 0x0a, 0xe9, 0x9a, 0x7a,
-		0x09, 0xf4, 0x85, 0x27, 0xe2, 0x05, 0x3c, 0xd7, 0x45, 0xf8, 0x99, 0x56, 0x23, 0x7c, 0x80, 0x3a,
-		0x65, 0x84, 0x14, 0xee, 0x40, 0x8a, 0xfc, 0x75, 0xc9, 0x44, 0x29, 0x46, 0xd5, 0xd2, 0x4f, 0x60,
-		0x0b, 0x40, 0xa1, 0x2d, 0x14, 0x29, 0x3f, 0x33, 0x28, 0x5e, 0xd1, 0xe4, 0x16, 0x28, 0x5b, 0x82,
-		0x65, 0x5d, 0xc0, 0xac, 0x0b, 0x9a, 0x4d, 0x81, 0x2b, 0x16, 0xc3, 0x7c, 0xff, 0x2b, 0x99, 0x95,
-		0xa9, 0x39, 0xd8, 0xb7, 0x70, 0x2b, 0x73, 0x84, 0xb7, 0x32, 0x56, 0xf5, 0xca, 0x93, 0xe1, 0xf0,
-		0x56, 0xa6, 0x34, 0x5b, 0x50, 0x3f, 0xda, 0xdf, 0x3f, 0x38, 0xdc, 0xdf, 0xaf, 0x1d, 0x36, 0x0f,
-		0x6b, 0xc7, 0xad, 0x56, 0xfd, 0xa0, 0x8e, 0xf7, 0x33, 0x4f, 0xfe, 0xfc, 0x28, 0x71, 0x75, 0x18,
-		0xe3, 0xd5, 0xa9, 0x12, 0xc4, 0xf7, 0x88, 0x36, 0x55, 0x79, 0x69, 0x2c, 0x20, 0x92, 0xa7, 0x43,
-		0x22, 0x1e, 0x41, 0x3c, 0x82, 0x78, 0x04, 0xf1, 0x08, 0xe2, 0x11, 0xc4, 0x23, 0x88, 0x47, 0x10,
-		0x8f, 0xbc, 0x8e, 0x47, 0x7c, 0xa6, 0x3d, 0xa2, 0x7c, 0x3b, 0x48, 0x64, 0x33, 0x18, 0x62, 0x10,
-		0xc4, 0x20, 0x88, 0x41, 0x10, 0x83, 0x20, 0x06, 0x41, 0x0c, 0x82, 0x18, 0x04, 0x31, 0xc8, 0xeb,
-		0x18, 0x84, 0x2a, 0x25, 0x94, 0x1d, 0x04, 0xb2, 0x1a, 0x0a, 0xf1, 0x07, 0xe2, 0x0f, 0xc4, 0x1f,
-		0x88, 0x3f, 0x10, 0x7f, 0x20, 0xfe, 0x40, 0xfc, 0x81, 0xf8, 0xe3, 0x75, 0xfc, 0x11, 0x78, 0xda,
-		0x26, 0x06, 0xb9, 0x37, 0x1c, 0xe2, 0x10, 0xc4, 0x21, 0x88, 0x43, 0x10, 0x87, 0x20, 0x0e, 0x41,
-		0x1c, 0x82, 0x38, 0x04, 0x71, 0xc8, 0xeb, 0x38, 0x24, 0x8a, 0x43, 0xc3, 0xec, 0xe6, 0x86, 0x3c,
-		0x1a, 0x12, 0xf1, 0x08, 0xe2, 0x11, 0xc4, 0x23, 0x88, 0x47, 0x10, 0x8f, 0x20, 0x1e, 0x41, 0x3c,
-		0x82, 0x78, 0xe4, 0x75, 0x3c, 0x22, 0x3c, 0x43, 0x2d, 0xe1, 0x90, 0xd5, 0x50, 0x88, 0x3f, 0x10,
-		0x7f, 0x20, 0xfe, 0x40, 0xfc, 0x81, 0xf8, 0x03, 0xf1, 0x07, 0xe2, 0x0f, 0xc4, 0x1f, 0xaf, 0xe3,
-		0x0f, 0x6b, 0x51, 0x10, 0x8c, 0x7d, 0x20, 0xf6, 0x40, 0xec, 0x81, 0xd8, 0x03, 0xb1, 0x07, 0x62,
-		0x0f, 0xc4, 0x1e, 0x88, 0x3d, 0x52, 0x60, 0x8f, 0x98, 0x5b, 0xbe, 0x89, 0x79, 0x30, 0x20, 0x62,
-		0x11, 0xc4, 0x22, 0x88, 0x45, 0x10, 0x8b, 0x20, 0x16, 0x41, 0x2c, 0x82, 0x58, 0x04, 0xb1, 0xc8,
-		0x36, 0x2c, 0x72, 0xc9, 0xc5, 0x35, 0xaf, 0x4a, 0x25, 0x8c, 0xb0, 0x85, 0x46, 0x1e, 0x0c, 0x89,
-		0x78, 0x04, 0xf1, 0x08, 0xe2, 0x11, 0xc4, 0x23, 0x88, 0x47, 0x10, 0x8f, 0x20, 0x1e, 0x41, 0x3c,
-		0xf2, 0xca, 0x32, 0x87, 0x44, 0x9b, 0xaa, 0x17, 0x52, 0xa2, 0x8a, 0x03, 0x91, 0x7b, 0x63, 0x21,
-		0x02, 0x41, 0x04, 0x82, 0x08, 0x24, 0xc3, 0x69, 0x31, 0x2c, 0xa2, 0x86, 0x79, 0x97, 0x1a, 0x31,
-		0x08, 0x62, 0x10, 0xc4, 0x20, 0x88, 0x41, 0x3e, 0x10, 0x06, 0x11, 0xb1, 0xb1, 0x4e, 0xa4, 0xfa,
-		0xcc, 0x98, 0x88, 0x49, 0x10, 0x93, 0x20, 0x26, 0xc1, 0xa8, 0x08, 0x22, 0x12, 0x44, 0x24, 0x88,
-		0x48, 0x10, 0x91, 0x6c, 0x41, 0x24, 0xf6, 0xa8, 0x54, 0x1f, 0x8c, 0x86, 0x28, 0x04, 0x51, 0x08,
-		0xa2, 0x10, 0x44, 0x21, 0x88, 0x42, 0x10, 0x85, 0x20, 0x0a, 0x41, 0x14, 0xb2, 0x05, 0x85, 0xd8,
-		0x22, 0x32, 0xbb, 0x37, 0x16, 0x22, 0x10, 0x44, 0x20, 0x88, 0x40, 0x10, 0x81, 0x20, 0x02, 0x41,
-		0x04, 0x82, 0x08, 0x04, 0x11, 0xc8, 0x16, 0x04, 0x62, 0x9b, 0xc6, 0xec, 0x99, 0x31, 0x11, 0x91,
-		0x20, 0x22, 0x41, 0x44, 0x82, 0x88, 0x04, 0x11, 0x09, 0x22, 0x12, 0x44, 0x24, 0x88, 0x48, 0xb6,
-		0x20, 0x12, 0x5b, 0x44, 0x66, 0xf7, 0xc6, 0x42, 0x04, 0x82, 0x08, 0x04, 0x11, 0x08, 0x22, 0x10,
-		0x44, 0x20, 0x88, 0x40, 0x10, 0x81, 0x20, 0x02, 0xd9, 0x82, 0x40, 0xec, 0x45, 0x42, 0x30, 0xfe,
-		0x81, 0xe8, 0x03, 0xd1, 0x07, 0xa2, 0x0f, 0x44, 0x1f, 0x88, 0x3e, 0x10, 0x7d, 0x20, 0xfa, 0x48,
-		0x83, 0x3e, 0xec, 0x92, 0x99, 0x3d, 0x19, 0x11, 0xd1, 0x08, 0xa2, 0x11, 0x44, 0x23, 0x88, 0x46,
-		0x10, 0x8d, 0x20, 0x1a, 0x41, 0x34, 0xf2, 0x71, 0xd0, 0xc8, 0xa7, 0x1d, 0x9e, 0xd1, 0x4a, 0x9b,
-		0x73, 0x61, 0xc8, 0x62, 0x4b, 0x72, 0x1d, 0xcb, 0x8a, 0xf6, 0xe6, 0x34, 0x22, 0x92, 0x98, 0xf9,
-		0x42, 0xe3, 0x7e, 0x96, 0xc4, 0xbb, 0xa4, 0xa6, 0x6a, 0x14, 0xe1, 0x5a, 0x0a, 0x65, 0x1e, 0xfd,
-		0x82, 0xfb, 0x54, 0x7d, 0x66, 0x0b, 0xa5, 0x1c, 0x10, 0x8f, 0xea, 0xbb, 0xff, 0xfd, 0xac, 0x0d,
-		0x31, 0xf4, 0xf3, 0x4a, 0x63, 0xe7, 0xc1, 0x3a, 0x15, 0x6d, 0x54, 0xec, 0x19, 0xbe, 0xd2, 0xfd,
-		0xa3, 0xe4, 0x7b, 0x27, 0x77, 0x5f, 0xfb, 0xb3, 0xbf, 0xfe, 0xae, 0x9f, 0x9d, 0xf5, 0xb7, 0x7c,
-		0xda, 0xcd, 0x3e, 0x64, 0xd8, 0x83, 0x8a, 0x4f, 0xb5, 0xa7, 0x98, 0xcc, 0xb5, 0x01, 0x1b, 0x43,
-		0x77, 0x7f, 0x90, 0x8c, 0xfb, 0x9f, 0x4f, 0x85, 0xe4, 0x46, 0x85, 0x45, 0xd0, 0x60, 0x61, 0x14,
-		0x58, 0x14, 0xfd, 0x59, 0x43, 0x7d, 0xd6, 0xd0, 0x9e, 0x0d, 0x94, 0xb7, 0x5b, 0xfd, 0x92, 0x1b,
-		0xcd, 0x6d, 0x76, 0x5b, 0x1b, 0xc5, 0xf8, 0x2c, 0xcf, 0x76, 0xaf, 0xce, 0x76, 0xfd, 0xa8, 0x0c,
-		0x72, 0x1e, 0xcb, 0x90, 0xde, 0x54, 0x23, 0xe1, 0xd3, 0x02, 0x72, 0x7e, 0x6f, 0x90, 0x8f, 0x21,
-		0xe7, 0xd4, 0xcc, 0x51, 0xd0, 0x9f, 0x11, 0xf4, 0xc5, 0xba, 0x80, 0x93, 0x74, 0xca, 0xe3, 0x88,
-		0x2a, 0x92, 0xc3, 0x8c, 0x3d, 0x10, 0xf7, 0xfd, 0x1c, 0xcf, 0xf6, 0x78, 0x1c, 0x2d, 0x5e, 0xfe,
-		0x57, 0x09, 0x54, 0x05, 0xe5, 0x64, 0x1a, 0xd2, 0x6a, 0x10, 0x8a, 0xeb, 0xaa, 0x27, 0xb8, 0x51,
-		0x22, 0xcc, 0xaf, 0x32, 0x9e, 0x1b, 0x2c, 0xe3, 0xc6, 0x76, 0x69, 0x40, 0xe2, 0x30, 0x39, 0x79,
-		0x01, 0x09, 0x35, 0xaa, 0x1e, 0x54, 0x3d, 0xe0, 0x54, 0xcf, 0x54, 0x88, 0x90, 0x92, 0x42, 0x6a,
-		0xa7, 0x5e, 0x1a, 0xd5, 0xe1, 0x17, 0x55, 0x17, 0x7e, 0x01, 0x15, 0xb1, 0x38, 0x68, 0xe8, 0x84,
-		0xa0, 0x13, 0x82, 0xfa, 0xa1, 0x84, 0xfa, 0x81, 0x05, 0x8c, 0xfb, 0xf4, 0x26, 0xbf, 0x7e, 0x58,
-		0x0f, 0x80, 0x02, 0x8e, 0x02, 0x0e, 0x4a, 0xc0, 0x63, 0xc6, 0x4d, 0xb3, 0x51, 0x40, 0xbe, 0x0f,
-		0x73, 0x3c, 0x5a, 0xec, 0x62, 0xa8, 0xc0, 0x0d, 0x99, 0x8d, 0x8b, 0x20, 0x4b, 0xb7, 0x0f, 0xb6,
-		0x2e, 0x7e, 0x6c, 0x5e, 0x2d, 0x14, 0xb8, 0xe8, 0xb1, 0x72, 0xc1, 0x63, 0x7b, 0x69, 0xf7, 0x1b,
-		0xc7, 0xfb, 0xc7, 0x07, 0x87, 0x8d, 0xe3, 0x56, 0x89, 0xd6, 0xf8, 0x8d, 0xae, 0x4f, 0x7e, 0x94,
-		0xc0, 0xec, 0x2e, 0x09, 0xd8, 0xe7, 0x2b, 0x69, 0xcf, 0x69, 0x7a, 0xef, 0x0f, 0x82, 0xe6, 0x17,
-		0xcd, 0x2f, 0x28, 0xf3, 0x5b, 0x8c, 0x66, 0xbd, 0x40, 0x92, 0x06, 0xda, 0x60, 0xb4, 0xc1, 0x6f,
-		0xb2, 0xb4, 0xf6, 0x93, 0x2a, 0xd0, 0x1a, 0xe7, 0xb4, 0xc6, 0x62, 0xc6, 0x3c, 0x52, 0x20, 0xa6,
-		0xbe, 0x1e, 0x00, 0xad, 0x30, 0x5a, 0x61, 0x8c, 0x72, 0x95, 0x2f, 0xca, 0x15, 0x0a, 0x21, 0xa7,
-		0xc4, 0xbb, 0x2c, 0x78, 0xdb, 0xfe, 0x70, 0x18, 0xbc, 0x34, 0x43, 0x65, 0x81, 0xca, 0x02, 0x9e,
-		0xb2, 0x88, 0x88, 0x57, 0x25, 0xbe, 0xaf, 0xa8, 0xd6, 0xf9, 0x55, 0xc5, 0xfd, 0x41, 0xf0, 0x76,
-		0xfc, 0x23, 0x4b, 0x3a, 0xc8, 0xdb, 0xf1, 0xfc, 0xc7, 0xfb, 0x81, 0xb8, 0xe7, 0x71, 0xcf, 0x47,
-		0xc4, 0x18, 0xaa, 0x78, 0x6e, 0x07, 0xbd, 0xf2, 0xbf, 0xff, 0xae, 0x55, 0x8f, 0x49, 0x35, 0x68,
-		0x57, 0x4f, 0x7f, 0xfc, 0xa7, 0xf1, 0xeb, 0xbf, 0xbe, 0x3c, 0xfc, 0xfb, 0x7f, 0xff, 0xa7, 0xf5,
-		0xeb, 0xff, 0x54, 0x9c, 0xf4, 0x63, 0x22, 0x13, 0x17, 0xd0, 0x58, 0x26, 0x46, 0x48, 0x82, 0x90,
-		0x04, 0x96, 0x9e, 0x8a, 0x19, 0x37, 0xf5, 0x83, 0x02, 0x2a, 0xea, 0x00, 0x03, 0x88, 0x18, 0x40,
-		0x2c, 0x69, 0x00, 0xf1, 0xa0, 0xd5, 0x6a, 0x62, 0xc4, 0xf0, 0x5d, 0x2c, 0xed, 0xaa, 0xa8, 0x28,
-		0xa7, 0xa9, 0x4d, 0x9e, 0x46, 0x5b, 0x8b, 0xb6, 0x16, 0x94, 0xad, 0x85, 0x51, 0x96, 0x23, 0x24,
-		0x55, 0x55, 0x6d, 0x88, 0x89, 0x0b, 0x78, 0xff, 0xf7, 0x07, 0x41, 0x39, 0x47, 0x39, 0x07, 0x25,
-		0xe7, 0x58, 0x94, 0x93, 0xbc, 0x8b, 0x14, 0xca, 0x54, 0xb5, 0xa4, 0x45, 0x92, 0xeb, 0xef, 0x8d,
-		0x81, 0x41, 0x42, 0x0c, 0x12, 0x02, 0x53, 0x14, 0xcc, 0xa7, 0xdc, 0x30, 0x73, 0xab, 0x68, 0x50,
-		0x44, 0x51, 0xe4, 0x70, 0x71, 0x2a, 0xfd, 0xd5, 0x57, 0x9f, 0x10, 0x4d, 0x8b, 0x13, 0x2f, 0xf5,
-		0x26, 0xbf, 0xf7, 0x2e, 0xce, 0x7a, 0x93, 0x9f, 0xe3, 0x51, 0xaf, 0xd7, 0xcd, 0x7b, 0x76, 0x12,
-		0xb7, 0x4d, 0x17, 0x62, 0x7d, 0x29, 0xe8, 0x7f, 0xae, 0xe7, 0x93, 0x4c, 0xe3, 0xe7, 0x9f, 0x67,
-		0x7f, 0x9c, 0x9d, 0x7f, 0x3f, 0xab, 0xbc, 0x87, 0x3f, 0x6d, 0x75, 0x22, 0xf5, 0xaf, 0x27, 0x00,
-		0x26, 0x51, 0xab, 0xc1, 0x98, 0x06, 0x84, 0x59, 0x34, 0x5a, 0x30, 0x66, 0x51, 0xab, 0x0d, 0x01,
-		0xcc, 0x03, 0xc4, 0x66, 0xec, 0x83, 0x10, 0x8c, 0x16, 0x10, 0x25, 0x35, 0x84, 0x61, 0x31, 0x0a,
-		0x4d, 0x23, 0xd7, 0x93, 0x3f, 0x76, 0x8d, 0x52, 0x77, 0xe2, 0xab, 0x99, 0x3c, 0x58, 0xf6, 0x2e,
-		0x15, 0x7d, 0xf1, 0x34, 0x86, 0x71, 0x30, 0x8c, 0x83, 0xde, 0x59, 0x19, 0xbd, 0xb3, 0x0d, 0x65,
-		0x5c, 0x35, 0x87, 0xa0, 0x96, 0xd0, 0x3b, 0x63, 0x84, 0x93, 0xaa, 0x95, 0x49, 0xd9, 0x9c, 0x9c,
-		0x9d, 0x49, 0x3e, 0x99, 0x6c, 0xa0, 0x82, 0xfa, 0xc1, 0x30, 0x50, 0x27, 0x31, 0xf7, 0x43, 0x5a,
-		0x29, 0x03, 0x23, 0xa7, 0xe5, 0x19, 0x52, 0x19, 0x66, 0x25, 0x16, 0x74, 0x63, 0x62, 0x2c, 0x18,
-		0x5d, 0x27, 0x6a, 0x08, 0xe0, 0xdc, 0x3c, 0x22, 0xaf, 0x89, 0xec, 0x0a, 0x53, 0xaf, 0x8f, 0x94,
-		0x08, 0x18, 0xcc, 0xa3, 0x19, 0xf8, 0x3e, 0x83, 0x38, 0x2f, 0x5f, 0x37, 0x41, 0x0a, 0x1c, 0x0f,
-		0x18, 0x67, 0x53, 0xc2, 0x7d, 0x90, 0x22, 0x27, 0x82, 0x80, 0x82, 0x14, 0xb3, 0x2b, 0xc1, 0x3c,
-		0x7a, 0x7e, 0x45, 0x55, 0xdb, 0x44, 0x10, 0xe7, 0x37, 0x33, 0x12, 0xe2, 0xb4, 0xb4, 0xe0, 0xd4,
-		0x7c, 0x9b, 0x80, 0x3c, 0x91, 0xf5, 0x3a, 0xc4, 0x69, 0x4d, 0x15, 0xf3, 0x67, 0x20, 0x55, 0xc8,
-		0xfc, 0x56, 0x52, 0xe5, 0xcd, 0x09, 0xe7, 0x34, 0x04, 0x09, 0x92, 0x05, 0x07, 0xb9, 0x6d, 0xcd,
-		0x46, 0x73, 0xa4, 0xc4, 0xcd, 0x2d, 0xc8, 0xc9, 0x09, 0x6d, 0x46, 0x04, 0x24, 0x16, 0x59, 0x92,
-		0xd3, 0xff, 0xee, 0x87, 0x1e, 0x48, 0xd8, 0x4f, 0xb4, 0x01, 0x89, 0x43, 0x0e, 0x6b, 0x4d, 0x62,
-		0x1a, 0xd1, 0x14, 0x24, 0x1a, 0x31, 0xc4, 0xbb, 0x9c, 0x88, 0xf1, 0xe2, 0x07, 0xc8, 0xf9, 0xe9,
-		0xc3, 0x31, 0x9b, 0x0d, 0x18, 0x07, 0x39, 0xbb, 0x88, 0x78, 0x63, 0xea, 0xfd, 0xc9, 0x57, 0x84,
-		0xcb, 0x21, 0xf5, 0xfb, 0xa7, 0x10, 0xe7, 0xa9, 0xe8, 0x2c, 0x0e, 0x89, 0xaa, 0x1f, 0x35, 0x1a,
-		0x20, 0x4d, 0x1e, 0x93, 0x54, 0x85, 0x84, 0x83, 0x9c, 0x1c, 0x51, 0x1e, 0xa7, 0x66, 0x14, 0xc6,
-		0x30, 0xa3, 0x95, 0xd3, 0xa8, 0x79, 0x58, 0x93, 0x44, 0x75, 0xe6, 0x04, 0x24, 0xd6, 0xd4, 0xbe,
-		0xc7, 0x40, 0x47, 0x4f, 0x3a, 0x64, 0x0a, 0xf4, 0x86, 0xc0, 0xcc, 0xa9, 0xe2, 0xd4, 0x74, 0x74,
-		0x44, 0x3c, 0x1f, 0xf2, 0x0c, 0x9b, 0xc3, 0x29, 0x03, 0x09, 0x3d, 0x23, 0x26, 0x6e, 0x1a, 0x2d,
-		0xa8, 0xce, 0x42, 0x6f, 0xb1, 0x7d, 0xa7, 0x7f, 0x81, 0x34, 0x7a, 0x26, 0xfa, 0xe6, 0xb1, 0x1e,
-		0xf7, 0x47, 0x20, 0xcf, 0xa5, 0x22, 0xbe, 0x06, 0x19, 0x30, 0xba, 0x69, 0xb4, 0xa2, 0x10, 0x64,
-		0xd8, 0xd9, 0xa3, 0x20, 0xf1, 0x97, 0x14, 0xfc, 0x00, 0xa6, 0x53, 0xc0, 0x64, 0x82, 0x4e, 0x42,
-		0x72, 0x0d, 0x52, 0x83, 0x04, 0x5e, 0x7d, 0xff, 0xa8, 0x09, 0x33, 0xca, 0x57, 0xab, 0x1d, 0x01,
-		0x35, 0x6a, 0x17, 0xc4, 0x67, 0x02, 0xe2, 0xdc, 0x84, 0x34, 0xcc, 0x23, 0x61, 0x67, 0x79, 0x17,
-		0xf2, 0x55, 0x89, 0x18, 0xa4, 0x15, 0x50, 0x5a, 0x81, 0x0c, 0x63, 0xfa, 0xc2, 0xd3, 0x89, 0x23,
-		0x37, 0x24, 0x5e, 0x48, 0x6e, 0xa9, 0x02, 0x69, 0x11, 0x34, 0xcc, 0x43, 0x39, 0xd7, 0x9a, 0xc1,
-		0x4c, 0x3d, 0x89, 0xa4, 0xd0, 0xcc, 0x50, 0xa8, 0xe1, 0xe7, 0x80, 0x4d, 0x15, 0xed, 0xc0, 0xbd,
-		0x3e, 0x8e, 0x64, 0xa8, 0x61, 0xce, 0x8b, 0xce, 0x26, 0xeb, 0xce, 0xd9, 0x60, 0x83, 0x7b, 0xa7,
-		0x5f, 0xbb, 0xe7, 0x63, 0x90, 0x3e, 0x8f, 0x12, 0xf2, 0x1b, 0x53, 0x26, 0x26, 0x21, 0xd8, 0xcd,
-		0xeb, 0x9d, 0x80, 0xdc, 0xba, 0xd9, 0xf1, 0xf1, 0x51, 0x1d, 0xea, 0xc4, 0x40, 0x7a, 0x73, 0x24,
-		0x8c, 0x7b, 0x52, 0xf0, 0x73, 0x1e, 0x83, 0x94, 0x35, 0xa0, 0x71, 0x3c, 0x5f, 0xd7, 0x40, 0x7a,
-		0x00, 0x94, 0xd2, 0xa3, 0x5a, 0xa3, 0x7e, 0xf0, 0x7d, 0xd8, 0x3e, 0x03, 0x2c, 0x6d, 0x83, 0x65,
-		0xe3, 0x0b, 0xa8, 0xb8, 0xd9, 0xbf, 0x9a, 0x5e, 0x78, 0xde, 0x9f, 0x52, 0x1b, 0x45, 0x49, 0x04,
-		0x13, 0xa2, 0xb0, 0x88, 0xa8, 0xdb, 0xfe, 0xb8, 0x0b, 0xf2, 0x98, 0x6a, 0x76, 0x33, 0x11, 0xa7,
-		0x22, 0x56, 0x70, 0x93, 0x8a, 0x3a, 0xc0, 0x53, 0x8a, 0xe6, 0x4c, 0x4a, 0xd6, 0x5f, 0x97, 0x43,
-		0xc2, 0xcd, 0x5b, 0x3c, 0xd8, 0x87, 0x19, 0x7a, 0x50, 0x24, 0xa2, 0x17, 0x34, 0x24, 0xb7, 0x63,
-		0xaa, 0xae, 0x18, 0xcc, 0x1d, 0x24, 0xbe, 0x0e, 0x1b, 0x40, 0x6f, 0x12, 0xba, 0x37, 0x20, 0x63,
-		0x7e, 0x37, 0x47, 0x07, 0x03, 0x22, 0x61, 0x46, 0x8d, 0x34, 0x50, 0x67, 0x41, 0x2a, 0x21, 0x4f,
-		0xbe, 0xb7, 0x65, 0x63, 0x28, 0x81, 0xa2, 0xcd, 0x89, 0x1f, 0xc1, 0xbc, 0x10, 0xf1, 0xb9, 0x06,
-		0xaa, 0x1f, 0x57, 0x2e, 0x10, 0x64, 0x2f, 0xb6, 0xb5, 0x0f, 0x12, 0x58, 0x02, 0x2d, 0xef, 0x59,
-		0x3a, 0xad, 0x5d, 0x71, 0xcd, 0xe1, 0xba, 0xad, 0xcb, 0x7b, 0x83, 0xbf, 0xce, 0x41, 0x27, 0x3c,
-		0xf7, 0x25, 0xcc, 0x3b, 0x2d, 0x90, 0x52, 0x27, 0x7d, 0x9e, 0xa4, 0xc9, 0x0e, 0x84, 0x90, 0x20,
-		0x5d, 0x81, 0xa9, 0x06, 0xbf, 0x6f, 0x75, 0x98, 0xd8, 0x4b, 0x1c, 0x1d, 0xd5, 0x1a, 0x8d, 0x10,
-		0x68, 0x31, 0xab, 0xc7, 0x24, 0xd4, 0xf0, 0xf3, 0xb2, 0x22, 0x0b, 0xaa, 0x27, 0x37, 0x12, 0x8c,
-		0x9b, 0x89, 0x48, 0x7e, 0x8c, 0xa9, 0x62, 0x40, 0x31, 0xb4, 0xf6, 0x39, 0xd4, 0x0b, 0x49, 0x90,
-		0x76, 0x2e, 0x24, 0x12, 0x64, 0x0d, 0xd6, 0x4c, 0x35, 0x6b, 0xcd, 0x8b, 0x2e, 0x48, 0x3e, 0x1b,
-		0x8f, 0x69, 0x4f, 0xf4, 0xc7, 0x83, 0xab, 0x10, 0x66, 0x6d, 0xa7, 0xaf, 0x21, 0x43, 0x93, 0x56,
-		0xe7, 0xe2, 0x74, 0xd4, 0xe7, 0x30, 0x13, 0xdc, 0x9a, 0x87, 0x20, 0xd3, 0xd3, 0x25, 0xcc, 0xc4,
-		0xed, 0xe0, 0x5b, 0xc0, 0xa0, 0x72, 0x5a, 0x6a, 0x26, 0xc1, 0xde, 0x0f, 0x03, 0x8d, 0xe3, 0xb5,
-		0x35, 0x3b, 0x8f, 0x41, 0xea, 0xc5, 0x7f, 0x1a, 0xc7, 0x4d, 0x90, 0x36, 0x2d, 0x12, 0x1e, 0xf9,
-		0x46, 0x95, 0x66, 0x82, 0xd7, 0xc1, 0xb2, 0x22, 0x42, 0x75, 0x49, 0x87, 0x71, 0x68, 0x98, 0x0c,
-		0xe9, 0x8d, 0x80, 0x69, 0xde, 0x80, 0xde, 0x0b, 0x27, 0x67, 0xf2, 0xfc, 0x8a, 0xaa, 0x39, 0x25,
-		0x3e, 0xe4, 0x32, 0x97, 0x5b, 0xc5, 0x00, 0x4b, 0x5f, 0x57, 0x78, 0xfa, 0x3b, 0x53, 0x34, 0xa4,
-		0x5a, 0xc3, 0xbe, 0xbe, 0xf2, 0x85, 0xa7, 0xcf, 0x03, 0x3f, 0x82, 0x3d, 0xcb, 0xa4, 0x87, 0x41,
-		0x48, 0xc9, 0x15, 0x05, 0x5a, 0x18, 0x7f, 0x74, 0x78, 0x08, 0x94, 0xf5, 0x05, 0x6e, 0x29, 0xe4,
-		0x94, 0x19, 0x90, 0x99, 0x28, 0x71, 0x68, 0x14, 0x01, 0x69, 0xdc, 0x23, 0x5f, 0x03, 0xcd, 0x41,
-		0x9c, 0x43, 0x8d, 0xa1, 0x0b, 0xc3, 0xcf, 0xfd, 0x18, 0xb2, 0xe5, 0x26, 0x90, 0x8b, 0x42, 0x38,
-		0xd0, 0x50, 0xd1, 0x1d, 0xf9, 0x44, 0x27, 0x32, 0xc0, 0x21, 0x66, 0x14, 0x00, 0xa6, 0xd3, 0xbd,
-		0x5a, 0x16, 0x55, 0xf7, 0x65, 0xdb, 0xf7, 0x15, 0xd5, 0x20, 0x0d, 0xba, 0x01, 0x9a, 0x9e, 0x28,
-		0x95, 0x30, 0x54, 0xf0, 0xa3, 0x1a, 0x54, 0x2e, 0xc8, 0x0d, 0x63, 0x22, 0xc8, 0x9b, 0xd6, 0x69,
-		0xf8, 0x8d, 0x7a, 0x86, 0x8c, 0x0d, 0x01, 0x39, 0xbf, 0xab, 0xe8, 0x9a, 0x28, 0xba, 0x22, 0x6d,
-		0x38, 0x63, 0x1e, 0xd0, 0xda, 0xdd, 0xaf, 0x52, 0xf0, 0xd1, 0xfc, 0x56, 0x33, 0x8f, 0x84, 0x7f,
-		0x72, 0x06, 0x39, 0x79, 0xbd, 0x01, 0x78, 0x0b, 0x81, 0x92, 0x1d, 0x68, 0x11, 0x98, 0x85, 0x18,
-		0x0e, 0x84, 0x90, 0x53, 0xa0, 0xfd, 0x0e, 0x78, 0x40, 0x60, 0xc6, 0x21, 0x34, 0x48, 0xde, 0xba,
-		0x90, 0x71, 0x3a, 0x83, 0x4a, 0x36, 0x68, 0xa8, 0x8a, 0x80, 0x76, 0xf2, 0x09, 0x9b, 0x4c, 0x42,
-		0xcd, 0x0a, 0xdb, 0x14, 0x8a, 0x9c, 0x6e, 0x6a, 0xac, 0xe1, 0xd2, 0x4c, 0x0d, 0x4f, 0xbf, 0x76,
-		0x41, 0x5a, 0x72, 0xcf, 0xd0, 0x90, 0xe9, 0x21, 0x35, 0x64, 0x70, 0x7e, 0x3e, 0x02, 0x89, 0xc4,
-		0xa0, 0xca, 0x5f, 0xb3, 0x05, 0x9b, 0xb1, 0x61, 0x38, 0xea, 0xc3, 0x6c, 0x52, 0x44, 0xbc, 0x79,
-		0x77, 0x3c, 0x00, 0xd9, 0x69, 0xa3, 0x0e, 0xb4, 0xcc, 0x1a, 0x64, 0x4a, 0x7e, 0x00, 0x12, 0x4e,
-		0x4a, 0xb9, 0x4c, 0xa4, 0x0a, 0x19, 0xbf, 0x84, 0xdb, 0xd7, 0x3f, 0xa9, 0xa7, 0xe8, 0xc3, 0xac,
-		0xa7, 0xd0, 0x40, 0x63, 0xcf, 0x49, 0xa2, 0xd8, 0x88, 0x98, 0x39, 0xd0, 0x96, 0x28, 0xf3, 0x98,
-		0x1b, 0xb0, 0x94, 0xf8, 0x61, 0x24, 0x81, 0xd6, 0x9b, 0x05, 0x30, 0xdb, 0xa2, 0xc0, 0xcc, 0x6c,
-		0x00, 0x7a, 0xb1, 0x43, 0x48, 0xd8, 0x82, 0x5b, 0x45, 0xd1, 0xe7, 0x90, 0xeb, 0xe7, 0x26, 0xe2,
-		0x92, 0xf2, 0x0b, 0xc6, 0x67, 0x20, 0x2b, 0x45, 0x80, 0x12, 0x17, 0xb0, 0xa0, 0x3a, 0xd3, 0x1c,
-		0x76, 0x00, 0x21, 0xa1, 0xed, 0xf4, 0x04, 0xe7, 0xd4, 0x03, 0x79, 0xd5, 0x7f, 0x7d, 0x4d, 0xf8,
-		0x68, 0xd4, 0x00, 0x9d, 0x2b, 0xb5, 0x4e, 0x74, 0xbb, 0x08, 0x46, 0x40, 0x9b, 0x50, 0xcc, 0xa0,
-		0x36, 0xc4, 0x27, 0xf5, 0xc6, 0x50, 0xca, 0xf1, 0x35, 0x33, 0xde, 0x1c, 0x26, 0xc4, 0x3c, 0x15,
-		0xea, 0x9a, 0x28, 0x1f, 0xb0, 0x71, 0xdf, 0x4f, 0x8c, 0xfb, 0x09, 0xcc, 0xa6, 0xdc, 0x92, 0xc0,
-		0xcc, 0x59, 0x5f, 0xb7, 0xb5, 0xe9, 0xb5, 0xcf, 0xda, 0x20, 0xf7, 0x0d, 0xa6, 0x73, 0x07, 0x96,
-		0x37, 0x17, 0x26, 0x82, 0x5e, 0x5d, 0x0c, 0x03, 0x4e, 0x5e, 0x6b, 0x12, 0x7f, 0x40, 0x66, 0x40,
-		0xc9, 0x28, 0x26, 0x20, 0x27, 0x46, 0xb5, 0x27, 0xf8, 0x87, 0x70, 0x08, 0x40, 0x1e, 0xcb, 0x39,
-		0x50, 0x13, 0xf0, 0x0f, 0xd0, 0x12, 0xe3, 0x25, 0x48, 0x3e, 0x18, 0xc2, 0xcc, 0x30, 0x91, 0x4a,
-		0xc8, 0x0e, 0x87, 0xd9, 0xdb, 0xf2, 0x71, 0xc5, 0x3f, 0xe4, 0x86, 0xc0, 0x04, 0xa8, 0x56, 0x21,
-		0x26, 0xea, 0x47, 0x04, 0xe8, 0x75, 0x48, 0x03, 0x66, 0xc8, 0xe4, 0xfc, 0x8a, 0xaa, 0x36, 0xcc,
-		0x7c, 0x9a, 0x88, 0xfa, 0x8c, 0x0c, 0x09, 0x0b, 0xe1, 0xb2, 0xa7, 0xaf, 0x0c, 0x5e, 0xb3, 0xa3,
-		0x23, 0xe2, 0x81, 0x8c, 0x7b, 0x49, 0xc1, 0xeb, 0x2d, 0x90, 0x57, 0x91, 0xe4, 0x8a, 0x79, 0xec,
-		0x5c, 0x1a, 0xe6, 0x91, 0x10, 0x6c, 0x81, 0xe0, 0x4d, 0xa3, 0x25, 0x61, 0x26, 0x7c, 0x85, 0xc2,
-		0x23, 0xe1, 0x84, 0x84, 0x97, 0x1f, 0xc2, 0xbb, 0x03, 0x4c, 0xa3, 0x95, 0xc4, 0x8d, 0xba, 0xfd,
-		0x2e, 0xd4, 0xbe, 0xac, 0x83, 0x11, 0xcc, 0x5b, 0xe5, 0x6e, 0xe8, 0xb1, 0x1e, 0xf7, 0x47, 0x06,
-		0x68, 0x40, 0x6c, 0xcc, 0x40, 0x46, 0xc4, 0xa6, 0x44, 0x33, 0x0f, 0x6a, 0x83, 0xd9, 0x55, 0x1f,
-		0x64, 0xe0, 0xb5, 0xd4, 0x52, 0x4a, 0xa8, 0x34, 0x45, 0x26, 0x86, 0x4b, 0x63, 0x70, 0xc6, 0xbc,
-		0x09, 0xd0, 0x40, 0xed, 0x74, 0x26, 0xa5, 0x08, 0x99, 0x77, 0x4b, 0x3c, 0x4f, 0xc4, 0xdc, 0x00,
-		0x4d, 0x7e, 0x4b, 0x7a, 0xb9, 0xe9, 0x21, 0xf1, 0x06, 0x50, 0x43, 0x63, 0x73, 0x7f, 0x5e, 0x3f,
-		0x6a, 0x34, 0x20, 0x33, 0x50, 0xd4, 0x61, 0xfa, 0x79, 0xf5, 0x26, 0xc8, 0xe2, 0x40, 0xdd, 0x68,
-		0x36, 0xc0, 0xf6, 0xa8, 0x96, 0x61, 0xfc, 0x21, 0x2e, 0x13, 0x20, 0xdf, 0x51, 0x7a, 0x44, 0x5e,
-		0x13, 0xd9, 0x15, 0xa6, 0x5e, 0x3f, 0x81, 0x49, 0x7d, 0xb6, 0x0c, 0x52, 0x77, 0xa0, 0xf6, 0x67,
-		0x5d, 0x87, 0x5a, 0x60, 0x13, 0x10, 0x86, 0x4d, 0x26, 0x6f, 0xc0, 0x36, 0x64, 0xf2, 0xf9, 0x5f,
-		0x30, 0x99, 0x9f, 0x7d, 0x0f, 0xe4, 0x86, 0xe9, 0x10, 0x28, 0xa5, 0xa9, 0xae, 0xc1, 0x2d, 0xb1,
-		0x8e, 0xae, 0x40, 0xc6, 0x9f, 0xc5, 0xf2, 0x0a, 0x68, 0xa2, 0x08, 0xd7, 0x12, 0x68, 0x4d, 0x05,
-		0x09, 0x42, 0xc2, 0x93, 0x14, 0x46, 0xc0, 0xed, 0xc7, 0xc7, 0x50, 0x1b, 0x96, 0x82, 0x8c, 0x89,
-		0x11, 0x7d, 0xcb, 0x41, 0x02, 0xca, 0x99, 0x84, 0x99, 0x71, 0x1a, 0x12, 0x39, 0x05, 0x9a, 0x35,
-		0x35, 0x8e, 0xa7, 0x49, 0x6d, 0x64, 0x40, 0x3c, 0x0a, 0x36, 0x6e, 0xe9, 0x41, 0x8e, 0x5b, 0x12,
-		0x13, 0xad, 0x58, 0x82, 0x41, 0x1a, 0x81, 0xc8, 0x00, 0xbe, 0xad, 0x03, 0x4c, 0xab, 0x5e, 0x07,
-		0x4b, 0xab, 0xbe, 0x26, 0x3b, 0xe8, 0x1a, 0x05, 0x37, 0xaa, 0xf7, 0xdd, 0xc8, 0x95, 0x56, 0xb9,
-		0x20, 0x3e, 0x13, 0x20, 0x27, 0x0a, 0x37, 0xb1, 0xc8, 0xd7, 0xf5, 0xd3, 0xee, 0x00, 0x70, 0xc3,
-		0x0d, 0xa0, 0x25, 0x5b, 0x1e, 0xd4, 0x9e, 0xa6, 0x9e, 0x67, 0x7a, 0x51, 0x0c, 0x52, 0xd6, 0x66,
-		0x6c, 0x46, 0xa6, 0x6c, 0xd9, 0x68, 0x03, 0xe8, 0xee, 0xf9, 0x6c, 0xc6, 0x0c, 0x09, 0xbf, 0x2b,
-		0x22, 0x25, 0x55, 0x1f, 0xa0, 0xbf, 0xa9, 0x8e, 0x7c, 0xdd, 0xf7, 0x18, 0xe4, 0xc4, 0xfd, 0xd6,
-		0x29, 0x9b, 0xc2, 0x74, 0x88, 0xc2, 0x06, 0xd8, 0xcb, 0x9e, 0x24, 0x09, 0x67, 0xe2, 0xc3, 0xac,
-		0x74, 0xba, 0x6a, 0x1e, 0x00, 0xf5, 0xcf, 0x4f, 0x63, 0x98, 0xd9, 0x98, 0x26, 0x86, 0x6a, 0x00,
-		0x18, 0xa5, 0xb4, 0xde, 0x3c, 0xde, 0x07, 0x99, 0xe8, 0x26, 0x22, 0x2a, 0x39, 0x81, 0x4d, 0xf8,
-		0x06, 0x18, 0x86, 0x8d, 0xc4, 0x35, 0x55, 0x21, 0xe3, 0x30, 0xe3, 0xb5, 0xa1, 0xbe, 0x86, 0x9a,
-		0x17, 0xb6, 0xce, 0x09, 0x1b, 0x35, 0x40, 0xd6, 0xc5, 0x10, 0x45, 0x60, 0x92, 0x31, 0x29, 0x09,
-		0xf8, 0xf2, 0x1f, 0xb0, 0x17, 0x17, 0xc9, 0x50, 0x4f, 0xc0, 0x42, 0x14, 0x62, 0xa2, 0xa9, 0xe0,
-		0x20, 0x2b, 0xaf, 0x67, 0xc7, 0xc7, 0x47, 0x0d, 0xb8, 0x44, 0x61, 0xdc, 0x83, 0xa9, 0x28, 0x15,
-		0xf1, 0x99, 0x18, 0xb6, 0x3b, 0x30, 0x6f, 0x45, 0x1e, 0xe4, 0xc9, 0xc2, 0xe5, 0xa0, 0x8d, 0x84,
-		0x4f, 0x41, 0xa6, 0x01, 0xcf, 0x9b, 0x8d, 0xe6, 0x57, 0x62, 0xe8, 0x25, 0xa5, 0x12, 0x66, 0xe0,
-		0x6b, 0x49, 0x02, 0x0d, 0x12, 0x82, 0x19, 0xa2, 0x06, 0x40, 0x63, 0x7a, 0x30, 0x19, 0x70, 0x16,
-		0xfe, 0x0e, 0x50, 0x72, 0x9f, 0x4d, 0x46, 0x29, 0xd0, 0xa6, 0x7b, 0x90, 0xa9, 0xac, 0x01, 0x73,
-		0x90, 0x7b, 0x5c, 0xc1, 0x64, 0x61, 0xb2, 0x51, 0x59, 0x56, 0x68, 0x84, 0x1f, 0x9f, 0xde, 0x61,
-		0x39, 0x8b, 0x2d, 0xe3, 0x3d, 0x71, 0x0e, 0xea, 0x07, 0xc3, 0x40, 0x15, 0xad, 0xcd, 0x78, 0xff,
-		0x99, 0x50, 0x19, 0x2a, 0xed, 0xf2, 0x04, 0x58, 0x30, 0xba, 0x9e, 0xdc, 0x4a, 0xa7, 0x37, 0xe1,
-		0x5e, 0xc9, 0xe7, 0x48, 0x89, 0x80, 0xb9, 0x7d, 0xa4, 0x02, 0xdf, 0x67, 0x2e, 0xbf, 0xbf, 0xaf,
-		0x9b, 0x4e, 0x0b, 0x04, 0x0f, 0x18, 0x67, 0x53, 0x52, 0x24, 0x88, 0x55, 0x02, 0x91, 0x10, 0x41,
-		0x40, 0x9d, 0x16, 0x83, 0x4d, 0xfb, 0xf6, 0x42, 0x80, 0xfd, 0xfd, 0xe7, 0x31, 0x33, 0xd2, 0xe5,
-		0xd7, 0x4f, 0x7a, 0x82, 0x7e, 0x9b, 0x38, 0x7d, 0x92, 0x8a, 0x50, 0x8c, 0xbc, 0xff, 0xeb, 0x4f,
-		0x15, 0xf3, 0x67, 0x4e, 0x8b, 0xf2, 0xfc, 0x56, 0x52, 0x55, 0x38, 0xb1, 0xb9, 0x04, 0x60, 0xaf,
-		0x48, 0x6d, 0x44, 0x09, 0xb6, 0xa1, 0xd9, 0x68, 0x8e, 0x94, 0xb8, 0xb9, 0x75, 0x7a, 0x12, 0x42,
-		0x9b, 0x11, 0x71, 0xda, 0x36, 0x9b, 0xa4, 0xb2, 0xf9, 0xf7, 0x42, 0xfc, 0x1d, 0x25, 0x80, 0xa9,
-		0x85, 0xba, 0xc2, 0x95, 0xc0, 0x2e, 0x1f, 0xd6, 0x9a, 0xc4, 0x34, 0xa2, 0xa9, 0xd3, 0xd6, 0xd9,
-		0x10, 0xef, 0x72, 0x22, 0xc6, 0x8b, 0x1f, 0x4e, 0xcf, 0x43, 0x1f, 0x8e, 0xd9, 0x6c, 0xc0, 0xb8,
-		0xd3, 0xb3, 0x88, 0x88, 0x37, 0xa6, 0xde, 0x9f, 0xdc, 0x13, 0xdc, 0x28, 0x11, 0x86, 0xd4, 0xef,
-		0x9f, 0xba, 0x3c, 0x1f, 0x45, 0x67, 0x71, 0x48, 0x54, 0x31, 0x02, 0xba, 0x12, 0x98, 0x0c, 0x26,
-		0xa9, 0x0a, 0x09, 0x77, 0x7a, 0x12, 0x44, 0x79, 0x9c, 0x9a, 0x51, 0x21, 0x82, 0xb2, 0x12, 0x38,
-		0xd7, 0xd3, 0xa8, 0x79, 0x58, 0x93, 0x44, 0x75, 0xe6, 0xc4, 0x69, 0x2c, 0xa5, 0x7d, 0x8f, 0x81,
-		0xf0, 0xae, 0x13, 0xaa, 0x2d, 0xa7, 0x41, 0xf9, 0xaa, 0xc4, 0xa9, 0x68, 0xcb, 0x87, 0xf2, 0xcc,
-		0xa4, 0x59, 0xac, 0x46, 0xb9, 0x04, 0x76, 0x90, 0x89, 0x9b, 0x22, 0xf7, 0x90, 0xe5, 0x00, 0xb7,
-		0x49, 0xed, 0xdc, 0xe9, 0x5f, 0x4e, 0x1b, 0x0d, 0x13, 0x7d, 0x2b, 0x4c, 0x0a, 0x5f, 0x02, 0x1c,
-		0x52, 0xac, 0x41, 0xd4, 0xfb, 0x4f, 0xe0, 0xa6, 0xd1, 0x8a, 0x42, 0xa7, 0xc3, 0x80, 0x1e, 0x75,
-		0x1a, 0x77, 0x48, 0xc1, 0x0f, 0xdc, 0x06, 0xb1, 0x2b, 0xd2, 0xcf, 0x90, 0x5c, 0x3b, 0x2d, 0xc9,
-		0x81, 0x57, 0xdf, 0x3f, 0x6a, 0xba, 0x1d, 0xbd, 0xa9, 0xd5, 0x8e, 0x1c, 0x37, 0x0a, 0x05, 0xd9,
-		0x33, 0xde, 0x7f, 0x0e, 0x0f, 0xcb, 0x04, 0xbe, 0x2a, 0x11, 0x3b, 0xad, 0x5d, 0x95, 0x56, 0x4e,
-		0x87, 0xa1, 0x36, 0x9c, 0xbd, 0xc5, 0xbb, 0x54, 0x96, 0x40, 0xd3, 0x6a, 0xb7, 0x0f, 0xd3, 0x5c,
-		0x6b, 0xe6, 0xf6, 0xd5, 0x75, 0x24, 0x85, 0x66, 0x86, 0xba, 0x1e, 0x0e, 0x0c, 0xd8, 0x54, 0xd1,
-		0x8e, 0xfb, 0xd7, 0x5e, 0x91, 0x0c, 0xb5, 0xdb, 0xef, 0x4f, 0x67, 0x16, 0xd8, 0x64, 0x4b, 0x12,
-		0xb4, 0x39, 0xfd, 0xda, 0x3d, 0x1f, 0x3b, 0x8d, 0xc5, 0x95, 0x90, 0x85, 0xb9, 0x01, 0x4b, 0xb2,
-		0x19, 0xbd, 0x13, 0xa7, 0xb7, 0x62, 0x76, 0x7c, 0x7c, 0x54, 0x77, 0x7d, 0x02, 0x4e, 0x7b, 0x13,
-		0x2b, 0xca, 0xc5, 0xf3, 0x22, 0x9c, 0xc2, 0x25, 0x90, 0x05, 0xc7, 0xe3, 0x33, 0xbe, 0xae, 0x39,
-		0x8d, 0x58, 0x57, 0x9d, 0x94, 0x0e, 0xbe, 0x0f, 0xdb, 0x67, 0x00, 0xa4, 0x61, 0x20, 0x66, 0x0b,
-		0xef, 0xce, 0x75, 0xfc, 0xb7, 0x64, 0xf9, 0x2d, 0xde, 0x6b, 0xa7, 0x0c, 0x26, 0x9b, 0x45, 0x44,
-		0xdd, 0x16, 0xeb, 0x52, 0x59, 0x82, 0x4b, 0x3b, 0x76, 0x33, 0x11, 0xa7, 0x22, 0x56, 0xee, 0x27,
-		0x19, 0x74, 0x80, 0xa4, 0x18, 0xcc, 0x99, 0x94, 0xcc, 0x02, 0xd7, 0x77, 0x59, 0xf2, 0x89, 0x0e,
-		0xf6, 0xdd, 0x76, 0x59, 0x37, 0x54, 0x45, 0x63, 0xaa, 0xae, 0x98, 0xdb, 0x3b, 0x92, 0xb4, 0x74,
-		0x73, 0x3c, 0x52, 0xdb, 0xbd, 0x71, 0x3a, 0x96, 0x73, 0x73, 0x74, 0x30, 0x20, 0xd2, 0xed, 0xe8,
-		0x81, 0x76, 0x1c, 0xdc, 0x2e, 0xfc, 0xed, 0x93, 0xef, 0x6d, 0xd9, 0x18, 0x4a, 0xc7, 0xd1, 0xd4,
-		0xc4, 0x8f, 0xdc, 0x0e, 0x2c, 0xfb, 0x5c, 0x3b, 0xae, 0x8f, 0x56, 0xd0, 0x1c, 0x82, 0xb7, 0xd4,
-		0xda, 0x77, 0x1a, 0x38, 0x39, 0x9e, 0x3e, 0xbe, 0x74, 0x8e, 0x6c, 0xf4, 0x78, 0x2c, 0x4b, 0x5c,
-		0xf6, 0xaf, 0x73, 0x10, 0x09, 0x81, 0x7d, 0xe9, 0x76, 0xac, 0xdf, 0x69, 0xa9, 0x90, 0x3e, 0x4f,
-		0xd2, 0xce, 0x06, 0x42, 0x48, 0xa7, 0xa1, 0xeb, 0x54, 0x83, 0xd9, 0x87, 0xba, 0xdb, 0x98, 0x23,
-		0xe1, 0x55, 0x6f, 0x84, 0x8e, 0x17, 0x1b, 0x79, 0x4c, 0xba, 0x1e, 0x0e, 0x5c, 0x66, 0xf0, 0xbb,
-		0xee, 0x49, 0x8c, 0x04, 0xe3, 0x66, 0x22, 0x92, 0x1f, 0x63, 0xaa, 0x98, 0xe3, 0x58, 0x50, 0xfb,
-		0xdc, 0xf5, 0x8b, 0x17, 0xa7, 0xed, 0x44, 0x48, 0xa4, 0xd3, 0x39, 0xfb, 0x33, 0xd5, 0xac, 0x35,
-		0x2f, 0xba, 0x4e, 0xd7, 0xc7, 0x7b, 0x4c, 0x7b, 0xa2, 0x3f, 0x1e, 0x14, 0x6b, 0x50, 0x51, 0x86,
-		0x5b, 0x3c, 0x08, 0xa6, 0xba, 0xd5, 0xb9, 0x38, 0x1d, 0xf5, 0xb9, 0xdb, 0x89, 0x2a, 0xcd, 0x43,
-		0xa7, 0xd3, 0x31, 0xa5, 0xdb, 0x09, 0x8c, 0xc1, 0xb7, 0x80, 0xb9, 0xce, 0xe9, 0xa4, 0x99, 0x74,
-		0xfe, 0x5e, 0xcb, 0xf1, 0xf8, 0x4c, 0x5b, 0xb3, 0xf3, 0xd8, 0x69, 0x3d, 0xf4, 0x4f, 0xe3, 0xb8,
-		0xe9, 0xb4, 0x4d, 0x88, 0x84, 0x47, 0xbe, 0x51, 0xa5, 0x99, 0xe0, 0x75, 0xe7, 0x59, 0x84, 0x5c,
-		0x77, 0x7d, 0x86, 0x71, 0x68, 0x98, 0x0c, 0xe9, 0x8d, 0x70, 0xdb, 0x3c, 0x38, 0x7e, 0x9f, 0x95,
-		0x9c, 0x25, 0x6b, 0xed, 0xfe, 0x4a, 0x20, 0xe3, 0xb7, 0x8a, 0x01, 0x90, 0x8e, 0xae, 0xf0, 0xf4,
-		0xba, 0x97, 0x0c, 0x8c, 0xb0, 0xbe, 0x2f, 0x3c, 0x7d, 0x1e, 0xf8, 0x11, 0x8c, 0xd9, 0x30, 0x6e,
-		0xa8, 0x0a, 0x29, 0xb9, 0xa2, 0x8e, 0x17, 0x10, 0x1e, 0x1d, 0x1e, 0x3a, 0x5e, 0x5d, 0xee, 0x7e,
-		0x69, 0xcb, 0x94, 0x19, 0xa7, 0x6f, 0xb2, 0xe3, 0xd0, 0x28, 0xe2, 0xb4, 0x11, 0x8c, 0x7c, 0xed,
-		0x78, 0x6e, 0xd0, 0xdc, 0xf5, 0xd8, 0xa5, 0x30, 0xfc, 0xdc, 0x8f, 0x21, 0x58, 0x38, 0x02, 0x21,
-		0x49, 0x99, 0x3b, 0x1e, 0x32, 0xb8, 0x2b, 0xa2, 0xed, 0x44, 0x06, 0x08, 0x84, 0x8a, 0x02, 0x00,
-		0xb4, 0x6e, 0xab, 0xee, 0xfe, 0x7d, 0xd9, 0xf6, 0x7d, 0x45, 0xb5, 0xd3, 0x86, 0xcf, 0x38, 0x9e,
-		0x36, 0x24, 0x95, 0x30, 0x54, 0xf0, 0xa3, 0x9a, 0xeb, 0x1c, 0x49, 0x1b, 0x86, 0x21, 0xa7, 0x6f,
-		0x8e, 0xa6, 0xe1, 0x37, 0xea, 0x19, 0x32, 0x36, 0xc4, 0xe9, 0x79, 0x5c, 0x45, 0xd7, 0x44, 0xd1,
-		0x55, 0x51, 0xea, 0x19, 0xf3, 0x1c, 0xaf, 0xa1, 0xfa, 0x2a, 0x05, 0x1f, 0xcd, 0x6f, 0x35, 0xf3,
-		0x48, 0xf8, 0x27, 0x67, 0x10, 0x92, 0x35, 0x1b, 0x00, 0xb6, 0xc4, 0xf1, 0x22, 0x4f, 0x2d, 0x02,
-		0xb3, 0x10, 0x93, 0x81, 0x10, 0x72, 0xea, 0x38, 0xdf, 0x2c, 0x0f, 0x88, 0xdb, 0xfe, 0xab, 0x76,
-		0x9a, 0x2f, 0x26, 0x64, 0x9c, 0xce, 0x5c, 0x27, 0xed, 0x31, 0x54, 0x45, 0x8e, 0x33, 0x91, 0x87,
-		0x4d, 0x26, 0x5d, 0xcf, 0xfa, 0xd8, 0x24, 0x2e, 0x9f, 0x5a, 0x68, 0xb3, 0x5f, 0x16, 0x5a, 0x89,
-		0xe1, 0xe9, 0xd7, 0xae, 0xd3, 0x16, 0xcf, 0x33, 0x34, 0x64, 0x7a, 0x48, 0x0d, 0x19, 0x9c, 0x9f,
-		0x8f, 0x9c, 0x46, 0x20, 0xae, 0xcb, 0x47, 0xb3, 0x05, 0xa3, 0x22, 0x75, 0x38, 0xea, 0xbb, 0x4d,
-		0xa6, 0x4e, 0xbc, 0x79, 0x77, 0x3c, 0x70, 0x9a, 0xa1, 0xb8, 0xee, 0x78, 0xf9, 0x9a, 0xd3, 0xa9,
-		0xa6, 0x81, 0xd3, 0x70, 0x49, 0xca, 0x65, 0x02, 0x45, 0xc8, 0xf8, 0xa5, 0xfb, 0x7d, 0x1f, 0x93,
-		0xbc, 0xdf, 0xbe, 0xdb, 0x79, 0xbf, 0xda, 0xf1, 0x58, 0x60, 0x92, 0x08, 0x32, 0x22, 0x66, 0xee,
-		0x38, 0x35, 0xf4, 0x3c, 0xe6, 0xc6, 0x79, 0x0a, 0xd3, 0x30, 0x92, 0x8e, 0xd7, 0x21, 0x04, 0x6e,
-		0xd3, 0x43, 0xbb, 0x7d, 0x93, 0xea, 0x78, 0x20, 0x9c, 0x90, 0xb0, 0xe5, 0x7e, 0xb6, 0x6f, 0x9f,
-		0x43, 0xa8, 0x9f, 0x98, 0x88, 0x4b, 0xca, 0x2f, 0x18, 0x9f, 0x39, 0x9d, 0xb9, 0xec, 0x78, 0xc1,
-		0x26, 0x0b, 0xaa, 0x33, 0xcd, 0x61, 0x38, 0x9e, 0x09, 0x3d, 0x95, 0x27, 0x38, 0xa7, 0x9e, 0xd3,
-		0x57, 0x8e, 0xd7, 0xd7, 0x84, 0x8f, 0x46, 0x0d, 0x10, 0x39, 0x12, 0xeb, 0x84, 0x95, 0x8b, 0x60,
-		0xe4, 0x38, 0xa9, 0xef, 0xcc, 0xf5, 0x46, 0x8a, 0xa4, 0xde, 0x18, 0x4a, 0x39, 0xbe, 0x66, 0xc6,
-		0x9b, 0xbb, 0x0d, 0xa1, 0x4e, 0x85, 0xba, 0x26, 0xca, 0x07, 0x60, 0x04, 0xf7, 0x13, 0x23, 0x78,
-		0xe2, 0x76, 0xb3, 0x38, 0x49, 0xdc, 0xce, 0xd1, 0x5c, 0xd3, 0x75, 0xf7, 0xda, 0x67, 0x6d, 0xa7,
-		0xf7, 0xc1, 0x6d, 0xe7, 0xc2, 0x79, 0xfe, 0x36, 0xb7, 0x91, 0xe0, 0xea, 0x42, 0x0b, 0x40, 0x12,
-		0x4a, 0x93, 0xf8, 0x03, 0x32, 0x73, 0xbc, 0xa8, 0x76, 0xe2, 0xf4, 0x04, 0xa8, 0xf6, 0xdc, 0xee,
-		0xc1, 0xfe, 0x04, 0xc0, 0x3a, 0x7d, 0x9c, 0xe6, 0x8e, 0xab, 0xd6, 0x7f, 0x1c, 0x2f, 0xe9, 0x5a,
-		0x82, 0xbd, 0x83, 0xa1, 0xdb, 0x37, 0xd4, 0x52, 0x09, 0xd9, 0xe1, 0x6e, 0xf7, 0x98, 0x79, 0x5c,
-		0x01, 0x09, 0xa1, 0x11, 0x96, 0xeb, 0x5d, 0x37, 0x89, 0x89, 0xfa, 0x11, 0x71, 0x3c, 0xac, 0x0c,
-		0xa0, 0x69, 0x65, 0xdb, 0xed, 0x7b, 0xf7, 0x88, 0xfa, 0x8c, 0x0c, 0x09, 0x0b, 0xdd, 0x67, 0xc7,
-		0x5c, 0x19, 0x8c, 0xa6, 0xfb, 0xbd, 0xb2, 0xa5, 0xe0, 0xf5, 0x96, 0xd3, 0x57, 0x2e, 0xe4, 0x8a,
-		0x79, 0xec, 0x7c, 0xd9, 0xc7, 0xd2, 0xf9, 0x42, 0x90, 0x9b, 0x46, 0x4b, 0xba, 0x9d, 0xd0, 0x11,
-		0x0a, 0x8f, 0x84, 0x13, 0x12, 0x5e, 0x82, 0xf2, 0x2e, 0x00, 0xd0, 0x63, 0x24, 0xf1, 0x83, 0x6e,
-		0xbf, 0xeb, 0x7a, 0x5f, 0xa3, 0xc1, 0xc8, 0xed, 0xdb, 0xb0, 0x6e, 0x08, 0xa0, 0x93, 0xbc, 0x66,
-		0x72, 0xcc, 0x9c, 0x8e, 0x80, 0x4c, 0x89, 0x66, 0x9e, 0xeb, 0x8d, 0x98, 0x56, 0x7d, 0xbe, 0x80,
-		0xd4, 0xa8, 0x49, 0x29, 0x5d, 0xa7, 0x31, 0x30, 0xb1, 0xfb, 0xe5, 0x9b, 0x67, 0xcc, 0x9b, 0x38,
-		0x1e, 0x50, 0x9b, 0xce, 0xa4, 0x14, 0x21, 0xf3, 0x6e, 0x89, 0xe7, 0x89, 0x98, 0x1b, 0xc7, 0x93,
-		0x58, 0x92, 0x1e, 0x0f, 0x7a, 0x48, 0xbc, 0x81, 0xeb, 0xa1, 0x90, 0xb9, 0x3f, 0xaf, 0x1f, 0x35,
-		0x1a, 0x10, 0x2a, 0x69, 0xeb, 0x6e, 0xfb, 0x19, 0xf5, 0xa6, 0xdb, 0x8d, 0xfe, 0x1b, 0xcd, 0x86,
-		0xf3, 0x3d, 0xd5, 0x64, 0x18, 0x83, 0x0a, 0xd6, 0x42, 0xb8, 0x8b, 0xf1, 0x88, 0xbc, 0x26, 0xb2,
-		0x2b, 0x4c, 0xbd, 0x7e, 0xe2, 0x36, 0x45, 0xc9, 0x32, 0x68, 0xd8, 0x71, 0xbd, 0xbf, 0xd1, 0xda,
-		0x15, 0x87, 0x41, 0xe4, 0x13, 0x36, 0x99, 0xbc, 0x71, 0x9e, 0x20, 0xde, 0xe7, 0x7f, 0xb9, 0xcd,
-		0x1c, 0xe8, 0x7b, 0x4e, 0x6f, 0x80, 0x0e, 0x1d, 0xa7, 0xe8, 0xd2, 0x35, 0xf7, 0x4b, 0xd7, 0xa2,
-		0x2b, 0xa7, 0xe3, 0x81, 0x62, 0x19, 0x32, 0x9f, 0x28, 0xc2, 0xb5, 0x74, 0x3c, 0xf7, 0x97, 0x04,
-		0x21, 0xe1, 0x49, 0x6a, 0x11, 0x80, 0xf6, 0x77, 0x63, 0xd7, 0x1b, 0x01, 0x39, 0x1d, 0x03, 0x21,
-		0xfa, 0x96, 0x3b, 0x0d, 0x98, 0x66, 0xd2, 0xed, 0xcc, 0xae, 0x90, 0xc8, 0xa9, 0xe3, 0xd9, 0x12,
-		0xe3, 0x78, 0x0a, 0xa2, 0x15, 0xfb, 0xb2, 0xb7, 0x28, 0x84, 0xb8, 0x13, 0x31, 0xd1, 0x8a, 0x95,
-		0xce, 0x69, 0xe5, 0x1a, 0x19, 0x00, 0xb7, 0x15, 0x00, 0x68, 0x33, 0xeb, 0xce, 0xd3, 0x66, 0xae,
-		0x8b, 0x3c, 0xbb, 0x46, 0xb9, 0x1f, 0xad, 0xf9, 0x6e, 0xe4, 0x4a, 0xba, 0x2f, 0x88, 0xcf, 0x84,
-		0xd3, 0x13, 0x72, 0x3f, 0xd1, 0xc0, 0xd7, 0xf5, 0xd3, 0xee, 0x00, 0x00, 0x51, 0xb1, 0xe3, 0x29,
-		0xfe, 0x9e, 0xeb, 0xbd, 0x82, 0x3c, 0xcf, 0xf4, 0xa2, 0xd8, 0x69, 0x59, 0x98, 0xb1, 0x19, 0x99,
-		0xb2, 0x25, 0x41, 0xb1, 0xe3, 0xbb, 0xe1, 0xb3, 0x19, 0x33, 0x24, 0xfc, 0xae, 0x88, 0x94, 0x54,
-		0x01, 0xea, 0x1b, 0xa4, 0x23, 0x5f, 0xf7, 0x3d, 0x06, 0x21, 0x21, 0xb5, 0x75, 0xca, 0xa6, 0x6e,
-		0x03, 0xf5, 0xb0, 0xe1, 0x7c, 0x70, 0x3c, 0xb9, 0xac, 0x9f, 0xf8, 0x6e, 0x67, 0xcc, 0x5f, 0x35,
-		0x0f, 0x1c, 0xf7, 0xf7, 0x4e, 0x63, 0xb7, 0xb3, 0xa1, 0x4c, 0xec, 0xba, 0x62, 0x65, 0x94, 0xd2,
-		0x7a, 0xf3, 0x78, 0xdf, 0xe9, 0x84, 0x15, 0x11, 0x51, 0xc9, 0x09, 0x0c, 0x02, 0x16, 0x00, 0xf0,
-		0x63, 0x24, 0xae, 0xa9, 0x0a, 0x19, 0x77, 0x3b, 0xae, 0x16, 0xea, 0x6b, 0xd7, 0xf3, 0x3e, 0xd6,
-		0x39, 0x1f, 0xa3, 0x86, 0xd3, 0xf9, 0xd8, 0x44, 0x11, 0xb7, 0x49, 0x19, 0x94, 0x04, 0x70, 0x09,
-		0x09, 0xa1, 0xfb, 0xa8, 0x0c, 0xf5, 0xc4, 0x79, 0x93, 0x4d, 0x4c, 0x34, 0x15, 0xdc, 0xe9, 0x8a,
-		0xb6, 0xd9, 0xf1, 0xf1, 0x51, 0xc3, 0x7d, 0xa2, 0x0f, 0xee, 0xb9, 0xad, 0x98, 0x14, 0xf1, 0x99,
-		0x18, 0xb6, 0x3b, 0x6e, 0x47, 0x97, 0x1f, 0xe4, 0x9d, 0xb9, 0xcf, 0x91, 0x16, 0x09, 0x9f, 0x3a,
-		0x9d, 0x3e, 0x37, 0x6f, 0x36, 0x9a, 0x5f, 0x89, 0xa1, 0x97, 0x94, 0x4a, 0xb7, 0x03, 0x1d, 0x4b,
-		0x12, 0x41, 0xa7, 0xa1, 0x87, 0x21, 0x6a, 0xe0, 0x78, 0xac, 0xc6, 0xed, 0x8a, 0xfa, 0x05, 0x0e,
-		0x77, 0x9c, 0x14, 0x60, 0x93, 0xb9, 0xe5, 0x78, 0x73, 0x0d, 0x08, 0x94, 0x87, 0x00, 0xb8, 0x27,
-		0x3d, 0xae, 0xdc, 0x66, 0x63, 0x28, 0x52, 0x71, 0x90, 0xeb, 0xc9, 0x1f, 0x9f, 0x76, 0xfb, 0x3d,
-		0xe9, 0x3f, 0x9d, 0xee, 0x93, 0x29, 0x37, 0xa9, 0xd2, 0xe6, 0x5c, 0x18, 0x62, 0x98, 0xe0, 0x95,
-		0x2f, 0x19, 0xb6, 0xa7, 0xa2, 0xbd, 0x39, 0x8d, 0x88, 0x24, 0x66, 0xbe, 0xd8, 0x90, 0xcf, 0x92,
-		0x78, 0x97, 0xd4, 0x54, 0xcd, 0x3a, 0x4b, 0xf6, 0xd1, 0x2f, 0xb8, 0x4f, 0xd5, 0x67, 0xb6, 0xce,
-		0xf5, 0xd2, 0x77, 0xff, 0xfb, 0x59, 0x1b, 0x62, 0x52, 0x06, 0xa9, 0xb6, 0x4f, 0xfc, 0xf5, 0x4f,
-		0x6c, 0x59, 0x92, 0xca, 0x1f, 0xf4, 0x36, 0xe9, 0xd5, 0xb7, 0x38, 0x64, 0x5b, 0x3e, 0x3a, 0x60,
-		0xda, 0xb4, 0x8d, 0x51, 0xa9, 0xd6, 0xac, 0x32, 0x64, 0xbc, 0x17, 0xd2, 0x88, 0x72, 0xa3, 0x2b,
-		0x5f, 0xf6, 0x78, 0x1c, 0x86, 0xbf, 0xa5, 0x78, 0x88, 0xdc, 0x64, 0x7f, 0xe8, 0x5c, 0xf9, 0x54,
-		0x51, 0xff, 0xe4, 0x76, 0xf5, 0x48, 0xa1, 0xf5, 0xc8, 0x78, 0x34, 0x6c, 0x1d, 0x89, 0x4a, 0x8a,
-		0x79, 0x6a, 0xa3, 0x62, 0xcf, 0xf0, 0x95, 0x3a, 0x18, 0x25, 0x03, 0x4f, 0xee, 0xc6, 0xfd, 0x99,
-		0x32, 0xad, 0xf0, 0xe5, 0xf3, 0xf2, 0xfc, 0xbf, 0xbc, 0xb0, 0x62, 0x69, 0x57, 0xaa, 0xd8, 0x0a,
-		0x3d, 0x3f, 0x95, 0xa7, 0x2f, 0xfa, 0xcc, 0x4b, 0xae, 0xe3, 0x47, 0xd5, 0x48, 0xf8, 0x71, 0x48,
-		0xf5, 0x8b, 0x6f, 0xf9, 0x38, 0xe0, 0xb4, 0x79, 0xe0, 0x85, 0x89, 0xff, 0xc1, 0xb8, 0x5f, 0xf9,
-		0xb2, 0x57, 0x7f, 0xe1, 0x9f, 0x3b, 0x82, 0x07, 0x6c, 0x56, 0xf9, 0xb2, 0x57, 0x7b, 0xe1, 0x03,
-		0x23, 0x45, 0x03, 0x76, 0xf3, 0xfa, 0xa2, 0x6d, 0x94, 0xfe, 0xe5, 0xbd, 0xa5, 0x7a, 0xe5, 0x90,
-		0x54, 0xc6, 0x22, 0x56, 0x1e, 0xdd, 0x7a, 0x66, 0x17, 0xf2, 0x7e, 0x2d, 0x94, 0xbf, 0x44, 0x88,
-		0xc9, 0x7b, 0x6c, 0x11, 0x88, 0xdf, 0x89, 0x6e, 0xab, 0x59, 0xbc, 0x90, 0xc9, 0xca, 0x97, 0x3d,
-		0xa3, 0x62, 0xba, 0x4d, 0x82, 0xee, 0x3e, 0xfd, 0xe8, 0xf5, 0x2d, 0x1d, 0xbc, 0x2e, 0x7b, 0x5d,
-		0x05, 0x3d, 0xda, 0xc7, 0xed, 0x6b, 0xf2, 0xfc, 0xfe, 0x6f, 0x5b, 0x98, 0xd7, 0x8f, 0x41, 0xea,
-		0xe3, 0x90, 0xe5, 0x58, 0xe4, 0x3e, 0x1e, 0x59, 0x8f, 0x49, 0xee, 0xe3, 0x92, 0xfb, 0xd8, 0x14,
-		0x39, 0x3e, 0x76, 0x2c, 0xe1, 0xb6, 0x63, 0xb5, 0xf9, 0xa0, 0xb7, 0xde, 0xd1, 0x94, 0x6b, 0xb8,
-		0xc1, 0x9f, 0xcb, 0xe7, 0x52, 0xae, 0x43, 0xba, 0xe3, 0x95, 0xf9, 0x98, 0xe5, 0x39, 0x6e, 0x85,
-		0x8f, 0x5d, 0xde, 0xe3, 0x57, 0xf8, 0x18, 0x16, 0x3e, 0x8e, 0x36, 0x8e, 0xe5, 0x6e, 0x30, 0x6c,
-		0xda, 0xe3, 0xba, 0x79, 0x80, 0x84, 0xa1, 0xb8, 0xae, 0x8a, 0x2b, 0xaa, 0x74, 0x3c, 0xd5, 0x9e,
-		0x62, 0x32, 0x33, 0x00, 0x7e, 0xe8, 0x9d, 0x3f, 0x3f, 0x5e, 0xc6, 0x75, 0x5d, 0x1d, 0xf3, 0x5a,
-		0xc6, 0xc7, 0xb2, 0x1e, 0xf7, 0x22, 0xc7, 0xde, 0xda, 0xf1, 0x2f, 0x2a, 0x06, 0xd6, 0xc4, 0xc1,
-		0x9a, 0x58, 0xd8, 0x14, 0x8f, 0x9c, 0x2e, 0x64, 0xc6, 0xfd, 0x9f, 0xdc, 0x4a, 0x5a, 0x6c, 0xf7,
-		0xa7, 0x42, 0x84, 0x94, 0xf0, 0x3c, 0xfb, 0xbe, 0xd6, 0xe9, 0xf5, 0x5d, 0x79, 0xc9, 0x19, 0x54,
-		0xf1, 0x94, 0xaa, 0x6a, 0x82, 0xb3, 0xaf, 0x48, 0x98, 0x5f, 0x09, 0x3c, 0x18, 0x05, 0x45, 0x1f,
-		0x45, 0x1f, 0xb4, 0xe8, 0xc7, 0x8c, 0x9b, 0x66, 0xa3, 0x80, 0xe4, 0x1f, 0xe6, 0x78, 0xf4, 0x82,
-		0xf0, 0xd9, 0xe2, 0xdb, 0xff, 0xfd, 0xe9, 0x6d, 0x83, 0x8f, 0x95, 0x21, 0xe3, 0xb9, 0xcf, 0x69,
-		0x41, 0x21, 0x7f, 0x32, 0xcc, 0x37, 0x12, 0xc6, 0xd4, 0xc2, 0x38, 0xa7, 0x8a, 0x78, 0x0b, 0x98,
-		0xd2, 0x65, 0x33, 0x96, 0xc4, 0x98, 0x6a, 0xef, 0x11, 0x11, 0xae, 0x0c, 0xc9, 0x4d, 0xe9, 0x96,
-		0x76, 0xbf, 0x71, 0xbc, 0x7f, 0x7c, 0x70, 0xd8, 0x38, 0x6e, 0x95, 0x68, 0x8d, 0x3f, 0xbd, 0xcd,
-		0x53, 0x3f, 0x4a, 0x60, 0x90, 0x7d, 0x6a, 0x01, 0x94, 0xdf, 0x1f, 0x04, 0xcd, 0x31, 0x9a, 0x63,
-		0xd0, 0xe6, 0x58, 0x1b, 0xc5, 0xf8, 0xac, 0x08, 0x10, 0x3f, 0x2a, 0x81, 0xdc, 0x53, 0x4e, 0xa6,
-		0x21, 0xf5, 0xf3, 0xcb, 0xfc, 0x7a, 0x00, 0x94, 0x77, 0x94, 0x77, 0xf4, 0xbc, 0xcb, 0xef, 0x79,
-		0x87, 0x42, 0xb3, 0xfc, 0xd2, 0x9e, 0x3c, 0x8d, 0xa2, 0x8e, 0xa2, 0x8e, 0xa2, 0x5e, 0x7e, 0x51,
-		0x4f, 0x6e, 0x12, 0x93, 0x3b, 0xf2, 0xaa, 0xc9, 0xb3, 0x26, 0xf7, 0x93, 0x73, 0x1f, 0x0c, 0x84,
-		0x0a, 0x00, 0x15, 0x00, 0x68, 0x05, 0xf0, 0xe0, 0x2e, 0x3e, 0xf7, 0xc1, 0x7f, 0xa0, 0x10, 0xf6,
-		0x73, 0x3c, 0xdb, 0xe3, 0x71, 0xb4, 0x98, 0xc8, 0xaf, 0x12, 0x28, 0x93, 0x55, 0xe6, 0x4f, 0x4e,
-		0x0d, 0x92, 0x22, 0xc3, 0x0b, 0xd5, 0x06, 0xaa, 0x0d, 0x0c, 0x09, 0x94, 0x40, 0xd2, 0xa5, 0x9a,
-		0xea, 0xfc, 0x92, 0x9e, 0x3c, 0x8d, 0x92, 0x8e, 0x92, 0x8e, 0x1e, 0xc2, 0x3b, 0x79, 0x08, 0xae,
-		0x26, 0xab, 0x3f, 0x4a, 0x80, 0x7d, 0xf4, 0xf7, 0xcf, 0xab, 0x04, 0x36, 0x5b, 0x79, 0xeb, 0x29,
-		0xd2, 0x15, 0x33, 0x41, 0x9e, 0x3c, 0x50, 0x27, 0xa3, 0xe2, 0xc3, 0x34, 0xbb, 0xdd, 0x2a, 0xb2,
-		0xf2, 0xa5, 0xd9, 0x65, 0x56, 0x54, 0x77, 0x81, 0x3a, 0x4a, 0x02, 0x45, 0x83, 0x2c, 0xfb, 0xb6,
-		0x56, 0x4c, 0x19, 0xd2, 0x04, 0x2a, 0xa3, 0x95, 0x80, 0xff, 0xeb, 0x5f, 0x2b, 0xf9, 0xfc, 0x9c,
-		0x1c, 0xff, 0x37, 0x14, 0xd2, 0x87, 0x5a, 0xa2, 0x1a, 0x28, 0xfa, 0x4f, 0x4c, 0xb9, 0x77, 0x9b,
-		0x5d, 0x70, 0x5f, 0x1c, 0x09, 0x73, 0x66, 0x51, 0x98, 0x2d, 0x08, 0x73, 0xe6, 0x9c, 0xd9, 0x8c,
-		0xa9, 0xde, 0x4f, 0x36, 0x3d, 0x53, 0xca, 0x77, 0xce, 0x63, 0x8c, 0x60, 0x1c, 0xc1, 0x78, 0x31,
-		0x30, 0x9e, 0x55, 0x2c, 0xee, 0xc4, 0x63, 0x45, 0xcd, 0x93, 0x7b, 0xd3, 0x1e, 0x33, 0x1b, 0xe7,
-		0x5c, 0xe9, 0x62, 0x99, 0x50, 0xb9, 0x05, 0xc7, 0x86, 0x00, 0x59, 0x17, 0x24, 0x5b, 0x02, 0x65,
-		0x5d, 0xb0, 0xac, 0x0b, 0xd8, 0x2e, 0x04, 0x2d, 0x9f, 0xc0, 0xe5, 0x14, 0xbc, 0xe2, 0xde, 0xf0,
-		0x93, 0xd3, 0x13, 0x33, 0x6e, 0x8e, 0x8a, 0x9c, 0x9a, 0x95, 0x28, 0x15, 0xc8, 0x01, 0x2c, 0x98,
-		0xb0, 0xba, 0xfe, 0x53, 0xec, 0xd4, 0xee, 0xd9, 0x4a, 0x60, 0xb5, 0xa4, 0x63, 0x9e, 0x0c, 0x67,
-		0x29, 0xa1, 0x75, 0x33, 0x9e, 0xc5, 0xa4, 0xcb, 0x82, 0xa7, 0xf9, 0xe1, 0x16, 0x58, 0x48, 0x74,
-		0xdd, 0xf5, 0x16, 0x34, 0x5a, 0xad, 0x12, 0x6f, 0xc2, 0xa7, 0xf7, 0x79, 0xfa, 0xc7, 0x1b, 0x65,
-		0xde, 0xe6, 0x38, 0x64, 0x95, 0x99, 0x62, 0x7e, 0x71, 0xcc, 0x91, 0x8c, 0x82, 0x80, 0x03, 0x01,
-		0x07, 0x02, 0x8e, 0x02, 0xa7, 0x67, 0x13, 0xa3, 0xa9, 0x2e, 0xe4, 0x29, 0xef, 0x1d, 0xfd, 0x13,
-		0xd7, 0x77, 0xbf, 0xc0, 0x18, 0xf9, 0xee, 0xec, 0x0b, 0xa8, 0xb0, 0x9d, 0x3a, 0x67, 0x39, 0xc3,
-		0xff, 0x9b, 0xe7, 0xed, 0x5f, 0x03, 0xbc, 0x14, 0xa5, 0xcb, 0x74, 0x3f, 0x90, 0x7d, 0xf1, 0xb2,
-		0x5c, 0xa0, 0x2e, 0x09, 0x76, 0x72, 0x87, 0x6d, 0x96, 0x8f, 0xbf, 0x71, 0xd4, 0xa6, 0x81, 0x51,
-		0x1b, 0x8c, 0xda, 0x60, 0xd4, 0x06, 0x41, 0x14, 0x82, 0x28, 0x8c, 0xda, 0x60, 0xd4, 0x06, 0xa3,
-		0x36, 0x18, 0xb5, 0xc1, 0xa8, 0x0d, 0x46, 0x6d, 0x30, 0x6a, 0x83, 0x80, 0x03, 0x01, 0x07, 0x46,
-		0x6d, 0x30, 0x6a, 0xb3, 0xa3, 0xa8, 0x4d, 0x06, 0x32, 0xe2, 0x1c, 0x41, 0x1b, 0xa0, 0xa9, 0xb0,
-		0x05, 0x33, 0xd5, 0xf6, 0xd2, 0xf0, 0xf9, 0x9e, 0x2f, 0xbf, 0x63, 0x98, 0x7c, 0xc5, 0xc3, 0xbf,
-		0x9d, 0x6e, 0xbe, 0xef, 0xfd, 0xf2, 0xfc, 0x54, 0xa4, 0x0b, 0x67, 0xf8, 0x2d, 0xc6, 0xc0, 0xdc,
-		0x3e, 0xcc, 0xed, 0xb3, 0xa0, 0x1b, 0x32, 0xe7, 0xf6, 0x61, 0x8c, 0x18, 0x63, 0xc4, 0x18, 0x23,
-		0x7e, 0xe1, 0xc1, 0x1b, 0x56, 0xdc, 0x55, 0xbb, 0x61, 0xe8, 0xa8, 0xa1, 0xa3, 0x86, 0x8e, 0x5a,
-		0xd1, 0xc8, 0x70, 0xfd, 0xc0, 0x82, 0x6f, 0x76, 0x80, 0xa1, 0x61, 0x0c, 0x0d, 0x7f, 0x90, 0xd0,
-		0xf0, 0x41, 0xab, 0xd5, 0xc4, 0xe0, 0xb0, 0x53, 0xc1, 0xe1, 0x9b, 0x7f, 0x2c, 0xe0, 0x8d, 0x7f,
-		0x10, 0x6f, 0x20, 0xde, 0x40, 0xbc, 0x81, 0x78, 0x03, 0xf1, 0x06, 0xe2, 0x0d, 0xc4, 0x1b, 0x88,
-		0x37, 0x5e, 0x5e, 0xe6, 0x5b, 0x0b, 0xf1, 0x8d, 0x5b, 0x8c, 0x6f, 0x20, 0xde, 0x40, 0xbc, 0x81,
-		0x78, 0x03, 0xf1, 0x06, 0xe2, 0x0d, 0xc4, 0x1b, 0x88, 0x37, 0x5e, 0xc3, 0x1b, 0x16, 0xe2, 0x1b,
-		0xb7, 0x18, 0xdf, 0x40, 0xbc, 0x81, 0x78, 0x03, 0xf1, 0x06, 0xe2, 0x0d, 0xc4, 0x1b, 0x88, 0x37,
-		0x3e, 0x0e, 0xde, 0xf8, 0xd8, 0x99, 0xaa, 0x2a, 0xd2, 0x98, 0xa3, 0x6a, 0x67, 0x1d, 0xdf, 0x28,
-		0x3b, 0xf5, 0x22, 0xd2, 0x6f, 0x99, 0x97, 0x9a, 0x2d, 0x91, 0x2f, 0x57, 0x02, 0x5f, 0xee, 0xec,
-		0xd3, 0x06, 0x66, 0x9f, 0xee, 0x00, 0xe1, 0x62, 0x37, 0xf6, 0x27, 0x87, 0x00, 0xbb, 0xb1, 0x63,
-		0x7e, 0xea, 0x2e, 0x1c, 0x3e, 0xa4, 0x81, 0xcf, 0x8f, 0x2b, 0xb0, 0x1b, 0x3b, 0x8a, 0x3e, 0x8a,
-		0xfe, 0xce, 0x44, 0x1f, 0xbb, 0xb1, 0xbf, 0x53, 0x80, 0x00, 0xbb, 0xb1, 0xef, 0x7e, 0x69, 0xb1,
-		0x1b, 0xfb, 0xfb, 0x1a, 0xe4, 0x15, 0xb7, 0x51, 0x75, 0xe1, 0x25, 0x16, 0xe8, 0xc5, 0xf4, 0x70,
-		0x18, 0xe4, 0x81, 0x47, 0x93, 0x0c, 0xb1, 0x5a, 0x6c, 0xee, 0x57, 0x03, 0xea, 0x55, 0xa7, 0x54,
-		0x15, 0xbf, 0xe5, 0xbc, 0x37, 0x16, 0xde, 0x76, 0xe2, 0x6d, 0xe7, 0xbb, 0x8a, 0x5b, 0x41, 0xc3,
-		0xf7, 0xee, 0xb7, 0x9d, 0xb9, 0xbb, 0x21, 0x3e, 0x31, 0x41, 0x47, 0x25, 0xce, 0xad, 0xc8, 0xd5,
-		0x17, 0xf5, 0xc9, 0x5a, 0xe5, 0xe8, 0x8f, 0x8a, 0x1a, 0x07, 0x35, 0x0e, 0x6a, 0x9c, 0x0f, 0xa8,
-		0x71, 0x84, 0xe7, 0xc5, 0x92, 0x64, 0x69, 0x71, 0xf6, 0xe2, 0x82, 0xdd, 0x0d, 0x85, 0xba, 0x07,
-		0x75, 0x0f, 0xea, 0x1e, 0xd4, 0x3d, 0x5b, 0xde, 0x51, 0x0a, 0x6d, 0xec, 0x79, 0x5b, 0x0f, 0x46,
-		0x43, 0x0d, 0x84, 0x1a, 0x08, 0x35, 0x10, 0x6a, 0xa0, 0x2d, 0xef, 0xa8, 0x2d, 0x46, 0x7b, 0x34,
-		0x46, 0x7b, 0x50, 0xfb, 0xa0, 0xf6, 0xf9, 0xa8, 0xda, 0x67, 0xa7, 0xe1, 0xec, 0x3f, 0xe8, 0x6d,
-		0xce, 0xc0, 0x4e, 0x65, 0xc0, 0xb4, 0x69, 0x1b, 0x93, 0x33, 0x1c, 0x3e, 0x64, 0xbc, 0x17, 0xd2,
-		0xc5, 0xa9, 0xd4, 0x95, 0x2f, 0x7b, 0x3c, 0x0e, 0xc3, 0x1c, 0x5a, 0x76, 0x48, 0x6e, 0x8a, 0x0f,
-		0x72, 0xae, 0x7c, 0xaa, 0xa8, 0x7f, 0x72, 0xbb, 0x1a, 0xe2, 0x83, 0x65, 0x12, 0x27, 0x29, 0x9e,
-		0x9f, 0x8b, 0xdc, 0xc1, 0xed, 0x65, 0x4f, 0x85, 0xed, 0x2c, 0xbf, 0x6e, 0x9c, 0x7c, 0x5b, 0x09,
-		0x6e, 0x32, 0x7d, 0x6a, 0x21, 0xbd, 0xf0, 0xfe, 0x20, 0x98, 0x58, 0xb4, 0x73, 0x03, 0x8c, 0xb7,
-		0x98, 0xf9, 0xd5, 0x50, 0xf1, 0xc4, 0xa2, 0xdc, 0x86, 0x34, 0xa7, 0x01, 0xdd, 0x8d, 0xdc, 0x53,
-		0x4e, 0xa6, 0x21, 0xf5, 0xf3, 0xcb, 0xfc, 0x7a, 0x00, 0x94, 0x77, 0x94, 0x77, 0xcc, 0x21, 0x7e,
-		0x5d, 0xe0, 0xcb, 0x90, 0x43, 0x1c, 0x0a, 0xcd, 0xf2, 0x4b, 0x7b, 0xf2, 0x34, 0x8a, 0x3a, 0x8a,
-		0x3a, 0x8a, 0x7a, 0xf9, 0x45, 0x3d, 0xf1, 0x6f, 0x12, 0x57, 0x6b, 0xd9, 0xbb, 0x25, 0xb7, 0xd4,
-		0x3f, 0x1e, 0x08, 0x15, 0x00, 0x2a, 0x00, 0xd0, 0x0a, 0xe0, 0x41, 0x84, 0x20, 0xf7, 0xc1, 0xdf,
-		0x2b, 0xd8, 0xf5, 0x28, 0x67, 0xb7, 0xa3, 0xdd, 0x28, 0x93, 0x5c, 0xf9, 0x53, 0x45, 0xf2, 0xa6,
-		0x50, 0x6d, 0xa0, 0xda, 0xc0, 0x90, 0xc0, 0x3b, 0x48, 0xba, 0x90, 0x54, 0x2d, 0x75, 0x9e, 0x36,
-		0xc4, 0xc4, 0x05, 0xea, 0x1a, 0x9e, 0x8c, 0x84, 0x1a, 0x00, 0x35, 0xc0, 0xc7, 0x01, 0x0e, 0x74,
-		0x75, 0xec, 0x3f, 0x3c, 0x76, 0x90, 0x6a, 0x5a, 0x40, 0x8b, 0x24, 0x4f, 0xa3, 0xe6, 0x40, 0xcd,
-		0x81, 0x31, 0x87, 0xf2, 0xc7, 0x1c, 0xf4, 0x2d, 0xf7, 0xaa, 0x54, 0x29, 0xa1, 0x0a, 0x34, 0xcd,
-		0xbb, 0x1b, 0x03, 0xc5, 0x1e, 0xc5, 0x1e, 0xc5, 0xfe, 0x9d, 0xc4, 0x1e, 0x28, 0xe3, 0x59, 0x06,
-		0x9a, 0xb8, 0x14, 0xd4, 0x63, 0x9f, 0x0a, 0x2c, 0x4e, 0x86, 0xe4, 0xa3, 0x6c, 0xc9, 0x46, 0xb9,
-		0x92, 0x8b, 0x72, 0x25, 0x13, 0x65, 0x4b, 0x1e, 0xda, 0xb6, 0x1e, 0x19, 0x0f, 0x89, 0xfd, 0xc3,
-		0x51, 0x49, 0xc5, 0x24, 0x97, 0x21, 0xd1, 0xe7, 0xf5, 0x73, 0xf6, 0xf2, 0xe9, 0x79, 0xfe, 0x5f,
-		0x5e, 0x58, 0xbf, 0xb4, 0xeb, 0x66, 0x61, 0xbd, 0x9e, 0x9f, 0xcf, 0xd3, 0xb7, 0x7d, 0xe6, 0x4d,
-		0x2b, 0x8b, 0xaf, 0x78, 0xd9, 0x0f, 0xb8, 0x57, 0x6d, 0xa0, 0x5e, 0xcc, 0xc0, 0xda, 0xc2, 0x76,
-		0xb0, 0xd5, 0x92, 0xa7, 0xb1, 0xd8, 0x99, 0x2d, 0x73, 0x5a, 0x0b, 0x9c, 0xd9, 0xd2, 0x66, 0xb6,
-		0xa8, 0x79, 0x2c, 0x67, 0xb6, 0x93, 0xb6, 0xad, 0xfa, 0x3f, 0xd9, 0xbd, 0xed, 0x2b, 0x71, 0x7f,
-		0xaf, 0xb7, 0x2d, 0x42, 0x3a, 0x82, 0x8b, 0xd4, 0x20, 0x2e, 0x0b, 0x68, 0xcb, 0x0d, 0xd2, 0xb2,
-		0x82, 0xb2, 0xdc, 0x20, 0x2c, 0x37, 0xe8, 0x2a, 0x02, 0xb2, 0x8a, 0x19, 0xbd, 0xb4, 0x04, 0x12,
-		0x95, 0xa9, 0xa2, 0xe4, 0x52, 0xc4, 0x66, 0xa1, 0x7b, 0x72, 0x50, 0x77, 0x3e, 0x7c, 0x1c, 0x40,
-		0x03, 0x79, 0xe1, 0x55, 0x3f, 0x26, 0x79, 0xe7, 0x7a, 0xe2, 0xae, 0xd0, 0x76, 0x7a, 0xeb, 0x53,
-		0x91, 0x97, 0x0f, 0x68, 0xf9, 0xfc, 0x07, 0x20, 0x02, 0xca, 0x7e, 0xa4, 0x3f, 0x88, 0xdb, 0x9b,
-		0xf9, 0xc8, 0xbf, 0x8d, 0xc3, 0x9b, 0x9b, 0xfc, 0x67, 0x93, 0x5e, 0x2f, 0x69, 0x8e, 0x6c, 0xd3,
-		0xa7, 0x12, 0xf2, 0x60, 0x38, 0x2c, 0x0a, 0x2b, 0x20, 0x48, 0xb6, 0x04, 0xca, 0xba, 0x60, 0x59,
-		0x17, 0x30, 0xbb, 0x82, 0x96, 0x4f, 0xe0, 0x72, 0x0a, 0x5e, 0xf1, 0x88, 0xd3, 0x93, 0x13, 0xc3,
-		0x7c, 0xca, 0x0d, 0x33, 0xb7, 0x8a, 0x06, 0x36, 0xaa, 0xc1, 0x0a, 0x30, 0x10, 0x56, 0xfa, 0xab,
-		0x57, 0x39, 0x21, 0xda, 0xc2, 0xf9, 0x5b, 0x4f, 0xb0, 0x37, 0xf9, 0xbd, 0x77, 0x71, 0xd6, 0x9b,
-		0xfc, 0x1c, 0x8f, 0x7a, 0xbd, 0x6e, 0xc5, 0x06, 0xe5, 0xa2, 0x2e, 0xdc, 0x8f, 0x65, 0xcf, 0x4a,
-		0x4f, 0x96, 0x07, 0xf3, 0x4c, 0xa6, 0xf7, 0xf3, 0xcf, 0xb3, 0x3f, 0xce, 0xce, 0xbf, 0x9f, 0x55,
-		0xca, 0xd0, 0xaf, 0x64, 0x27, 0x13, 0xac, 0x7f, 0x3d, 0x01, 0x3c, 0xb9, 0x5a, 0x0d, 0xf6, 0xf4,
-		0x20, 0xcf, 0xae, 0xd1, 0x82, 0x3d, 0xbb, 0x5a, 0x6d, 0x08, 0x78, 0x7e, 0xa0, 0x37, 0x6f, 0x1f,
-		0xb4, 0xe0, 0xb5, 0x80, 0x2b, 0xcd, 0x21, 0x6c, 0x8b, 0x67, 0x65, 0x7a, 0x85, 0x46, 0xf8, 0x51,
-		0x7a, 0x96, 0xeb, 0x5c, 0x54, 0x97, 0x71, 0x54, 0x5d, 0x39, 0xc8, 0xda, 0x02, 0xe5, 0xe5, 0xfd,
-		0xd1, 0xd0, 0xd3, 0x46, 0x4f, 0x1b, 0x3d, 0xed, 0x8c, 0x27, 0x26, 0x66, 0xdc, 0x1c, 0x59, 0xf0,
-		0xb1, 0x5b, 0xd8, 0x4d, 0xd4, 0xaa, 0x5e, 0x79, 0xde, 0xc7, 0xcf, 0x1e, 0x42, 0x7f, 0x71, 0x3c,
-		0xec, 0x26, 0x0a, 0x72, 0x0b, 0x3e, 0xbd, 0xe3, 0x06, 0x7e, 0x10, 0x09, 0xdc, 0x47, 0x09, 0xc4,
-		0x2d, 0xd8, 0x95, 0x04, 0x62, 0x2f, 0xdf, 0xb7, 0x66, 0xe0, 0x4a, 0x52, 0xba, 0x92, 0xff, 0x7e,
-		0x7e, 0x90, 0x95, 0xf1, 0x79, 0x75, 0xd3, 0x5d, 0x86, 0xc4, 0xf5, 0x4c, 0xfd, 0x61, 0x9f, 0x60,
-		0xbc, 0x2c, 0x7d, 0x62, 0x1f, 0x4b, 0x6e, 0xee, 0x1b, 0xfb, 0x06, 0xde, 0xd8, 0xbf, 0xab, 0x9b,
-		0x83, 0x37, 0xf6, 0xe9, 0xcf, 0x0d, 0xde, 0xd8, 0x63, 0x1c, 0x01, 0xe3, 0x08, 0x85, 0x4e, 0x0c,
-		0xde, 0xd8, 0x67, 0x07, 0xb2, 0x78, 0x63, 0xff, 0x7e, 0x13, 0xc4, 0x1b, 0x7b, 0x97, 0xa7, 0x87,
-		0x37, 0xf6, 0xee, 0xce, 0x0e, 0x6f, 0xec, 0x9d, 0x9d, 0x1c, 0xde, 0xd8, 0xbb, 0xac, 0x34, 0xf1,
-		0xc6, 0x7e, 0xc7, 0xc1, 0x3b, 0xbc, 0xb1, 0xdf, 0xbe, 0x5d, 0x78, 0x63, 0x8f, 0x9e, 0x36, 0x7a,
-		0xda, 0x45, 0x4e, 0x0c, 0xde, 0xd8, 0xdf, 0x7b, 0x11, 0x17, 0xee, 0x0b, 0x6b, 0x78, 0x5f, 0xf8,
-		0xde, 0x5b, 0xd0, 0x68, 0xb5, 0xf0, 0xc6, 0xd0, 0x0e, 0x5c, 0xc1, 0x1b, 0xc3, 0x97, 0x6f, 0x0c,
-		0x33, 0xb0, 0xb7, 0x64, 0x5f, 0x1c, 0x67, 0x29, 0x6f, 0x5e, 0x5a, 0xae, 0x4a, 0xa6, 0x1b, 0xd0,
-		0xd7, 0x79, 0x4d, 0x46, 0x42, 0x99, 0x9f, 0x27, 0xab, 0xd1, 0x87, 0x8b, 0xc1, 0x6d, 0x71, 0xe8,
-		0xa4, 0xe0, 0x53, 0xc8, 0x58, 0x52, 0x9d, 0xaf, 0x94, 0xba, 0xd4, 0xd5, 0xff, 0x79, 0x79, 0xc2,
-		0x80, 0x70, 0x00, 0xe4, 0xe4, 0x01, 0x7b, 0x67, 0x26, 0x00, 0x6c, 0xab, 0xb5, 0xf7, 0x66, 0x07,
-		0xdd, 0x96, 0xb3, 0x86, 0x84, 0x78, 0xf9, 0x91, 0x02, 0x72, 0x68, 0xaf, 0x02, 0x3a, 0xc8, 0x96,
-		0x8f, 0x92, 0x8e, 0x92, 0xee, 0xaa, 0xa4, 0x03, 0x70, 0x03, 0xb2, 0x64, 0x56, 0xda, 0x81, 0xe8,
-		0x99, 0x74, 0x5e, 0x1e, 0x5d, 0x97, 0x51, 0xc7, 0x21, 0x3c, 0xff, 0x68, 0xf0, 0x3c, 0xb3, 0x4e,
-		0xba, 0xeb, 0x6b, 0x47, 0x49, 0x90, 0x2d, 0xad, 0x6a, 0xa3, 0x84, 0x0e, 0x33, 0x3c, 0x33, 0x5a,
-		0x09, 0xf3, 0xbf, 0xfe, 0xb5, 0x92, 0xcf, 0xcf, 0xc9, 0xf1, 0x7f, 0x43, 0x21, 0xcd, 0x96, 0xe6,
-		0x9c, 0x2b, 0xbd, 0x39, 0xb7, 0x17, 0xdd, 0x40, 0x31, 0x45, 0x2f, 0x1a, 0xbd, 0x68, 0xc4, 0xd6,
-		0x88, 0xad, 0xd1, 0x8b, 0x46, 0x2f, 0x1a, 0x25, 0x1d, 0x25, 0x1d, 0xbd, 0xe8, 0xf7, 0xf5, 0xa2,
-		0xb1, 0x57, 0xc4, 0x4b, 0x0f, 0x7d, 0x84, 0x5e, 0x11, 0x77, 0x07, 0xc1, 0x46, 0x5f, 0x88, 0xd1,
-		0x56, 0xfd, 0xe3, 0x40, 0x3b, 0x88, 0x65, 0x73, 0x86, 0x02, 0x4d, 0x20, 0x5e, 0x77, 0x40, 0x53,
-		0x39, 0x9c, 0xa9, 0x9b, 0x40, 0x34, 0xb0, 0x09, 0xc4, 0xfb, 0x34, 0x81, 0x20, 0x61, 0x28, 0xae,
-		0xab, 0xe2, 0x8a, 0x2a, 0x1d, 0x4f, 0xd3, 0xfb, 0x6e, 0x9b, 0x45, 0x7f, 0xe1, 0xf9, 0x6d, 0x8d,
-		0x05, 0x68, 0x40, 0xe2, 0x30, 0x99, 0x76, 0x40, 0x42, 0x4d, 0x53, 0x36, 0x96, 0xa8, 0x61, 0x63,
-		0x09, 0x9b, 0x60, 0xec, 0xfd, 0x1a, 0x4b, 0xa4, 0x06, 0x53, 0x39, 0xba, 0x6f, 0xa5, 0xec, 0xb6,
-		0xe5, 0x80, 0x06, 0x7f, 0x05, 0xd2, 0x3c, 0xa3, 0xc1, 0x3f, 0xbd, 0xf2, 0xb6, 0xdb, 0xde, 0x32,
-		0xc7, 0xdb, 0x55, 0x9e, 0xb5, 0x18, 0xaf, 0xda, 0xd5, 0x87, 0x53, 0xb9, 0x7b, 0xe1, 0xe5, 0xff,
-		0xad, 0x5e, 0xf9, 0xa5, 0x57, 0xad, 0x30, 0xdd, 0x11, 0x91, 0x54, 0x54, 0x6b, 0xea, 0x8f, 0x93,
-		0xd7, 0x7d, 0x72, 0xd8, 0x2b, 0x4c, 0x9f, 0x92, 0x4b, 0x7a, 0x21, 0xc4, 0x53, 0x41, 0x78, 0x3c,
-		0xc5, 0xca, 0xfd, 0x7f, 0x7a, 0xf0, 0xde, 0x5d, 0x7a, 0xc5, 0xbc, 0xd5, 0xba, 0xff, 0xfa, 0xf4,
-		0xeb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x00, 0x67, 0xd0, 0x98,
-		0xd6, 0x34, 0x08, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x53, 0xe3, 0x3a,
+		0xf2, 0xf8, 0x57, 0xa1, 0x52, 0xfb, 0x30, 0xfc, 0x17, 0x0f, 0xb9, 0x10, 0x6e, 0x2f, 0x5b, 0x21,
+		0x09, 0x73, 0xa8, 0x93, 0x40, 0x8a, 0xe4, 0xcc, 0xec, 0x2e, 0xb0, 0x94, 0x62, 0x2b, 0x89, 0x0a,
+		0x5b, 0xf2, 0x91, 0xe4, 0x40, 0xfe, 0x33, 0x7c, 0xf7, 0x5f, 0xf9, 0x92, 0xc4, 0xba, 0x38, 0x40,
+		0x30, 0x10, 0x33, 0x7a, 0x19, 0x26, 0xdd, 0xb2, 0xdd, 0x6a, 0xb5, 0xba, 0x5b, 0xdd, 0x52, 0xeb,
+		0x67, 0xe9, 0x1c, 0x78, 0xb0, 0x74, 0xbc, 0x55, 0x72, 0xe0, 0x14, 0xd9, 0xb0, 0xb4, 0xb3, 0x55,
+		0xfa, 0x13, 0x61, 0xa7, 0x74, 0xbc, 0x55, 0xd9, 0xd9, 0x2a, 0x35, 0x09, 0x1e, 0xa1, 0x71, 0xe9,
+		0x78, 0xab, 0xbc, 0xb3, 0x55, 0x6a, 0x21, 0x5a, 0x3a, 0xde, 0xfa, 0x59, 0x72, 0x5d, 0xc7, 0x8f,
+		0xfe, 0x33, 0x7f, 0x34, 0x02, 0xac, 0x78, 0xb0, 0x47, 0xe1, 0x08, 0x3d, 0x08, 0x8f, 0x10, 0xdb,
+		0x9a, 0x3f, 0xd5, 0x27, 0x01, 0xb5, 0x61, 0x84, 0xfd, 0x13, 0xce, 0xee, 0x09, 0x0d, 0xdf, 0x51,
+		0xf2, 0xe3, 0x67, 0x76, 0xb6, 0x4a, 0x7f, 0x00, 0xd6, 0xa0, 0xe3, 0xc0, 0x83, 0x98, 0x97, 0x8e,
+		0xb7, 0x38, 0x0d, 0xe0, 0xce, 0x56, 0x29, 0x05, 0x59, 0xbc, 0xeb, 0xf1, 0x31, 0x45, 0xa4, 0x3d,
+		0x27, 0x60, 0xf9, 0xcd, 0x04, 0xb4, 0x69, 0x84, 0x4e, 0x00, 0x63, 0x88, 0x59, 0xc8, 0x11, 0x89,
+		0x5d, 0x82, 0x97, 0x04, 0x97, 0x3f, 0x92, 0xe0, 0xc1, 0xcc, 0x87, 0xc2, 0x97, 0x18, 0xa7, 0x08,
+		0x0b, 0xfc, 0x3c, 0x8c, 0x1a, 0x2e, 0x49, 0xb7, 0xb8, 0xfc, 0x8c, 0x8c, 0xdb, 0xd4, 0xbe, 0x65,
+		0xd3, 0x59, 0xd9, 0xdb, 0xd9, 0x2a, 0xb5, 0x71, 0xe0, 0x85, 0xed, 0x1f, 0xa3, 0xa7, 0x21, 0x06,
+		0x43, 0x17, 0x8a, 0xe3, 0x37, 0x87, 0x85, 0x23, 0x0d, 0x47, 0x20, 0x70, 0xa3, 0x8f, 0x86, 0x74,
+		0x6c, 0x6c, 0x9f, 0x87, 0x84, 0xb8, 0x10, 0xe0, 0x74, 0x5f, 0x2b, 0x51, 0xcb, 0x09, 0x74, 0x5d,
+		0x62, 0x71, 0xe4, 0x41, 0x2a, 0x3c, 0x90, 0x86, 0x6f, 0x6a, 0xa7, 0x02, 0x84, 0xf9, 0xfe, 0x5e,
+		0x8a, 0xbc, 0xc3, 0x9d, 0xad, 0xd2, 0x25, 0xc0, 0xe3, 0x10, 0x7b, 0xf5, 0xb3, 0xd4, 0x45, 0x38,
+		0xfe, 0xf6, 0x92, 0xf8, 0xef, 0xc0, 0x0d, 0x60, 0xf2, 0xff, 0x53, 0x0a, 0x6c, 0x8e, 0x08, 0x6e,
+		0xa1, 0x31, 0xe2, 0x2c, 0x04, 0x86, 0x5f, 0xe9, 0x82, 0x87, 0xac, 0x87, 0x2a, 0x87, 0x7b, 0x7b,
+		0xfb, 0x07, 0x7b, 0x7b, 0xe5, 0x83, 0xda, 0x41, 0xf9, 0xa8, 0x5e, 0xaf, 0xec, 0x57, 0xea, 0xfa,
+		0xf7, 0x3c, 0xde, 0x44, 0x14, 0xb3, 0xc0, 0xf7, 0x29, 0x64, 0xcc, 0xe2, 0xee, 0xd4, 0x02, 0xce,
+		0x14, 0x52, 0x8e, 0x18, 0x4c, 0x3a, 0x97, 0x9a, 0x6c, 0xd9, 0xcd, 0x36, 0x95, 0xf5, 0xc8, 0x81,
+		0x98, 0x23, 0x3e, 0xa3, 0x70, 0x94, 0x96, 0xa9, 0x90, 0x1d, 0x67, 0x09, 0xea, 0x04, 0x30, 0xf1,
+		0x99, 0x4e, 0xa7, 0xd5, 0xbb, 0x1d, 0x74, 0xbe, 0x97, 0xe6, 0x2c, 0x65, 0xf1, 0x30, 0xcd, 0xf1,
+		0xcd, 0x3f, 0x1a, 0xfd, 0xfe, 0x59, 0xff, 0xf6, 0xac, 0x55, 0x7a, 0xdc, 0x49, 0x3d, 0xd7, 0xbb,
+		0xb8, 0x1c, 0xe8, 0x81, 0xad, 0x76, 0xbf, 0x79, 0x79, 0xd6, 0x1b, 0x9c, 0x5d, 0x9c, 0x8b, 0xd8,
+		0xfe, 0x7f, 0xfa, 0x83, 0x76, 0xf7, 0x49, 0x7c, 0xb3, 0xd1, 0x6b, 0x9c, 0x9c, 0x75, 0xce, 0x06,
+		0x67, 0xed, 0xbe, 0xd8, 0xa0, 0xdb, 0x38, 0x6f, 0x7c, 0x6b, 0x77, 0xdb, 0xe7, 0x83, 0xdb, 0x46,
+		0xab, 0x75, 0xd9, 0xee, 0xf7, 0xb5, 0x2f, 0x38, 0x6f, 0x74, 0xdb, 0xa5, 0x64, 0xb0, 0x3b, 0x88,
+		0xf1, 0x06, 0xe7, 0xf1, 0x34, 0xea, 0x22, 0xdc, 0x76, 0xa3, 0x21, 0x0c, 0x3b, 0x89, 0x03, 0xd7,
+		0x8d, 0x45, 0x4b, 0x05, 0x5e, 0x50, 0x07, 0x52, 0xe8, 0x9c, 0xcc, 0x12, 0x50, 0x2c, 0x38, 0x33,
+		0xc6, 0xa1, 0x67, 0x39, 0x90, 0xd9, 0x14, 0xf9, 0xa1, 0x70, 0x89, 0x02, 0xa3, 0xa2, 0x0b, 0x64,
+		0x48, 0x42, 0x5e, 0x41, 0x3c, 0xe6, 0x93, 0xb7, 0x99, 0xa5, 0xd5, 0xfa, 0x13, 0x93, 0x32, 0x66,
+		0x1e, 0x06, 0x9e, 0x44, 0x69, 0x0a, 0x6e, 0xb8, 0xf9, 0x2c, 0x6e, 0x86, 0xcf, 0x36, 0x30, 0x26,
+		0x1c, 0x2c, 0x44, 0x94, 0xd9, 0x13, 0xe8, 0x01, 0x1f, 0x44, 0xf4, 0x94, 0x76, 0x89, 0x0f, 0x71,
+		0xec, 0x9b, 0x45, 0xdd, 0xda, 0x8d, 0xfe, 0x89, 0x01, 0x71, 0x17, 0x11, 0xe6, 0x90, 0x8e, 0x80,
+		0x0d, 0x99, 0xd0, 0xd1, 0x14, 0x78, 0xc3, 0x7c, 0xba, 0x05, 0x65, 0x7a, 0x7a, 0x8d, 0xaf, 0xbc,
+		0x26, 0xa1, 0x9f, 0xdd, 0xd1, 0x52, 0xf4, 0xcd, 0x46, 0x2b, 0x9a, 0x21, 0x60, 0xd0, 0x5a, 0x08,
+		0xb5, 0x25, 0x99, 0xf9, 0x83, 0x90, 0xbc, 0xc5, 0x14, 0xb7, 0x2d, 0x34, 0x3a, 0x5e, 0x4e, 0x58,
+		0x19, 0x90, 0xfc, 0x8e, 0xba, 0x1b, 0x2b, 0x60, 0x4c, 0x88, 0x1f, 0xaa, 0x31, 0x41, 0xb3, 0xcd,
+		0x81, 0xe2, 0x58, 0x8f, 0x80, 0xcb, 0xd6, 0xe0, 0x12, 0xf1, 0xb9, 0xc5, 0x21, 0xf5, 0xf2, 0xe2,
+		0xd4, 0xe2, 0x7d, 0x2f, 0x19, 0xf4, 0x35, 0xb5, 0x63, 0x8a, 0x93, 0x4b, 0x1e, 0xa6, 0x55, 0x66,
+		0xb1, 0x24, 0xc9, 0x85, 0x60, 0xb4, 0x42, 0x7c, 0xbe, 0x7e, 0x4d, 0xfa, 0xb6, 0xbb, 0x14, 0x10,
+		0x0c, 0xd1, 0x78, 0x32, 0x24, 0x54, 0x34, 0x09, 0x4b, 0x68, 0x86, 0xe6, 0xaa, 0x7e, 0x90, 0xe6,
+		0x9a, 0x13, 0xa6, 0xa5, 0x76, 0xe3, 0xec, 0x01, 0xf0, 0xc1, 0x10, 0xb9, 0x88, 0x23, 0xc9, 0xe2,
+		0x0a, 0x88, 0x0d, 0xe3, 0xf0, 0x82, 0xb6, 0x99, 0x9e, 0xe4, 0x99, 0xb1, 0xba, 0x2f, 0x24, 0x34,
+		0x2f, 0xbd, 0xb4, 0x98, 0x94, 0x8b, 0xff, 0xed, 0xa6, 0x05, 0x69, 0xf9, 0x63, 0xf6, 0xa9, 0x55,
+		0x18, 0xe3, 0x80, 0xc3, 0x94, 0x06, 0x8b, 0x7e, 0x4b, 0x9e, 0x7b, 0x08, 0xd9, 0xb0, 0x79, 0xf5,
+		0x84, 0xcf, 0x65, 0x1c, 0xac, 0x0d, 0x0c, 0xa0, 0xc8, 0xa1, 0x8b, 0xff, 0x64, 0x85, 0x53, 0xfa,
+		0xb7, 0xdf, 0x3b, 0x0d, 0x29, 0xee, 0xd1, 0xba, 0x68, 0x86, 0x11, 0x96, 0x66, 0xe3, 0xa4, 0xd3,
+		0xbe, 0x6d, 0xb5, 0xbf, 0x9f, 0x35, 0xdb, 0x62, 0x83, 0x1f, 0x9d, 0xc6, 0xf9, 0x6d, 0xa3, 0xd9,
+		0x6c, 0xf7, 0xfb, 0xb7, 0xbd, 0x8b, 0xb3, 0xf3, 0x81, 0x88, 0xee, 0x0f, 0x1a, 0x61, 0x30, 0xe5,
+		0xf6, 0xe2, 0xbc, 0xf3, 0x1f, 0x11, 0x33, 0xf8, 0x71, 0x71, 0x1b, 0xc5, 0x64, 0xba, 0x8d, 0xe6,
+		0xed, 0x65, 0xbb, 0xd3, 0x90, 0xf0, 0x97, 0xed, 0x5e, 0xbb, 0x31, 0x68, 0x5f, 0x4a, 0xd0, 0x8b,
+		0xbf, 0x14, 0xd8, 0xc5, 0xe0, 0x0f, 0x19, 0x34, 0x68, 0x77, 0xda, 0xbd, 0x3f, 0x2e, 0xce, 0x25,
+		0x62, 0x9b, 0x9a, 0x1e, 0x86, 0x9f, 0x3f, 0xb9, 0x3c, 0x6b, 0x7d, 0x8b, 0xe3, 0x32, 0x1f, 0xa5,
+		0xec, 0xe2, 0xe9, 0x1e, 0x7f, 0xfe, 0x4f, 0x38, 0x4b, 0x8b, 0x63, 0x5e, 0x71, 0xa2, 0x0f, 0xe9,
+		0x58, 0x29, 0x52, 0x6e, 0x34, 0xb0, 0x39, 0x9e, 0x0b, 0xa5, 0xeb, 0xf8, 0xb7, 0x67, 0xf3, 0x57,
+		0xdd, 0x9e, 0x27, 0x2f, 0xb8, 0x6d, 0x2e, 0x9f, 0x79, 0xd7, 0x51, 0x88, 0xe7, 0xdb, 0x6f, 0x6d,
+		0x80, 0x53, 0x86, 0xd6, 0x0e, 0x18, 0x27, 0x5e, 0x18, 0x58, 0x96, 0xbc, 0xbd, 0x14, 0x7c, 0xc3,
+		0x8c, 0x12, 0x77, 0xa7, 0xc2, 0x07, 0xc3, 0xdf, 0xc6, 0xbd, 0xdb, 0x1c, 0xe9, 0x5a, 0x4a, 0xce,
+		0x2e, 0x77, 0xa7, 0x82, 0xb4, 0x91, 0x00, 0x89, 0xfd, 0x0b, 0x50, 0xe1, 0x9d, 0xba, 0xb0, 0x0f,
+		0xf3, 0xce, 0x59, 0x2c, 0x18, 0x2a, 0x69, 0xcf, 0x34, 0xfc, 0x13, 0x74, 0x76, 0xd1, 0x97, 0x22,
+		0x39, 0xb2, 0x45, 0x12, 0xbc, 0xac, 0xd4, 0x7a, 0x91, 0xe5, 0x2b, 0xab, 0x4f, 0x4a, 0x67, 0x36,
+		0xba, 0x17, 0x08, 0xf3, 0x5a, 0x35, 0x45, 0x5d, 0x6d, 0x65, 0x3a, 0xb9, 0x92, 0xce, 0x92, 0x54,
+		0xf6, 0x0e, 0xf6, 0x0e, 0x6b, 0xfb, 0x7b, 0x87, 0x6b, 0xe5, 0x58, 0xe6, 0x4f, 0x1f, 0xac, 0x4c,
+		0x5c, 0x4d, 0x93, 0xe6, 0x4b, 0x82, 0x63, 0xc8, 0xc6, 0xae, 0xd4, 0x10, 0x06, 0x34, 0x1d, 0x9a,
+		0x39, 0x7a, 0x7c, 0x6f, 0xe3, 0x34, 0x77, 0xc3, 0x0b, 0x27, 0x8a, 0xcf, 0x55, 0xd8, 0x89, 0xa6,
+		0x4e, 0x2d, 0x33, 0x42, 0xc8, 0x16, 0x09, 0xd0, 0x96, 0xa4, 0x37, 0x0a, 0xb2, 0xec, 0x10, 0x07,
+		0xf0, 0xf9, 0xcb, 0x8d, 0x81, 0x3b, 0x2d, 0xbd, 0xa7, 0x78, 0x25, 0xd9, 0x4b, 0x47, 0x5a, 0xd4,
+		0x17, 0x5e, 0xa0, 0x90, 0x53, 0x28, 0xc3, 0x0f, 0xc6, 0x22, 0x91, 0x60, 0x9c, 0x26, 0xd1, 0x6c,
+		0x57, 0x7a, 0xd1, 0x76, 0x25, 0xb3, 0x5d, 0xb1, 0xf0, 0xdb, 0x15, 0x8b, 0xa3, 0x90, 0xb2, 0x86,
+		0xcc, 0x05, 0x8c, 0x5b, 0x81, 0xef, 0xc8, 0xea, 0x27, 0x0d, 0xdf, 0x60, 0x07, 0x52, 0x98, 0xe0,
+		0x7b, 0xcf, 0x76, 0x20, 0x8f, 0xaa, 0xd5, 0x5a, 0xed, 0xa0, 0x5a, 0xae, 0xed, 0x1f, 0xd6, 0xf7,
+		0x0e, 0x0e, 0xea, 0x87, 0xe5, 0xb5, 0x3c, 0x49, 0xf5, 0x35, 0xab, 0x5d, 0x4a, 0x0f, 0x60, 0x30,
+		0x8e, 0x4c, 0xbe, 0x05, 0x1c, 0x27, 0xdc, 0x83, 0x28, 0xf4, 0x47, 0x83, 0x2e, 0x9a, 0x38, 0xa9,
+		0x5d, 0x50, 0x35, 0x41, 0x56, 0x9b, 0xa2, 0xf5, 0xd5, 0x27, 0x94, 0x67, 0xee, 0x19, 0x54, 0x90,
+		0x85, 0xec, 0x9d, 0xa4, 0xdf, 0xe6, 0xb0, 0x82, 0xf6, 0x45, 0x15, 0x45, 0x01, 0xb1, 0xa9, 0xbd,
+		0xca, 0x20, 0x52, 0x63, 0x8e, 0xcc, 0x3e, 0x56, 0xb3, 0x8f, 0xb5, 0x18, 0xdc, 0xe4, 0xdc, 0x15,
+		0x28, 0x0c, 0x7f, 0x6f, 0xf2, 0x42, 0xa2, 0xb2, 0x9f, 0x22, 0x6f, 0xff, 0x6d, 0x17, 0x12, 0xfb,
+		0xf5, 0x7a, 0x2d, 0xff, 0x5d, 0xc0, 0xcf, 0x5d, 0x74, 0x6b, 0x52, 0xa9, 0xc8, 0x29, 0x4c, 0x44,
+		0xe3, 0xd9, 0x11, 0x8c, 0xb7, 0x8a, 0x5e, 0x14, 0x6a, 0x39, 0x6f, 0x93, 0x20, 0xec, 0x81, 0x94,
+		0xab, 0x9c, 0x03, 0x37, 0x2c, 0x0b, 0x38, 0xa2, 0xc0, 0x83, 0x96, 0x83, 0x98, 0x0d, 0xa8, 0xe8,
+		0x96, 0x88, 0x98, 0x8d, 0x5d, 0x58, 0xc6, 0x7c, 0xdd, 0xe8, 0x90, 0x44, 0xcc, 0x49, 0x48, 0x29,
+		0xa1, 0x16, 0xc2, 0x1a, 0x26, 0x2f, 0x50, 0x86, 0xcb, 0xf9, 0x70, 0x99, 0x04, 0x3c, 0x93, 0xcd,
+		0x21, 0xce, 0xf0, 0xf9, 0xb5, 0x7c, 0xd6, 0xca, 0xb1, 0x91, 0xe0, 0x1c, 0x38, 0xab, 0x97, 0x5d,
+		0x23, 0xb5, 0xaf, 0xe3, 0x6d, 0x14, 0x79, 0xb3, 0x5d, 0x08, 0xa8, 0x1a, 0x90, 0x8b, 0xc1, 0x9b,
+		0xca, 0xdd, 0x30, 0x58, 0x68, 0x01, 0xec, 0x44, 0xe7, 0x98, 0xe5, 0xd5, 0x46, 0x0f, 0x70, 0x0e,
+		0x69, 0xc8, 0xdb, 0xab, 0xd2, 0xf5, 0xb5, 0xf3, 0x73, 0xef, 0xd1, 0x0a, 0xff, 0x54, 0xe7, 0x7f,
+		0x06, 0xf1, 0x9f, 0x63, 0xe1, 0xcf, 0x97, 0xeb, 0xeb, 0xaf, 0xd7, 0xd7, 0xce, 0x3f, 0xb7, 0xff,
+		0xf5, 0xe5, 0xbf, 0xbf, 0xae, 0xae, 0xaf, 0xff, 0x79, 0x7d, 0x6d, 0xdd, 0x08, 0x2d, 0xb6, 0x4b,
+		0xc9, 0x82, 0xc2, 0x9d, 0x6a, 0x7d, 0x83, 0x34, 0xdc, 0x48, 0xe5, 0xfa, 0x52, 0x19, 0xf2, 0x31,
+		0xc0, 0x77, 0x98, 0xdc, 0x63, 0x85, 0xbf, 0x73, 0xb8, 0xe1, 0xef, 0x9a, 0xfc, 0xcd, 0x73, 0x25,
+		0x12, 0xe7, 0x15, 0xd3, 0x4e, 0xfc, 0xca, 0xf5, 0x50, 0x73, 0xde, 0xf0, 0xb7, 0x28, 0xbf, 0x60,
+		0x4e, 0x05, 0x7a, 0xb0, 0xf4, 0x06, 0xf2, 0x56, 0x94, 0xad, 0xd7, 0x4f, 0x4d, 0x87, 0x3c, 0x78,
+		0x53, 0xac, 0x08, 0x80, 0xc9, 0x41, 0x17, 0x3d, 0x07, 0x5d, 0xa8, 0x20, 0x0e, 0xc4, 0x9c, 0x22,
+		0xc8, 0x2c, 0x30, 0x86, 0x8e, 0xb2, 0x7e, 0x50, 0x90, 0xc6, 0xa1, 0x78, 0xed, 0x12, 0xcd, 0x84,
+		0xcb, 0x4c, 0xb8, 0xcc, 0x84, 0x71, 0x0c, 0x67, 0x4d, 0x18, 0xc7, 0x84, 0x71, 0x8a, 0x12, 0xc6,
+		0x01, 0xb6, 0x0d, 0x7d, 0x0e, 0xd5, 0x38, 0xce, 0x02, 0x61, 0xe4, 0xf2, 0x75, 0x81, 0x1c, 0x13,
+		0x28, 0x33, 0x81, 0xb2, 0xdf, 0x29, 0x50, 0xf6, 0xac, 0x98, 0xd8, 0xef, 0x15, 0x09, 0x33, 0x85,
+		0x48, 0x4d, 0x21, 0x52, 0x53, 0x88, 0xd4, 0x14, 0x22, 0x35, 0x1b, 0x38, 0xcd, 0x06, 0xce, 0xf7,
+		0x2a, 0x44, 0x9a, 0x4e, 0x15, 0xbc, 0xfc, 0x71, 0xbd, 0xd9, 0x8e, 0x19, 0xe6, 0x03, 0xfb, 0x0e,
+		0x72, 0x8b, 0x53, 0x80, 0x99, 0x4f, 0xb0, 0x23, 0x19, 0x35, 0x0d, 0xfa, 0x65, 0x71, 0x51, 0xff,
+		0x6e, 0xf1, 0x34, 0xe5, 0xb9, 0x8c, 0xb7, 0xf8, 0xc6, 0x37, 0x29, 0x77, 0xf1, 0xae, 0x44, 0x03,
+		0xd7, 0x25, 0xf7, 0x16, 0x99, 0x42, 0xca, 0x82, 0xa1, 0x5e, 0x7b, 0x65, 0x34, 0x79, 0x7d, 0x05,
+		0xca, 0x77, 0xe9, 0x68, 0x6e, 0x55, 0x28, 0x45, 0x61, 0xa4, 0x7c, 0x57, 0x95, 0xce, 0x37, 0x2d,
+		0xd9, 0xfb, 0xae, 0x62, 0x91, 0x6f, 0xf9, 0x5e, 0x33, 0x0d, 0x9f, 0x9c, 0x86, 0x01, 0x27, 0x16,
+		0x86, 0x63, 0xc2, 0x91, 0x9c, 0x7f, 0x94, 0x50, 0x79, 0x2c, 0x62, 0x20, 0x9f, 0xe4, 0x65, 0xfc,
+		0xc2, 0x57, 0xbd, 0x70, 0x09, 0x93, 0xe5, 0x25, 0xbd, 0xca, 0x3d, 0x42, 0xa3, 0xbc, 0x7a, 0x84,
+		0x46, 0x2f, 0xcc, 0x5c, 0x3a, 0x81, 0xef, 0xc2, 0x07, 0xcb, 0x23, 0x8e, 0xd8, 0x3e, 0x0d, 0xdf,
+		0xcc, 0x21, 0x82, 0x38, 0xf0, 0x20, 0x05, 0x12, 0xc7, 0x33, 0xaf, 0xf7, 0xb0, 0x46, 0xa1, 0x21,
+		0xb0, 0x09, 0xe6, 0x94, 0xb8, 0x9a, 0x15, 0xb6, 0x88, 0xcf, 0xa5, 0x42, 0xf1, 0xc7, 0x4a, 0xea,
+		0x5b, 0x07, 0x13, 0xde, 0x41, 0x68, 0x33, 0xfb, 0xe6, 0x12, 0xe2, 0x0f, 0x81, 0x7d, 0xa7, 0xca,
+		0xad, 0x88, 0xc9, 0x65, 0x18, 0x3f, 0xb2, 0xa3, 0x1e, 0xb0, 0x33, 0x4e, 0xed, 0xda, 0xeb, 0x1f,
+		0xd7, 0x7d, 0x17, 0xb9, 0xd4, 0x53, 0xa8, 0x84, 0xf3, 0xff, 0x77, 0x55, 0xb6, 0x8e, 0x80, 0x35,
+		0x6a, 0x58, 0xa7, 0x37, 0x61, 0xd0, 0xfe, 0x58, 0xfc, 0xbd, 0xfd, 0xb3, 0xfe, 0xf8, 0x8f, 0x24,
+		0x4c, 0xef, 0xf1, 0x40, 0xfc, 0x02, 0x0f, 0x36, 0x72, 0x30, 0x37, 0xec, 0xe8, 0x56, 0x7e, 0x3b,
+		0xd0, 0x3e, 0xd0, 0x4c, 0x45, 0xc7, 0x51, 0x99, 0x0f, 0xa1, 0xe6, 0x6c, 0x70, 0x0c, 0xde, 0xcc,
+		0x59, 0xb0, 0x4e, 0xe8, 0xaa, 0x1d, 0x16, 0x47, 0x3d, 0x6f, 0x0f, 0x6e, 0xfb, 0xbd, 0x76, 0xbb,
+		0x95, 0x59, 0xfa, 0x35, 0x44, 0xde, 0xfe, 0x75, 0xfe, 0xe7, 0xf9, 0xc5, 0x0f, 0x39, 0xe0, 0x14,
+		0xa1, 0x2a, 0xdf, 0x4e, 0xb4, 0xe0, 0x72, 0x39, 0x0b, 0xa1, 0x87, 0x57, 0xeb, 0x59, 0xf0, 0x72,
+		0xb9, 0xab, 0xc5, 0x64, 0x3c, 0xb0, 0x97, 0xf1, 0x81, 0x7a, 0x26, 0x41, 0xdd, 0xac, 0x1e, 0xe8,
+		0x11, 0xd5, 0xe8, 0x45, 0x49, 0xa8, 0x20, 0xa7, 0x5a, 0x50, 0xef, 0x20, 0xf3, 0xeb, 0xc8, 0xc8,
+		0x72, 0x83, 0xe6, 0xbc, 0x5b, 0x3a, 0x19, 0x41, 0x00, 0x03, 0xeb, 0x79, 0x4d, 0x47, 0x74, 0x54,
+		0xd9, 0xef, 0x8e, 0xe8, 0x49, 0x80, 0x1d, 0x17, 0x8a, 0xdc, 0x85, 0xbe, 0x4b, 0x99, 0x08, 0x42,
+		0xa3, 0xde, 0x7d, 0xd4, 0x11, 0x01, 0x6a, 0x03, 0xff, 0x1e, 0xf8, 0x2d, 0xc2, 0x2b, 0x95, 0x1e,
+		0x25, 0x23, 0x24, 0xbf, 0x68, 0xe4, 0x38, 0x48, 0x84, 0x38, 0xac, 0x26, 0xbd, 0x18, 0x8f, 0x10,
+		0x46, 0x43, 0x80, 0x1d, 0xe9, 0xd5, 0x64, 0x34, 0x82, 0xd2, 0xeb, 0xa6, 0x04, 0xd9, 0xf0, 0x62,
+		0x0a, 0x69, 0x83, 0x7b, 0x22, 0x66, 0xcc, 0x7d, 0x11, 0xc0, 0x08, 0x86, 0xfc, 0xbb, 0x54, 0xe9,
+		0x78, 0x5a, 0xa9, 0x88, 0x80, 0x21, 0x45, 0xce, 0x58, 0xfa, 0xc8, 0x64, 0xe6, 0x43, 0x6a, 0x4f,
+		0x00, 0xc6, 0xd0, 0x15, 0x31, 0x90, 0x60, 0xa9, 0x69, 0xad, 0x5a, 0xeb, 0x51, 0xf2, 0x30, 0x93,
+		0xc0, 0x84, 0xf1, 0x1e, 0x90, 0xfa, 0x13, 0x2f, 0xa5, 0xfe, 0x70, 0x5c, 0x5b, 0x62, 0x11, 0x60,
+		0x5c, 0xea, 0xcb, 0x41, 0xb9, 0x06, 0x78, 0xd5, 0x1b, 0x4a, 0x3d, 0xe2, 0xc0, 0xbe, 0x1b, 0x90,
+		0x7e, 0xf8, 0x47, 0xc2, 0xb0, 0x83, 0x3e, 0x1a, 0x77, 0x10, 0x96, 0xe0, 0x1e, 0xb0, 0xfb, 0xd0,
+		0xfe, 0x0b, 0x27, 0x3e, 0xae, 0x0b, 0x9d, 0xb3, 0x53, 0xb1, 0x05, 0x85, 0xe3, 0xc0, 0x05, 0xb4,
+		0x72, 0x58, 0xad, 0x4a, 0x5d, 0x40, 0x3e, 0xa4, 0x2e, 0xc0, 0x12, 0x18, 0x50, 0x1b, 0x43, 0xde,
+		0x73, 0x03, 0x59, 0x3a, 0x86, 0x5e, 0xed, 0xa0, 0xec, 0x03, 0xda, 0x9c, 0x00, 0x89, 0x47, 0xcc,
+		0xb1, 0x51, 0xc6, 0x28, 0x36, 0x43, 0xdf, 0x54, 0xc4, 0x41, 0x3e, 0x81, 0x14, 0x43, 0xde, 0x64,
+		0x1e, 0xb0, 0x1d, 0x3d, 0xae, 0xd6, 0x1d, 0x22, 0x89, 0x65, 0x1e, 0x22, 0x0f, 0xd5, 0xba, 0xca,
+		0xd8, 0x76, 0xf8, 0xc8, 0xe9, 0xbf, 0xa5, 0x4e, 0x70, 0xef, 0xbb, 0x8d, 0xda, 0xd8, 0xe9, 0x49,
+		0x6f, 0xa1, 0xc0, 0x61, 0xd2, 0x90, 0x3f, 0x54, 0xeb, 0x9e, 0x2b, 0x89, 0x56, 0xb4, 0xfb, 0x76,
+		0x47, 0x30, 0x4a, 0x78, 0x5f, 0x66, 0x20, 0xf2, 0xa3, 0x1e, 0xba, 0xe0, 0x5e, 0xfa, 0xc6, 0xc8,
+		0xae, 0xec, 0x1d, 0xd6, 0x64, 0xd9, 0x28, 0x97, 0x0f, 0x15, 0x22, 0x2f, 0x81, 0x83, 0x88, 0x08,
+		0x25, 0x3e, 0x47, 0x36, 0x70, 0x9b, 0xb1, 0x74, 0x7e, 0xa3, 0x24, 0x90, 0x68, 0xa3, 0x8c, 0x4a,
+		0x62, 0xe3, 0x10, 0x9b, 0x45, 0x8c, 0xee, 0x02, 0xdb, 0x05, 0x33, 0x48, 0x25, 0x3a, 0x99, 0xfc,
+		0x8a, 0x09, 0x63, 0x48, 0x9e, 0x8a, 0x9e, 0x4f, 0x18, 0xe2, 0x50, 0x15, 0xb1, 0x11, 0x1a, 0x52,
+		0xd8, 0xd4, 0x4d, 0x16, 0xcf, 0x77, 0x99, 0x0c, 0x81, 0xe3, 0xc1, 0x32, 0xa0, 0xa0, 0x88, 0xc4,
+		0xe9, 0xb7, 0xd6, 0x85, 0x94, 0x08, 0xf1, 0x29, 0xf1, 0xbf, 0x23, 0xca, 0x03, 0xe0, 0x6a, 0x1e,
+		0x68, 0x9f, 0x48, 0xcd, 0xc7, 0x47, 0x47, 0x87, 0x15, 0x15, 0x24, 0x71, 0x1b, 0xb8, 0x41, 0xdb,
+		0x27, 0xf8, 0x02, 0x07, 0xd2, 0x3b, 0x95, 0xd1, 0x77, 0x58, 0x59, 0xe2, 0x16, 0x84, 0xf0, 0xb0,
+		0x5c, 0xad, 0xec, 0xff, 0xe8, 0x36, 0xce, 0xb5, 0x6f, 0xed, 0x90, 0x71, 0x38, 0x42, 0x2a, 0xa7,
+		0x9c, 0xe9, 0xf0, 0xd2, 0xb6, 0xff, 0xf2, 0x19, 0xa7, 0x10, 0x78, 0x72, 0x37, 0x91, 0x07, 0xe8,
+		0xec, 0xac, 0xdf, 0x92, 0x5e, 0xca, 0xd0, 0xc3, 0x80, 0x9c, 0x92, 0x80, 0xea, 0x26, 0x76, 0x33,
+		0x73, 0x5a, 0x4f, 0x90, 0xef, 0xa3, 0xd4, 0x76, 0x73, 0x55, 0xbb, 0xec, 0xef, 0xc9, 0x03, 0x49,
+		0x81, 0x07, 0x2f, 0xa1, 0x0b, 0x66, 0x7d, 0x48, 0xa3, 0x8b, 0x65, 0xc5, 0xde, 0x39, 0xcc, 0xad,
+		0x2a, 0x12, 0xda, 0x7a, 0x90, 0x24, 0xe5, 0xe1, 0x70, 0xbf, 0x03, 0x7c, 0x79, 0xdc, 0x99, 0xc2,
+		0xd8, 0x70, 0x5c, 0x4f, 0x7e, 0x34, 0xfc, 0x6a, 0xd7, 0x57, 0xb8, 0x34, 0x70, 0x3c, 0x59, 0x44,
+		0x1d, 0xcc, 0x94, 0x6f, 0x27, 0x8c, 0xd6, 0x8f, 0x4f, 0x7d, 0x4f, 0x62, 0x88, 0xa2, 0x76, 0xe3,
+		0xe1, 0x68, 0x91, 0x7b, 0xac, 0x1b, 0x90, 0x58, 0x1e, 0xff, 0x7d, 0x91, 0xa1, 0xb8, 0xce, 0x7c,
+		0x59, 0xb2, 0xa5, 0xb7, 0xfb, 0x0e, 0x8e, 0x54, 0x4f, 0x87, 0x10, 0x5f, 0x62, 0xdb, 0x90, 0xad,
+		0x68, 0x5b, 0x91, 0x7b, 0x4e, 0x0e, 0x0f, 0xcb, 0xd5, 0xaa, 0xab, 0x18, 0x0d, 0x1b, 0xf9, 0xaa,
+		0x88, 0xc5, 0xda, 0x59, 0xe5, 0x74, 0x8f, 0x20, 0xcc, 0x07, 0x24, 0xfa, 0xd3, 0x87, 0x14, 0x29,
+		0x5c, 0x63, 0x0e, 0x56, 0xa7, 0x82, 0x44, 0xb7, 0x0b, 0x7c, 0x49, 0x1f, 0x8f, 0x69, 0xad, 0x5c,
+		0xbb, 0x6c, 0x49, 0x36, 0xd6, 0x46, 0xcc, 0x26, 0x67, 0xfd, 0xce, 0xd4, 0x95, 0x2d, 0x81, 0xc3,
+		0xf4, 0xdd, 0xab, 0x37, 0x2f, 0x4f, 0x7b, 0x67, 0x58, 0x56, 0x09, 0xb5, 0x03, 0x11, 0x40, 0x7d,
+		0x59, 0x75, 0x8d, 0xbe, 0x8f, 0x90, 0xea, 0x93, 0x30, 0xe4, 0x6b, 0x66, 0x83, 0x32, 0xfa, 0x0d,
+		0x86, 0x2e, 0x02, 0xe9, 0x9b, 0x7f, 0x57, 0x8f, 0x6a, 0x12, 0x8d, 0x1e, 0xb1, 0xc1, 0x77, 0x48,
+		0x19, 0x22, 0xb8, 0xa2, 0xf1, 0x30, 0x54, 0x66, 0x77, 0x03, 0x97, 0xa3, 0x30, 0x94, 0x46, 0x64,
+		0x72, 0x95, 0x59, 0x10, 0xbd, 0x21, 0x94, 0xa7, 0x09, 0x04, 0x8e, 0x5e, 0x89, 0xce, 0x28, 0xd2,
+		0x7e, 0xa5, 0x45, 0x6c, 0xf6, 0x03, 0x51, 0xe8, 0x42, 0xc6, 0xb2, 0x84, 0x38, 0xd4, 0xfc, 0x17,
+		0x23, 0xc7, 0xcb, 0xc2, 0x47, 0x7e, 0xa2, 0x0b, 0xc1, 0x14, 0x2a, 0x26, 0xea, 0xf0, 0xe0, 0x40,
+		0xb1, 0xa7, 0x3a, 0xf5, 0x3f, 0x0c, 0xd7, 0x9d, 0x02, 0x24, 0x70, 0x39, 0x05, 0x52, 0x37, 0x3d,
+		0x87, 0x29, 0x9a, 0x62, 0xa2, 0x4a, 0x18, 0xe1, 0xf8, 0xc2, 0x09, 0xf4, 0x7d, 0x00, 0x7a, 0xc5,
+		0x89, 0x95, 0xc1, 0x5e, 0x9a, 0xbb, 0xa6, 0xc7, 0x33, 0x59, 0xe3, 0x8d, 0xb4, 0x0e, 0xd3, 0x34,
+		0x36, 0x35, 0x67, 0x7e, 0x23, 0x89, 0x63, 0x88, 0x0c, 0x50, 0x94, 0x88, 0x4f, 0x09, 0x87, 0x04,
+		0x1f, 0x96, 0x55, 0x8f, 0x64, 0xe1, 0x7d, 0x48, 0xb3, 0x63, 0xe8, 0x7e, 0x87, 0x36, 0x07, 0x7d,
+		0x0e, 0x24, 0xcc, 0xd4, 0xbb, 0x07, 0x14, 0x26, 0xc6, 0xee, 0x1c, 0xd9, 0x8a, 0x75, 0xf9, 0xe6,
+		0x13, 0xdc, 0x9b, 0xcc, 0x58, 0xa8, 0xf5, 0xfe, 0xc2, 0x48, 0xaf, 0xf8, 0xaa, 0xda, 0xc7, 0x14,
+		0x53, 0xc7, 0xc8, 0x88, 0x87, 0x9f, 0xeb, 0x24, 0xc1, 0x33, 0x89, 0xab, 0x23, 0x20, 0x8f, 0x2a,
+		0x93, 0xbc, 0x0a, 0x17, 0x61, 0x38, 0x56, 0xdd, 0x0f, 0x0e, 0xa9, 0xa7, 0x78, 0xbe, 0x6e, 0x0d,
+		0xf9, 0xaa, 0x36, 0x58, 0x28, 0xd3, 0xd3, 0x85, 0xe5, 0xd1, 0x99, 0xf8, 0xee, 0xe9, 0x37, 0x69,
+		0x6f, 0x06, 0xb0, 0x39, 0x74, 0x11, 0xeb, 0x42, 0x0e, 0x3a, 0x17, 0x17, 0x3d, 0x89, 0x0f, 0xea,
+		0x77, 0x6a, 0xf5, 0x2c, 0x4b, 0xd7, 0xed, 0x9d, 0xc9, 0xee, 0x30, 0xb0, 0x27, 0xad, 0x7e, 0x47,
+		0x84, 0xc2, 0x8a, 0x62, 0x7c, 0x44, 0xc0, 0x78, 0x24, 0xb1, 0xc1, 0xf7, 0x63, 0x35, 0xe0, 0x22,
+		0x7c, 0xa7, 0x5b, 0x5f, 0x45, 0x9a, 0xf3, 0x4c, 0xd6, 0x9c, 0x4c, 0x91, 0xaf, 0x48, 0x41, 0x44,
+		0xa7, 0xef, 0x64, 0xa7, 0x74, 0x12, 0x60, 0xae, 0x71, 0xff, 0x5c, 0xcf, 0x57, 0xb4, 0xf6, 0x48,
+		0x76, 0x4c, 0xe5, 0x59, 0xa7, 0x08, 0x29, 0x00, 0x6e, 0x5d, 0xa7, 0x2f, 0xcf, 0xb0, 0x5e, 0x7f,
+		0x0f, 0xc8, 0x1d, 0xc4, 0x97, 0x61, 0x18, 0x47, 0xd4, 0xa6, 0x8a, 0xd9, 0x42, 0x23, 0x6b, 0xcc,
+		0x70, 0xd6, 0x70, 0x44, 0x0e, 0x8b, 0x4d, 0x30, 0x86, 0xb6, 0x34, 0x99, 0xee, 0xef, 0x01, 0xee,
+		0xf5, 0xaa, 0x19, 0x33, 0x7d, 0xae, 0x1a, 0x2e, 0x47, 0x3d, 0xc5, 0xb1, 0x1c, 0xab, 0xcb, 0x2b,
+		0x50, 0xa9, 0x76, 0x7d, 0xbf, 0x7f, 0x8f, 0xb8, 0x3d, 0x91, 0x59, 0x73, 0x4a, 0xe8, 0x3d, 0xa0,
+		0x8e, 0xb6, 0x9b, 0x7b, 0x51, 0x37, 0x4f, 0xe4, 0xa5, 0x8f, 0x0f, 0x28, 0xd0, 0x08, 0xee, 0xe9,
+		0xb7, 0x56, 0xbb, 0x71, 0xde, 0x90, 0xda, 0xca, 0xcc, 0xd7, 0x78, 0x46, 0xae, 0xad, 0x79, 0x5b,
+		0xbb, 0xab, 0x9d, 0xee, 0x35, 0xe0, 0x74, 0xc0, 0x58, 0x31, 0x7f, 0x03, 0x09, 0x04, 0x99, 0x4d,
+		0xf0, 0x13, 0xcc, 0x93, 0x5e, 0x32, 0x51, 0x08, 0xfb, 0x1b, 0x78, 0x5a, 0xb6, 0xec, 0x77, 0x01,
+		0x56, 0xed, 0x53, 0x13, 0xbb, 0x4c, 0x85, 0xa6, 0xad, 0x96, 0x7e, 0x39, 0xa2, 0xae, 0xc0, 0x00,
+		0xf7, 0xce, 0x3c, 0xa0, 0x08, 0xa8, 0x76, 0xb9, 0xa5, 0x84, 0x05, 0x3c, 0xe8, 0x20, 0xd0, 0x05,
+		0xc8, 0xd5, 0x79, 0x6d, 0x49, 0x07, 0x6a, 0xba, 0xb5, 0xa6, 0x4f, 0x70, 0xa5, 0x2e, 0x4d, 0x02,
+		0x30, 0x45, 0x36, 0xba, 0x88, 0x57, 0x60, 0x1a, 0xd5, 0xfe, 0x50, 0xad, 0xfb, 0xf2, 0x44, 0x77,
+		0x89, 0x0d, 0xdc, 0x01, 0x70, 0xef, 0x9e, 0xe0, 0xbe, 0xd6, 0xf8, 0x47, 0x23, 0xdf, 0x3a, 0x6b,
+		0xa9, 0xeb, 0x8c, 0x4e, 0x4f, 0x9e, 0x43, 0x2d, 0x57, 0xbb, 0xa2, 0x65, 0xc8, 0xef, 0x23, 0x49,
+		0x1a, 0x86, 0x80, 0x21, 0x5b, 0x5d, 0x6a, 0x24, 0xeb, 0x97, 0x4c, 0x0b, 0xe3, 0xfb, 0xbe, 0x6a,
+		0xae, 0x79, 0xa0, 0x33, 0x62, 0xe7, 0xc8, 0x1e, 0x28, 0x22, 0x35, 0x1c, 0xfb, 0x3e, 0x71, 0x91,
+		0x3d, 0x03, 0x76, 0xb4, 0x45, 0x56, 0x51, 0x17, 0x91, 0x47, 0x1e, 0x8a, 0x45, 0x47, 0x15, 0x8b,
+		0x89, 0x33, 0x51, 0x63, 0x14, 0x73, 0x9b, 0x57, 0x91, 0xc7, 0xa1, 0x52, 0x93, 0x17, 0xc5, 0xd5,
+		0x5a, 0x55, 0xb3, 0x9e, 0xf1, 0xdd, 0xe0, 0x09, 0x21, 0xd5, 0xcf, 0x8e, 0x54, 0xd4, 0xeb, 0x84,
+		0x31, 0x9d, 0x20, 0x36, 0xd5, 0xf5, 0xc6, 0x7c, 0xc8, 0xb3, 0x5c, 0x92, 0xd0, 0x4a, 0x3e, 0x68,
+		0x9c, 0x66, 0x07, 0xff, 0x5b, 0xf6, 0xc4, 0x1c, 0x5b, 0x6a, 0xc4, 0x5c, 0xc5, 0x0d, 0x62, 0x65,
+		0x9d, 0xe1, 0xf1, 0xa6, 0xae, 0x36, 0xa0, 0x90, 0xb1, 0x2c, 0x07, 0x23, 0x17, 0xe0, 0x48, 0xd1,
+		0x68, 0x97, 0x47, 0x7d, 0x75, 0x19, 0x21, 0xc9, 0x03, 0x60, 0x33, 0x2c, 0x31, 0x62, 0xec, 0xcb,
+		0xba, 0xc8, 0x05, 0xfe, 0x50, 0x99, 0xf3, 0xfd, 0x60, 0x98, 0xb1, 0x80, 0x8d, 0x57, 0x6e, 0x7a,
+		0x39, 0x09, 0x43, 0x3b, 0xba, 0x70, 0x01, 0xf2, 0xb8, 0x56, 0xda, 0xb5, 0xee, 0x5c, 0x45, 0xe3,
+		0xce, 0xcd, 0x4d, 0x5d, 0x8b, 0x53, 0x9d, 0x2c, 0xfc, 0xe0, 0xf3, 0x30, 0x85, 0x26, 0x68, 0xa3,
+		0x8d, 0x25, 0x3a, 0xac, 0x72, 0xda, 0xea, 0x68, 0xdd, 0x4f, 0x45, 0x7d, 0xdb, 0xea, 0x4a, 0xc3,
+		0xb6, 0x79, 0xdb, 0x0b, 0xa4, 0x77, 0x8e, 0xd1, 0x18, 0x0c, 0x51, 0xec, 0x76, 0x2a, 0x4f, 0x38,
+		0x61, 0x92, 0x08, 0xb8, 0x3f, 0x28, 0xf0, 0x7d, 0x48, 0x57, 0xae, 0x3a, 0x42, 0x87, 0xfd, 0xcc,
+		0x46, 0x7a, 0x75, 0x59, 0x3f, 0x45, 0x43, 0x99, 0xed, 0x6e, 0x55, 0x23, 0xb8, 0xd1, 0x74, 0x1e,
+		0x38, 0xb2, 0xee, 0x9e, 0xd6, 0xf6, 0x95, 0x31, 0x3b, 0x0d, 0x64, 0x6d, 0xc3, 0x03, 0x95, 0xac,
+		0x70, 0xbe, 0x57, 0x6a, 0x47, 0xf2, 0xd2, 0x9e, 0x78, 0xd0, 0xc7, 0x20, 0xcb, 0xa9, 0xd0, 0x32,
+		0xa1, 0x47, 0xee, 0x21, 0x0d, 0x5d, 0x58, 0x09, 0xeb, 0xb2, 0x7b, 0x55, 0x1f, 0xcc, 0x75, 0x41,
+		0xaf, 0xda, 0x93, 0x97, 0xdb, 0x40, 0x36, 0xe7, 0xd4, 0x5f, 0x15, 0xaf, 0x53, 0x03, 0x64, 0x03,
+		0x4d, 0x37, 0x01, 0xf7, 0x86, 0x04, 0x3b, 0x6a, 0x18, 0xab, 0xaa, 0x73, 0x0c, 0xb0, 0x2d, 0x13,
+		0x41, 0x43, 0x09, 0xec, 0x36, 0x9a, 0xb2, 0x9c, 0x0a, 0xba, 0x47, 0xe7, 0x2b, 0x85, 0x09, 0x74,
+		0x4f, 0x0d, 0x70, 0x7f, 0x03, 0x1c, 0xde, 0x41, 0xe8, 0x43, 0xaa, 0x73, 0xca, 0x94, 0x18, 0x35,
+		0xed, 0x28, 0x92, 0xc0, 0x3d, 0x95, 0xab, 0x8a, 0xa9, 0x5e, 0xe8, 0x1a, 0xc5, 0x5d, 0xd7, 0x3b,
+		0x65, 0x5a, 0x0f, 0xce, 0xc6, 0x54, 0xb6, 0xe3, 0x2c, 0x4c, 0x10, 0xed, 0x6c, 0x99, 0xc4, 0x87,
+		0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49,
+		0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c,
+		0x98, 0xc4, 0x87, 0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c, 0x98,
+		0xc4, 0x87, 0x49, 0x7c, 0x98, 0xc4, 0x87, 0x49, 0x7c, 0x7c, 0x92, 0xc4, 0x47, 0x5e, 0xa5, 0x02,
+		0xb4, 0x45, 0xb9, 0xd3, 0xf5, 0x03, 0xf2, 0x38, 0x71, 0xf6, 0x31, 0x15, 0x16, 0x9e, 0xbe, 0x44,
+		0x3b, 0xee, 0xe7, 0x6e, 0x52, 0xd6, 0x7c, 0x67, 0x21, 0xdd, 0xe1, 0x91, 0xd3, 0xc0, 0x85, 0x56,
+		0xe2, 0xba, 0xcb, 0x27, 0xc4, 0xb3, 0x5b, 0x99, 0x7a, 0x04, 0xf9, 0x12, 0xfd, 0xda, 0x0b, 0x92,
+		0x3f, 0x46, 0xf0, 0xc2, 0x63, 0xa3, 0xb5, 0x6a, 0x8a, 0xd4, 0x83, 0xb7, 0x3d, 0x36, 0xba, 0x57,
+		0x3d, 0xda, 0x3b, 0xda, 0x3f, 0xa8, 0x1e, 0xbd, 0xaa, 0xe6, 0xce, 0xda, 0xda, 0x22, 0x73, 0x3a,
+		0x08, 0x7a, 0x44, 0x6c, 0xb5, 0x62, 0x3e, 0x99, 0x49, 0x94, 0x37, 0xd1, 0x89, 0xad, 0x3e, 0xd6,
+		0x78, 0x70, 0x45, 0x98, 0x4e, 0x59, 0x27, 0x89, 0x8b, 0x6b, 0x99, 0xb4, 0x3d, 0xfa, 0x90, 0x19,
+		0x2a, 0x62, 0x84, 0x09, 0x9b, 0xcf, 0x15, 0x14, 0x46, 0xd2, 0x8d, 0xa4, 0x6f, 0xa2, 0xa4, 0x3f,
+		0xbf, 0x98, 0xdc, 0x9b, 0xd3, 0xa2, 0x56, 0xa6, 0xeb, 0x45, 0x5f, 0x18, 0x2c, 0x3f, 0x90, 0xba,
+		0x71, 0x29, 0x09, 0xd7, 0x75, 0xa3, 0x47, 0x9b, 0x8b, 0x77, 0x8a, 0xf0, 0xc2, 0xce, 0x60, 0xe3,
+		0xf0, 0x15, 0xda, 0xe1, 0xfb, 0xc8, 0x59, 0x95, 0xcb, 0x34, 0x5a, 0x6b, 0xe2, 0x6c, 0x44, 0xe5,
+		0x36, 0xc7, 0x43, 0xd8, 0x0a, 0xc9, 0x0c, 0xc4, 0xfa, 0x42, 0x02, 0x62, 0x13, 0x8b, 0x66, 0x3c,
+		0xbb, 0xfa, 0xd7, 0xef, 0x54, 0x9c, 0x2e, 0xe7, 0x5b, 0x99, 0xf2, 0x1f, 0xc6, 0x85, 0xcb, 0x05,
+		0x28, 0x45, 0x90, 0xc6, 0x62, 0x89, 0xc2, 0x41, 0x94, 0x68, 0xd6, 0xe0, 0x37, 0x51, 0x0a, 0x8b,
+		0x70, 0x37, 0x00, 0xc2, 0xd6, 0xd0, 0x97, 0xcb, 0x78, 0x46, 0xa0, 0xa2, 0x18, 0xc9, 0x8d, 0x67,
+		0x2f, 0x25, 0xc0, 0xb1, 0xc3, 0x3b, 0x56, 0xfc, 0x3b, 0xae, 0x72, 0x5a, 0xc4, 0x1a, 0x31, 0x5e,
+		0x9b, 0xcf, 0xc9, 0x4d, 0x21, 0x0a, 0x87, 0x17, 0x70, 0xc3, 0xdb, 0xb5, 0x79, 0x1b, 0x5d, 0x1a,
+		0xa6, 0x70, 0x36, 0x81, 0x1a, 0xbe, 0xae, 0xcd, 0xd7, 0x91, 0xcd, 0x32, 0x78, 0x9b, 0xc2, 0x18,
+		0xfe, 0xae, 0xcd, 0x5f, 0x2f, 0xdc, 0x5f, 0x96, 0xa9, 0x7b, 0x25, 0xac, 0xe1, 0xf3, 0xda, 0x7c,
+		0x26, 0x36, 0x87, 0x2a, 0x7f, 0x13, 0xa8, 0xe1, 0xeb, 0xda, 0x7c, 0xd5, 0x49, 0xad, 0x91, 0xd5,
+		0x57, 0xf2, 0x54, 0x75, 0x77, 0x7d, 0xe3, 0xee, 0xe6, 0xc6, 0x5e, 0xca, 0xac, 0x11, 0xb4, 0x2d,
+		0x9b, 0x50, 0x0a, 0x6d, 0x0e, 0x1d, 0xcb, 0x26, 0x0e, 0x0c, 0xf9, 0xa1, 0x70, 0x7d, 0x45, 0x4b,
+		0x33, 0x18, 0xb9, 0x0e, 0x46, 0x80, 0x9f, 0x3f, 0x1c, 0xfa, 0xb6, 0x66, 0x40, 0xf2, 0x19, 0x90,
+		0x00, 0x67, 0xbb, 0x23, 0x02, 0xce, 0x28, 0xf8, 0x57, 0xf0, 0x38, 0xba, 0xd2, 0xd0, 0x0a, 0x37,
+		0x2a, 0x12, 0x0d, 0x97, 0x05, 0xac, 0xe1, 0xf3, 0xc6, 0xdc, 0x25, 0xfb, 0x0e, 0x9c, 0xe5, 0xc8,
+		0x83, 0x1c, 0xd9, 0x77, 0x6c, 0xa3, 0x79, 0x4b, 0x02, 0xae, 0x04, 0xe5, 0xe6, 0x30, 0xa3, 0x88,
+		0xf3, 0x62, 0x70, 0x76, 0x58, 0x4e, 0x83, 0x36, 0x6a, 0x62, 0x7d, 0x4e, 0x6b, 0x03, 0x73, 0x02,
+		0xc2, 0x70, 0x77, 0x7d, 0xee, 0x6a, 0xc2, 0x47, 0x29, 0xb0, 0xe1, 0xec, 0xfa, 0x9c, 0x5d, 0x11,
+		0x3c, 0xd2, 0xa0, 0x0d, 0xa7, 0xd7, 0xe7, 0xb4, 0x26, 0x7c, 0x94, 0x02, 0x1b, 0xce, 0xae, 0xcf,
+		0x59, 0xad, 0xe4, 0x1a, 0x79, 0x7d, 0x2d, 0x57, 0x35, 0xce, 0x99, 0x89, 0x21, 0xe5, 0xc8, 0xe0,
+		0xcc, 0x65, 0xb2, 0x82, 0x34, 0x62, 0xfc, 0x26, 0x77, 0xd6, 0xaf, 0xbd, 0x95, 0xea, 0xa9, 0x7b,
+		0xed, 0x57, 0xed, 0x9f, 0x12, 0xaf, 0xbb, 0x37, 0x77, 0x29, 0x9a, 0xbb, 0x14, 0xcd, 0x5d, 0x8a,
+		0x1f, 0x7d, 0xc5, 0x20, 0x1a, 0x21, 0xec, 0x40, 0x91, 0xa4, 0x39, 0x6c, 0x53, 0xaf, 0xd7, 0xdb,
+		0xb4, 0x6d, 0xb3, 0x8b, 0x70, 0xd9, 0x24, 0xa1, 0x43, 0x8e, 0x97, 0xc5, 0x70, 0x13, 0x30, 0x5b,
+		0x3b, 0x18, 0x99, 0x54, 0x39, 0x13, 0x38, 0x9b, 0xc0, 0xcc, 0xcd, 0xa5, 0xe6, 0xe6, 0x52, 0x73,
+		0x73, 0xa9, 0xb9, 0xb9, 0x74, 0x33, 0x9d, 0x42, 0xe2, 0x43, 0xaa, 0xdb, 0x61, 0x9f, 0x86, 0x17,
+		0x7a, 0x83, 0xbd, 0xb9, 0x9a, 0xd5, 0x5c, 0xcd, 0x6a, 0xae, 0x66, 0x35, 0x57, 0xb3, 0x9a, 0x0a,
+		0xe5, 0xa6, 0x42, 0xb9, 0xa9, 0x50, 0x6e, 0x2a, 0x94, 0x9b, 0x0a, 0xe5, 0xa6, 0x42, 0xb9, 0xa9,
+		0x50, 0x6e, 0x2a, 0x94, 0x9b, 0x0a, 0xe5, 0xa6, 0x42, 0xb9, 0xa9, 0x50, 0x6e, 0x2a, 0x94, 0x9b,
+		0x0a, 0xe5, 0xa6, 0x42, 0xb9, 0xa9, 0x50, 0x6e, 0x2a, 0x94, 0x9b, 0x0a, 0xe5, 0xa6, 0x42, 0xb9,
+		0xa9, 0x50, 0x6e, 0x2a, 0x94, 0x9b, 0x0a, 0xe5, 0xa6, 0x42, 0xb9, 0xa9, 0x50, 0x6e, 0x2a, 0x94,
+		0x9b, 0xab, 0x59, 0x4d, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3,
+		0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x24,
+		0x3e, 0x4c, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x24, 0x3e,
+		0x4c, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x24, 0x3e, 0x4c, 0xe2, 0xc3, 0x5c, 0xcd, 0xfa,
+		0xca, 0x9a, 0x0d, 0xc9, 0x0d, 0x3b, 0x7f, 0xc2, 0x59, 0xfa, 0x20, 0x5d, 0x07, 0x31, 0xde, 0xe0,
+		0x3c, 0x2e, 0xf2, 0xd7, 0x45, 0xb8, 0xed, 0x42, 0x0f, 0xe2, 0xe8, 0x14, 0x1e, 0x0e, 0x5c, 0x37,
+		0x3e, 0xc0, 0xa7, 0x02, 0x2f, 0xa8, 0x03, 0x29, 0x74, 0x4e, 0x66, 0x09, 0xe8, 0xed, 0x88, 0x7f,
+		0x51, 0x85, 0x89, 0x37, 0xb8, 0x45, 0x48, 0x77, 0x11, 0x25, 0x5b, 0x71, 0x13, 0x25, 0xdb, 0xe4,
+		0x0b, 0x7f, 0x3e, 0xc5, 0x7d, 0x9a, 0xc0, 0x05, 0xd4, 0xb3, 0xf8, 0x84, 0x42, 0x36, 0x21, 0xae,
+		0x54, 0x76, 0x4e, 0x41, 0x9a, 0x8b, 0x41, 0xf3, 0x25, 0xda, 0x27, 0x8c, 0x47, 0x75, 0x84, 0x87,
+		0x30, 0x06, 0xa4, 0x14, 0xd9, 0x12, 0x51, 0x84, 0x42, 0x49, 0x0e, 0xb4, 0x91, 0x07, 0x5c, 0xa1,
+		0xf0, 0x41, 0xa5, 0xaa, 0x3b, 0x8f, 0x5c, 0x59, 0x5d, 0x0f, 0xa1, 0xba, 0xce, 0x41, 0xe8, 0xda,
+		0xd3, 0x0f, 0x25, 0x87, 0xa0, 0x99, 0x33, 0x67, 0xab, 0x45, 0x11, 0x43, 0x78, 0x6c, 0x31, 0xe0,
+		0xf9, 0xb2, 0x16, 0xca, 0x6e, 0x65, 0x2e, 0xc3, 0xfb, 0xc8, 0xcb, 0xf0, 0x24, 0xeb, 0x20, 0xfd,
+		0xde, 0x95, 0xf5, 0x55, 0xd1, 0x6f, 0x53, 0x35, 0xea, 0xc1, 0xa8, 0x07, 0xa3, 0x1e, 0xde, 0x4e,
+		0x3d, 0xe4, 0x7b, 0x59, 0xe6, 0x0b, 0xbf, 0xfe, 0x1c, 0x5f, 0x5c, 0xb8, 0x23, 0xf3, 0xb6, 0x11,
+		0xbe, 0x61, 0xb0, 0x7c, 0x41, 0x72, 0x4f, 0x61, 0x01, 0xfd, 0x1e, 0xe0, 0x86, 0x45, 0xcd, 0xc8,
+		0x14, 0x52, 0x16, 0x0c, 0xf5, 0x85, 0xeb, 0x32, 0x9a, 0x14, 0x61, 0x82, 0x65, 0xd6, 0xf1, 0x09,
+		0x15, 0x46, 0xb4, 0x18, 0x9a, 0x4a, 0xe5, 0x97, 0x04, 0x84, 0xd1, 0x21, 0x6b, 0x17, 0x0e, 0xcb,
+		0xbf, 0x0a, 0xe2, 0x66, 0x5d, 0x43, 0xfe, 0x44, 0xed, 0xbc, 0xe2, 0xce, 0x0c, 0x97, 0x30, 0x24,
+		0x55, 0xf0, 0x62, 0xa8, 0xd8, 0x5d, 0x8a, 0xd4, 0x7e, 0x64, 0x50, 0x2c, 0xa5, 0x48, 0x8b, 0x8c,
+		0x2b, 0x42, 0x47, 0x05, 0x73, 0xa6, 0xa5, 0x5d, 0x53, 0xd0, 0xe8, 0x93, 0xdd, 0x9b, 0xbf, 0x13,
+		0x7e, 0x66, 0x28, 0x3a, 0x83, 0x11, 0xa0, 0xb8, 0x92, 0xfa, 0x2e, 0xbe, 0x4f, 0x7a, 0x1d, 0x56,
+		0x5c, 0x91, 0x70, 0x21, 0x18, 0x49, 0xd5, 0x8b, 0x0e, 0xe2, 0x42, 0x76, 0x11, 0xa3, 0xbe, 0x7e,
+		0x4d, 0xfa, 0xb9, 0x1b, 0xf5, 0x48, 0x13, 0xea, 0xb4, 0x46, 0x14, 0xfe, 0x1d, 0x40, 0x6c, 0xcf,
+		0x56, 0x44, 0x0b, 0x53, 0x8d, 0x4c, 0xb8, 0x2d, 0x67, 0xa2, 0x21, 0xe6, 0x14, 0xb8, 0x4b, 0x0e,
+		0x5b, 0xa2, 0x9b, 0xa0, 0xc3, 0x17, 0x41, 0x30, 0xe5, 0x52, 0x83, 0xd5, 0x95, 0xce, 0x58, 0x25,
+		0xe5, 0x57, 0xd5, 0xaa, 0x07, 0xfb, 0x87, 0xeb, 0x38, 0x64, 0xe1, 0x83, 0x07, 0x2b, 0x7d, 0xb1,
+		0x79, 0x1e, 0xf4, 0x58, 0x93, 0x1b, 0x2d, 0x8a, 0x8b, 0x7b, 0x98, 0xa2, 0xb4, 0xfe, 0xb6, 0x1e,
+		0x6e, 0xb5, 0xbe, 0xda, 0xb5, 0x1d, 0x53, 0x24, 0xfa, 0x7d, 0x11, 0xa0, 0x08, 0x8c, 0x5c, 0xce,
+		0xa6, 0x90, 0xe4, 0x67, 0x38, 0x0d, 0xcc, 0x25, 0xdc, 0xba, 0x47, 0x0e, 0x9f, 0x58, 0x9e, 0x68,
+		0x93, 0xd3, 0x88, 0xcf, 0x2d, 0x43, 0x95, 0xdc, 0x65, 0xe8, 0x5d, 0x0c, 0x7d, 0x96, 0x29, 0x2b,
+		0x7a, 0x24, 0xd6, 0x58, 0x0e, 0x63, 0x39, 0x8a, 0x6a, 0x39, 0xf4, 0x4e, 0xa7, 0xce, 0xcb, 0xfc,
+		0x0c, 0xf9, 0x83, 0xfd, 0x8f, 0x4c, 0x1f, 0x18, 0x23, 0x6d, 0x8c, 0xf4, 0x6b, 0xa7, 0xeb, 0x3d,
+		0x98, 0x42, 0x17, 0xe2, 0x31, 0x9f, 0x08, 0x54, 0xa6, 0xc0, 0x9f, 0x6b, 0xc2, 0xd6, 0x3e, 0x6c,
+		0xc2, 0x7e, 0xac, 0x47, 0xf4, 0xae, 0xc9, 0xa7, 0x55, 0x21, 0x86, 0x97, 0x25, 0xa1, 0x84, 0x5f,
+		0xa7, 0x8b, 0xf7, 0xe8, 0xa2, 0x1d, 0xd4, 0x5b, 0xb5, 0xb7, 0x2b, 0x42, 0x6f, 0x70, 0xb0, 0xa0,
+		0x80, 0x5e, 0xea, 0x83, 0x18, 0x43, 0x7f, 0x40, 0x85, 0xd1, 0xbf, 0x9b, 0x75, 0x53, 0xc2, 0xc3,
+		0xdf, 0x22, 0x1f, 0xff, 0x36, 0x7c, 0x5c, 0x8b, 0x8f, 0x33, 0x51, 0x1e, 0x67, 0x46, 0x1e, 0xd7,
+		0xe4, 0xa3, 0x28, 0x8f, 0x33, 0x23, 0x8f, 0x6b, 0xf0, 0xf1, 0x23, 0xec, 0x2c, 0xf5, 0xd8, 0x87,
+		0xda, 0xf9, 0xc4, 0xc4, 0xbe, 0xc2, 0xc2, 0x5f, 0x7a, 0xac, 0xb0, 0x41, 0x1b, 0xb3, 0xcb, 0xc4,
+		0xec, 0x32, 0x79, 0x8b, 0x5d, 0x26, 0x36, 0x60, 0xd0, 0xe2, 0xd0, 0xf3, 0x21, 0x05, 0x3c, 0xa0,
+		0x22, 0xcd, 0x0a, 0xf2, 0x73, 0xad, 0xd6, 0xaa, 0x1f, 0x19, 0x5e, 0x49, 0x82, 0x80, 0xd1, 0x55,
+		0x4f, 0x4c, 0x17, 0x1e, 0x4c, 0x30, 0x1b, 0xbc, 0xaa, 0x98, 0x38, 0xda, 0x0d, 0xbd, 0x29, 0x70,
+		0x91, 0x37, 0x47, 0x2c, 0xbb, 0x61, 0x4d, 0x93, 0x11, 0xd5, 0xf5, 0x31, 0x41, 0x9a, 0x8d, 0xcb,
+		0xf9, 0x4d, 0x8d, 0xcf, 0xb7, 0xd1, 0x86, 0xd8, 0x76, 0xe0, 0x03, 0x65, 0xaf, 0xc4, 0x02, 0x5a,
+		0xe4, 0xbe, 0x7d, 0x9e, 0x8d, 0xfd, 0xcf, 0xe9, 0xa1, 0x46, 0x17, 0x68, 0xd0, 0x46, 0x1b, 0xe4,
+		0xa7, 0x0d, 0xfe, 0xb6, 0x46, 0xc0, 0xe6, 0x44, 0x94, 0xad, 0x05, 0xd0, 0x78, 0x24, 0x6f, 0x70,
+		0x5e, 0x24, 0xe3, 0x80, 0x48, 0xa1, 0x27, 0x31, 0x5b, 0x65, 0xce, 0x99, 0x31, 0xe7, 0x6f, 0x98,
+		0x97, 0xd8, 0xe4, 0x93, 0xe5, 0x4f, 0xc4, 0x20, 0xa2, 0xb8, 0xc0, 0xae, 0xe2, 0x91, 0xbf, 0x2c,
+		0x08, 0x91, 0xd4, 0x50, 0xe8, 0x47, 0x4f, 0x27, 0xce, 0x3f, 0x25, 0x1e, 0xe0, 0xc8, 0xb6, 0x1c,
+		0xc4, 0xfc, 0xb8, 0x46, 0x9f, 0xb4, 0x06, 0xd0, 0x34, 0x30, 0xba, 0x2e, 0x37, 0x5d, 0xf7, 0xd9,
+		0x0f, 0x57, 0x38, 0x63, 0x31, 0x77, 0x1f, 0xfe, 0x36, 0xe2, 0x93, 0x9b, 0xf8, 0x7c, 0xde, 0xb3,
+		0x2b, 0x80, 0x41, 0x9a, 0xda, 0xf9, 0x45, 0x46, 0x23, 0x06, 0xb9, 0xf0, 0x6c, 0x46, 0x13, 0x23,
+		0x5d, 0xb9, 0x49, 0x97, 0x39, 0x40, 0x64, 0x0e, 0x10, 0x15, 0x24, 0xae, 0xe1, 0x27, 0x57, 0xc1,
+		0x67, 0xdd, 0x64, 0x2f, 0x20, 0x0b, 0x37, 0xb0, 0x30, 0xa1, 0xfc, 0x19, 0x63, 0x4b, 0x18, 0x16,
+		0x97, 0x4d, 0x11, 0xc0, 0x28, 0xc5, 0xdc, 0x94, 0xe2, 0x67, 0x3b, 0xab, 0xb6, 0xb3, 0x55, 0xa2,
+		0x0f, 0x96, 0x1f, 0x96, 0x73, 0x13, 0x5a, 0x2f, 0x80, 0x46, 0x76, 0xf2, 0x8b, 0x6c, 0xcc, 0xb0,
+		0x6d, 0x41, 0x4a, 0xa5, 0x20, 0x52, 0x0a, 0x5c, 0x68, 0x39, 0xe2, 0x3a, 0x39, 0xe2, 0x46, 0x8e,
+		0x0a, 0xb8, 0xc3, 0xb2, 0x28, 0x05, 0xf9, 0x56, 0x77, 0xe3, 0xc5, 0x21, 0x92, 0xb7, 0xda, 0x52,
+		0x52, 0x4a, 0xe2, 0xf7, 0x54, 0x4a, 0xb1, 0xc6, 0x90, 0x8d, 0x2e, 0xa6, 0x46, 0xb9, 0x42, 0xf1,
+		0x26, 0x13, 0x3c, 0xa4, 0x10, 0xdc, 0x91, 0x80, 0x87, 0x9c, 0x97, 0xd4, 0x96, 0x80, 0x79, 0x59,
+		0x17, 0x88, 0x6d, 0xe5, 0x46, 0xfc, 0xfc, 0x5d, 0x6f, 0x72, 0xf4, 0xf7, 0xed, 0x09, 0x9d, 0x87,
+		0x20, 0x7d, 0x28, 0xc5, 0x1d, 0x44, 0xcc, 0xcb, 0xb4, 0xfd, 0x9b, 0x91, 0xad, 0x1e, 0xce, 0x72,
+		0x20, 0xe6, 0x88, 0xcf, 0xa4, 0x33, 0xe7, 0xe1, 0xe6, 0x94, 0xb3, 0x04, 0x75, 0x02, 0x98, 0xf8,
+		0x4c, 0x7b, 0xf0, 0x47, 0xfb, 0xf2, 0xbc, 0x3d, 0xb8, 0xed, 0xf7, 0xda, 0xed, 0x56, 0x69, 0xae,
+		0xa6, 0x59, 0xac, 0xfa, 0xe7, 0xad, 0x22, 0xe4, 0xed, 0x5f, 0xe7, 0x7f, 0x9e, 0x5f, 0xfc, 0x90,
+		0x0a, 0x83, 0xc7, 0xa8, 0xca, 0xb7, 0x13, 0x2d, 0xb8, 0x5c, 0xce, 0x42, 0xe8, 0xe1, 0xd5, 0x7a,
+		0x16, 0xbc, 0x5c, 0xee, 0x6a, 0x31, 0x19, 0x0f, 0xec, 0x65, 0x7c, 0xa0, 0x9e, 0x49, 0x50, 0x37,
+		0xab, 0x07, 0x7a, 0x44, 0x35, 0x7a, 0x51, 0x62, 0xb3, 0x70, 0xe0, 0x59, 0x89, 0x8c, 0x88, 0x4a,
+		0x50, 0x40, 0x6c, 0xaa, 0xdc, 0xbc, 0xf3, 0x81, 0x96, 0x8c, 0x87, 0x22, 0x1e, 0xaf, 0xf8, 0x56,
+		0x75, 0xad, 0xc3, 0x33, 0xeb, 0x7d, 0x6b, 0x6f, 0xad, 0xdd, 0x60, 0x6f, 0xed, 0xd7, 0x84, 0x70,
+		0x16, 0xfd, 0xbb, 0x2b, 0x28, 0xfc, 0x37, 0x38, 0x2c, 0x6b, 0x74, 0xad, 0xd1, 0xb5, 0x46, 0xd7,
+		0x6e, 0x94, 0xae, 0x2d, 0x6f, 0xe8, 0x09, 0xff, 0x4c, 0xad, 0x94, 0xef, 0x46, 0xf6, 0xac, 0xcf,
+		0x3c, 0x67, 0x1d, 0x14, 0x16, 0xb3, 0xbf, 0x3d, 0x49, 0x9e, 0xea, 0x86, 0x0f, 0x15, 0xb7, 0x14,
+		0xe2, 0x67, 0xcf, 0xaa, 0x7e, 0xb2, 0x00, 0x7e, 0xee, 0xc2, 0xff, 0xdb, 0x96, 0xc6, 0x2a, 0xe0,
+		0x81, 0x12, 0x33, 0x57, 0x7f, 0xef, 0xb9, 0x5a, 0x94, 0x48, 0xe3, 0x92, 0xe4, 0xe7, 0x5a, 0xd3,
+		0x7c, 0xcd, 0xfa, 0xef, 0x79, 0x62, 0xac, 0x05, 0x47, 0x20, 0x70, 0x79, 0x7c, 0x1d, 0xa8, 0xcb,
+		0xe0, 0x6f, 0x5f, 0x14, 0x72, 0x3e, 0x5d, 0xa2, 0x6c, 0x12, 0xe3, 0x50, 0xaa, 0x13, 0x12, 0x83,
+		0x4c, 0x8d, 0xc3, 0x9c, 0x83, 0xca, 0x63, 0xdf, 0x02, 0x4c, 0x94, 0xd9, 0x39, 0xcc, 0x9c, 0xf9,
+		0x5b, 0xfb, 0xcc, 0xdf, 0x84, 0x30, 0xae, 0x98, 0xc7, 0x05, 0xb0, 0x10, 0xf9, 0x42, 0xe2, 0x01,
+		0x84, 0x2d, 0x89, 0xde, 0x68, 0x9b, 0x73, 0x67, 0x5e, 0x6e, 0xe6, 0x4d, 0xca, 0xdd, 0xd4, 0xb2,
+		0x18, 0x1b, 0x3b, 0x88, 0x1c, 0xd2, 0xf0, 0x8b, 0x57, 0xa5, 0x2f, 0x5f, 0xbe, 0x5c, 0x01, 0xeb,
+		0xff, 0x37, 0xac, 0xff, 0x96, 0xad, 0xa3, 0xdb, 0x9b, 0xd4, 0x8f, 0xeb, 0x6b, 0xeb, 0xf6, 0x66,
+		0xfb, 0x67, 0x79, 0x67, 0xbf, 0xf2, 0xb8, 0xfd, 0xaf, 0x25, 0xfc, 0xe6, 0xfa, 0xfa, 0xeb, 0xf6,
+		0xff, 0x5b, 0xe7, 0xa9, 0x7f, 0x6d, 0xff, 0xba, 0xbe, 0xfe, 0x5a, 0x8a, 0xc7, 0xd6, 0x03, 0xb6,
+		0x05, 0x92, 0x2b, 0xa0, 0x8f, 0xc5, 0x7b, 0xf7, 0x17, 0xf0, 0x22, 0x8c, 0xb0, 0x9e, 0xde, 0xca,
+		0xa1, 0xc4, 0xe7, 0xff, 0x5d, 0x95, 0xad, 0x23, 0x60, 0x8d, 0x1a, 0xd6, 0xe9, 0xcd, 0xcf, 0xea,
+		0xe3, 0x97, 0x63, 0xf1, 0xf7, 0xf6, 0xcf, 0xfa, 0xe3, 0x3f, 0x16, 0x9c, 0xc1, 0x60, 0x1c, 0xf9,
+		0x2c, 0x19, 0x0c, 0x52, 0xd0, 0x45, 0xe0, 0x13, 0xf2, 0xad, 0xe5, 0x1b, 0xe7, 0x5c, 0x3a, 0x5a,
+		0xb6, 0xbc, 0x4a, 0x37, 0x9d, 0xee, 0x69, 0x1a, 0x2b, 0x2c, 0xfd, 0xf2, 0x25, 0x64, 0xe2, 0xcd,
+		0xaf, 0xab, 0x8a, 0x75, 0x74, 0x13, 0xff, 0xb7, 0x12, 0xfd, 0x89, 0xff, 0x5f, 0xbd, 0x2a, 0x5b,
+		0x7b, 0xf3, 0xff, 0xd7, 0xaf, 0xca, 0x56, 0xfd, 0x66, 0x3b, 0x94, 0xdd, 0x9f, 0xb5, 0xc7, 0x97,
+		0x3f, 0xb8, 0x9b, 0x7c, 0x6c, 0xfb, 0xd7, 0x97, 0xab, 0x8a, 0x55, 0xbd, 0x99, 0xff, 0xa8, 0x5d,
+		0x95, 0xad, 0xea, 0xcd, 0xf6, 0x76, 0x38, 0x78, 0xe2, 0xdd, 0x79, 0xd3, 0xfd, 0xe7, 0xf7, 0x61,
+		0x2e, 0x08, 0x95, 0x9d, 0xbd, 0xc7, 0xe3, 0xed, 0x9f, 0x07, 0x8f, 0x32, 0xf0, 0x97, 0xae, 0x59,
+		0x65, 0xe7, 0xe0, 0xf1, 0x38, 0x03, 0xb3, 0xff, 0x78, 0x2c, 0xc3, 0xf5, 0x0d, 0xeb, 0x92, 0x2c,
+		0x86, 0x88, 0x10, 0x5e, 0xcd, 0xfa, 0xe6, 0x5e, 0xc6, 0x03, 0xb5, 0xac, 0x07, 0x6a, 0x19, 0x0f,
+		0x64, 0xf6, 0xaa, 0x9a, 0xf1, 0x40, 0xfd, 0xf1, 0x97, 0xd2, 0xfe, 0x8b, 0xbe, 0xe9, 0xfe, 0xe3,
+		0xf6, 0xaf, 0x2c, 0xdc, 0xc1, 0xe3, 0xaf, 0xe3, 0xed, 0xed, 0xdd, 0x2f, 0x95, 0x70, 0x9c, 0x0f,
+		0xe3, 0xa1, 0xaf, 0xdc, 0x28, 0x12, 0x11, 0xfd, 0x1b, 0x8d, 0xab, 0x32, 0x2d, 0xc7, 0x80, 0xc3,
+		0x7b, 0x30, 0xcb, 0x9a, 0x96, 0x73, 0x74, 0x41, 0xa6, 0xa5, 0x46, 0x7b, 0xad, 0x98, 0x97, 0xcf,
+		0xd1, 0x75, 0xef, 0x39, 0x31, 0xb5, 0x13, 0xef, 0xf9, 0x44, 0xbe, 0xcb, 0xcc, 0xfb, 0x65, 0xa6,
+		0x5e, 0x7a, 0xea, 0x25, 0x73, 0x2a, 0xa7, 0xf5, 0x4e, 0xb4, 0xa2, 0x29, 0x7a, 0xa9, 0x5b, 0xb3,
+		0x80, 0x30, 0x0b, 0x08, 0xb3, 0x80, 0x30, 0x0b, 0x08, 0xb3, 0x80, 0x30, 0x0b, 0x08, 0xb3, 0x80,
+		0x30, 0x0b, 0x08, 0xb3, 0x80, 0x30, 0x0b, 0x08, 0xb3, 0x80, 0x78, 0xcf, 0x05, 0x44, 0xbe, 0x1b,
+		0x6d, 0x96, 0x79, 0x96, 0xa7, 0x12, 0x81, 0xfd, 0xb8, 0x65, 0x4e, 0x1f, 0x7e, 0xc6, 0x17, 0xf5,
+		0x9f, 0x42, 0xac, 0x49, 0x3c, 0x3f, 0x94, 0x79, 0xe8, 0xf4, 0xa3, 0xcf, 0x2e, 0x55, 0x02, 0x62,
+		0xa7, 0xe0, 0x0e, 0x5e, 0x12, 0x92, 0x52, 0x13, 0x12, 0x69, 0xea, 0x77, 0x5b, 0x70, 0x8a, 0xec,
+		0x90, 0xa1, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x40, 0x5d, 0x61, 0xe3, 0xe2, 0x99,
+		0x01, 0x00,
 	}
 )
 
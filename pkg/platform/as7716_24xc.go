@@ -0,0 +1,160 @@
+package platform
+
+import (
+	"fmt"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+const (
+	AS7716_24XC_PORT_NUM           = 16
+	AS7716_24XC_OPTICAL_MODULE_NUM = 8
+)
+
+// OF-DPA logical ports of the front panel ports (1-16)
+// and the ethernet ports connected to the optical modules (17-32)
+var as7716ASICPortMap = map[int]int{
+	1:  68,
+	2:  72,
+	3:  76,
+	4:  80,
+	5:  96,
+	6:  106,
+	7:  110,
+	8:  114,
+	9:  118,
+	10: 122,
+	11: 126,
+	12: 130,
+	13: 84,
+	14: 88,
+	15: 92,
+	16: 102,
+	17: 38,
+	18: 34,
+	19: 46,
+	20: 42,
+	21: 54,
+	22: 50,
+	23: 62,
+	24: 58,
+	25: 5,
+	26: 1,
+	27: 13,
+	28: 9,
+	29: 21,
+	30: 17,
+	31: 25,
+	32: 29,
+}
+
+const as7716OFDPAConfigHeader = `#
+# ofdpa configuration for as7716-24xc
+#
+#
+# port_mode_<logic-port>=1x100g (default) | 1x40g | 2x50g | 2x40g | 2x20g | 4x25g | 4x10g
+#
+# the last 8 ports (CFP2 ports) can be 200g ports by using the following config
+#    port_mode_<logic-port>=2x100g
+#    e.g. port_mode_38=2x100g
+#
+#
+# adding if=XXX after port_mod_XX=XXXg can config interface type (note: ONE space between them)
+#        default setting is SR4 for 100g ports at initial stage
+#        valid interface type: CR, CR4, SR, SR4, LR, LR4, KR, KR4, SFI, XFI,...
+# e.g.
+# port_mode_68=1x100g if=CR4
+#
+`
+
+var as7716Breakouts = []Breakout{
+	{
+		NumChannels: 1,
+		ChannelSpeeds: []model.E_OpenconfigIfEthernet_ETHERNET_SPEED{
+			model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB,
+			model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB,
+		},
+	},
+	{
+		NumChannels: 2,
+		ChannelSpeeds: []model.E_OpenconfigIfEthernet_ETHERNET_SPEED{
+			model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_20GB,
+			model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB,
+			model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB,
+		},
+	},
+	{
+		NumChannels: 4,
+		ChannelSpeeds: []model.E_OpenconfigIfEthernet_ETHERNET_SPEED{
+			model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB,
+			model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB,
+		},
+	},
+}
+
+// modulation formats of the optical modules.
+// each channel is connected to a 100G ethernet port of the ASIC.
+// a module carries 100G with DP-QPSK, 150G with DP-8QAM and 200G with DP-16QAM
+var as7716Modulations = []Modulation{
+	{
+		Type:       model.PacketTransport_OpticalModulationType_DP_QPSK,
+		ConfigName: "dp-qpsk",
+		Capacity:   map[string]int{"A": 100000},
+	},
+	{
+		Type:       model.PacketTransport_OpticalModulationType_DP_16QAM,
+		ConfigName: "dp-16qam",
+		Capacity:   map[string]int{"A": 100000, "B": 100000},
+	},
+	{
+		Type:       model.PacketTransport_OpticalModulationType_DP_8QAM,
+		ConfigName: "dp-8qam",
+		Capacity:   map[string]int{"A": 100000, "B": 50000},
+	},
+}
+
+func newAS7716() *Platform {
+	p := &Platform{
+		Name:              "as7716-24xc",
+		HWSKU:             "AS7716-24XC",
+		OFDPAConfigHeader: as7716OFDPAConfigHeader,
+	}
+	for i := 1; i <= AS7716_24XC_PORT_NUM; i++ {
+		// lanes 33-96 are virtual lanes used by broken out ports
+		lanes := make([]int, 0, 4)
+		for j := 1; j <= 4; j++ {
+			lanes = append(lanes, 32+4*(i-1)+j)
+		}
+		p.Ports = append(p.Ports, &Port{
+			Name:          fmt.Sprintf("Port%d", i),
+			Interface:     fmt.Sprintf("Ethernet%d", i),
+			Lane:          i,
+			BreakoutLanes: lanes,
+			ASICPort:      as7716ASICPortMap[i],
+			Breakouts:     as7716Breakouts,
+		})
+	}
+	for i := 1; i <= AS7716_24XC_OPTICAL_MODULE_NUM; i++ {
+		m := &OpticalModule{
+			Name:              fmt.Sprintf("Opt%d", i),
+			Index:             i - 1,
+			Modulations:       as7716Modulations,
+			DefaultModulation: model.PacketTransport_OpticalModulationType_DP_16QAM,
+		}
+		for j, ch := range []string{"A", "B"} {
+			index := AS7716_24XC_PORT_NUM + 2*i - 1 + j
+			m.Channels = append(m.Channels, &Channel{
+				Name:      ch,
+				Interface: fmt.Sprintf("Ethernet%d", index),
+				Lane:      index,
+				ASICPort:  as7716ASICPortMap[index],
+			})
+		}
+		p.OpticalModules = append(p.OpticalModules, m)
+	}
+	return p
+}
+
+func init() {
+	Register(newAS7716())
+}
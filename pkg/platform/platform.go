@@ -0,0 +1,290 @@
+package platform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+const (
+	DEFAULT_PLATFORM = "as7716-24xc"
+)
+
+// Breakout is a breakout mode supported by a front panel port
+type Breakout struct {
+	NumChannels   uint8
+	ChannelSpeeds []model.E_OpenconfigIfEthernet_ETHERNET_SPEED
+}
+
+// Port is a front panel port.
+// a port which isn't broken out is Interface, otherwise Interface_1..NumChannels
+type Port struct {
+	Name      string
+	Interface string
+	// SONiC lane used when the port isn't broken out
+	Lane int
+	// SONiC lanes used when the port is broken out
+	BreakoutLanes []int
+	// OF-DPA logical port
+	ASICPort  int
+	Breakouts []Breakout
+}
+
+// Channel is a line side channel of an optical module
+// which is connected to the ASIC as an internal ethernet port
+type Channel struct {
+	Name      string
+	Interface string
+	Lane      int
+	ASICPort  int
+}
+
+// Modulation is a modulation format supported by an optical module
+type Modulation struct {
+	Type model.E_PacketTransport_OpticalModulationType
+	// modulation-type of MODULE_CONFIG_TABLE
+	ConfigName string
+	// line rate capacity of the channels in Mbps. channels which aren't listed have no capacity
+	Capacity map[string]int
+}
+
+type OpticalModule struct {
+	Name string
+	// index of the module used by transyncd
+	Index    int
+	Channels []*Channel
+	// modulation formats supported by the type of the module
+	Modulations       []Modulation
+	DefaultModulation model.E_PacketTransport_OpticalModulationType
+}
+
+type Platform struct {
+	Name           string
+	HWSKU          string
+	Ports          []*Port
+	OpticalModules []*OpticalModule
+	// header of ofdpa.conf
+	OFDPAConfigHeader string
+}
+
+var platforms = map[string]*Platform{}
+
+var current *Platform
+
+// Register makes a platform available by its name.
+// this is supposed to be called from init() of each platform file
+func Register(p *Platform) {
+	if _, ok := platforms[p.Name]; ok {
+		panic(fmt.Sprintf("platform %s is already registered", p.Name))
+	}
+	platforms[p.Name] = p
+}
+
+func Names() []string {
+	names := make([]string, 0, len(platforms))
+	for k := range platforms {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func Get(name string) (*Platform, error) {
+	p, ok := platforms[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown platform %s, supported platforms: %s", name, strings.Join(Names(), ", "))
+	}
+	return p, nil
+}
+
+// Select sets the platform returned by Current
+func Select(name string) error {
+	p, err := Get(name)
+	if err != nil {
+		return err
+	}
+	current = p
+	return nil
+}
+
+// Current returns the selected platform.
+// the default platform is used when no platform is selected
+func Current() *Platform {
+	if current == nil {
+		return platforms[DEFAULT_PLATFORM]
+	}
+	return current
+}
+
+func (p *Platform) Port(name string) (*Port, error) {
+	for _, port := range p.Ports {
+		if port.Name == name {
+			return port, nil
+		}
+	}
+	return nil, fmt.Errorf("port %s doesn't exist on %s", name, p.Name)
+}
+
+// PortOf returns the front panel port of the interface and its sub port index.
+// the sub port index is 0 when the port isn't broken out
+func (p *Platform) PortOf(intf string) (*Port, int, error) {
+	elems := strings.Split(intf, "_")
+	if len(elems) > 2 {
+		return nil, 0, fmt.Errorf("invalid interface name: %s", intf)
+	}
+	for _, port := range p.Ports {
+		if port.Interface != elems[0] {
+			continue
+		}
+		if len(elems) == 1 {
+			return port, 0, nil
+		}
+		var subIndex int
+		if _, err := fmt.Sscanf(elems[1], "%d", &subIndex); err != nil || subIndex < 1 {
+			return nil, 0, fmt.Errorf("invalid interface name: %s", intf)
+		}
+		return port, subIndex, nil
+	}
+	return nil, 0, fmt.Errorf("interface %s doesn't exist on %s", intf, p.Name)
+}
+
+func (p *Platform) OpticalModule(name string) (*OpticalModule, error) {
+	for _, m := range p.OpticalModules {
+		if m.Name == name {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("optical module %s doesn't exist on %s", name, p.Name)
+}
+
+func (m *OpticalModule) Channel(name string) (*Channel, error) {
+	for _, c := range m.Channels {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("channel %s doesn't exist on %s", name, m.Name)
+}
+
+func (m *OpticalModule) ChannelNames() []string {
+	names := make([]string, 0, len(m.Channels))
+	for _, c := range m.Channels {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// ChannelNames returns the channels of any optical module of the registered platforms
+func ChannelNames() []string {
+	channels := map[string]bool{}
+	for _, p := range platforms {
+		for _, m := range p.OpticalModules {
+			for _, c := range m.Channels {
+				channels[c.Name] = true
+			}
+		}
+	}
+	names := make([]string, 0, len(channels))
+	for name := range channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func modulationName(t model.E_PacketTransport_OpticalModulationType) string {
+	if e, ok := model.ΛEnum["E_PacketTransport_OpticalModulationType"][int64(t)]; ok {
+		return e.Name
+	}
+	return fmt.Sprintf("%d", t)
+}
+
+func (m *OpticalModule) Modulation(t model.E_PacketTransport_OpticalModulationType) (*Modulation, error) {
+	for i, mod := range m.Modulations {
+		if mod.Type == t {
+			return &m.Modulations[i], nil
+		}
+	}
+	return nil, fmt.Errorf("modulation-type %s is not supported by %s, supported: %s", modulationName(t), m.Name, strings.Join(m.ModulationNames(), ", "))
+}
+
+func (m *OpticalModule) ModulationNames() []string {
+	names := make([]string, 0, len(m.Modulations))
+	for _, mod := range m.Modulations {
+		names = append(names, modulationName(mod.Type))
+	}
+	return names
+}
+
+// ModulationNames returns the modulation formats supported by any optical module of the registered platforms
+func ModulationNames() []string {
+	types := map[model.E_PacketTransport_OpticalModulationType]bool{}
+	for _, p := range platforms {
+		for _, m := range p.OpticalModules {
+			for _, mod := range m.Modulations {
+				types[mod.Type] = true
+			}
+		}
+	}
+	sorted := make([]model.E_PacketTransport_OpticalModulationType, 0, len(types))
+	for t := range types {
+		sorted = append(sorted, t)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	names := make([]string, 0, len(sorted))
+	for _, t := range sorted {
+		names = append(names, modulationName(t))
+	}
+	return names
+}
+
+func (p *Port) Breakout(numChannels uint8) (*Breakout, error) {
+	for i, b := range p.Breakouts {
+		if b.NumChannels == numChannels {
+			return &p.Breakouts[i], nil
+		}
+	}
+	return nil, fmt.Errorf("num-channels %d is not supported by port %s", numChannels, p.Name)
+}
+
+func (b *Breakout) Supports(speed model.E_OpenconfigIfEthernet_ETHERNET_SPEED) bool {
+	for _, s := range b.ChannelSpeeds {
+		if s == speed {
+			return true
+		}
+	}
+	return false
+}
+
+// InterfaceNames returns the interfaces created on the port
+func (p *Port) InterfaceNames(numChannels int) []string {
+	if numChannels == 1 {
+		return []string{p.Interface}
+	}
+	names := make([]string, 0, numChannels)
+	for i := 1; i <= numChannels; i++ {
+		names = append(names, fmt.Sprintf("%s_%d", p.Interface, i))
+	}
+	return names
+}
+
+// Lanes returns the SONiC lanes of the interface.
+// each interface of a broken out port uses len(BreakoutLanes)/numChannels lanes
+func (p *Port) Lanes(subIndex, numChannels int) ([]int, error) {
+	if subIndex == 0 {
+		if numChannels != 1 {
+			return nil, fmt.Errorf("%s is broken out into %d", p.Name, numChannels)
+		}
+		return []int{p.Lane}, nil
+	}
+	if numChannels < 2 || len(p.BreakoutLanes)%numChannels != 0 {
+		return nil, fmt.Errorf("invalid num-channels %d for %s_%d", numChannels, p.Interface, subIndex)
+	}
+	if subIndex > numChannels {
+		return nil, fmt.Errorf("invalid sub port %s_%d for num-channels %d", p.Interface, subIndex, numChannels)
+	}
+	width := len(p.BreakoutLanes) / numChannels
+	return p.BreakoutLanes[width*(subIndex-1) : width*subIndex], nil
+}
@@ -0,0 +1,89 @@
+package platform
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+func TestPortOf(t *testing.T) {
+	p := Current()
+	for _, c := range []struct {
+		intf     string
+		port     string
+		subIndex int
+	}{
+		{"Ethernet1", "Port1", 0},
+		{"Ethernet16", "Port16", 0},
+		{"Ethernet3_2", "Port3", 2},
+	} {
+		port, subIndex, err := p.PortOf(c.intf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if port.Name != c.port || subIndex != c.subIndex {
+			t.Errorf("%s: got %s/%d, expected %s/%d", c.intf, port.Name, subIndex, c.port, c.subIndex)
+		}
+	}
+	for _, intf := range []string{"Ethernet17", "Ethernet1_a", "Ethernet1_1_1", "Port1"} {
+		if _, _, err := p.PortOf(intf); err == nil {
+			t.Errorf("expected error for %s", intf)
+		}
+	}
+}
+
+func TestOpticalModuleChannel(t *testing.T) {
+	p := Current()
+	for _, c := range []struct {
+		module string
+		ch     string
+		intf   string
+	}{
+		{"Opt1", "A", "Ethernet17"},
+		{"Opt1", "B", "Ethernet18"},
+		{"Opt8", "B", "Ethernet32"},
+	} {
+		m, err := p.OpticalModule(c.module)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ch, err := m.Channel(c.ch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ch.Interface != c.intf {
+			t.Errorf("%s.%s: got %s, expected %s", c.module, c.ch, ch.Interface, c.intf)
+		}
+	}
+	if names := ChannelNames(); !reflect.DeepEqual(names, []string{"A", "B"}) {
+		t.Errorf("unexpected channel names: %v", names)
+	}
+}
+
+func TestOpticalModuleModulation(t *testing.T) {
+	m, err := Current().OpticalModule("Opt1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := m.Modulation(model.PacketTransport_OpticalModulationType_DP_QPSK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mod.ConfigName != "dp-qpsk" || mod.Capacity["A"] != 100000 || mod.Capacity["B"] != 0 {
+		t.Errorf("unexpected DP_QPSK: %v", mod)
+	}
+	mod, err = m.Modulation(model.PacketTransport_OpticalModulationType_DP_8QAM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mod.ConfigName != "dp-8qam" || mod.Capacity["A"] != 100000 || mod.Capacity["B"] != 50000 {
+		t.Errorf("unexpected DP_8QAM: %v", mod)
+	}
+	if _, err = m.Modulation(model.PacketTransport_OpticalModulationType_DP_16QAM_200G); err == nil {
+		t.Error("expected error for an unsupported modulation")
+	}
+	if names := ModulationNames(); !reflect.DeepEqual(names, []string{"DP_QPSK", "DP_16QAM", "DP_8QAM"}) {
+		t.Errorf("unexpected modulation names: %v", names)
+	}
+}
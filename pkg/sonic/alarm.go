@@ -0,0 +1,396 @@
+package sonic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+const (
+	ALARM_FILE = "alarms.json"
+	// DEFAULT_ALARM_INTERVAL is the interval AlarmManager evaluates the state
+	DEFAULT_ALARM_INTERVAL = 10 * time.Second
+	// DEFAULT_CLEARED_ALARM_SIZE is the number of the cleared alarms AlarmManager keeps
+	DEFAULT_CLEARED_ALARM_SIZE = 100
+	// DEFAULT_SD_FEC_BER_RISING_SAMPLES is used when alarm-thresholds/sd-fec-ber-rising-samples is not set
+	DEFAULT_SD_FEC_BER_RISING_SAMPLES = 3
+	// ALARM_SUBSCRIPTION_BUFFER is the number of the alarms a subscriber can fall behind
+	ALARM_SUBSCRIPTION_BUFFER = 100
+)
+
+// severities of openconfig-alarms
+const (
+	ALARM_SEVERITY_CRITICAL = "CRITICAL"
+	ALARM_SEVERITY_MAJOR    = "MAJOR"
+	ALARM_SEVERITY_MINOR    = "MINOR"
+	ALARM_SEVERITY_WARNING  = "WARNING"
+)
+
+// type ids of the alarms AlarmManager raises
+const (
+	ALARM_POST_FEC_BER      = "POST_FEC_BER"
+	ALARM_SD_FEC_BER_RISING = "SD_FEC_BER_RISING"
+	ALARM_SYNC_ERROR        = "SYNC_ERROR"
+	ALARM_OPERATION_STATUS  = "OPERATION_STATUS"
+	ALARM_CLIENT_OPER_DOWN  = "CLIENT_OPER_DOWN"
+)
+
+// Alarm is an alarm raised by AlarmManager. the fields follow openconfig-alarms
+type Alarm struct {
+	ID string `json:"id"`
+	// the optical module, the channel of the optical module (<module>/<channel>) or the interface
+	Resource    string    `json:"resource"`
+	TypeID      string    `json:"type-id"`
+	Severity    string    `json:"severity"`
+	Text        string    `json:"text"`
+	TimeCreated time.Time `json:"time-created"`
+	// nil while the alarm is active
+	TimeCleared *time.Time `json:"time-cleared,omitempty"`
+}
+
+func (a Alarm) Active() bool {
+	return a.TimeCleared == nil
+}
+
+// AlarmThresholds are the thresholds of the alarms of an optical module
+type AlarmThresholds struct {
+	PostFecBer            float64
+	SdFecBerRisingSamples int
+}
+
+// OpticalModuleAlarmThresholds returns the thresholds configured for the optical module filling the defaults
+func OpticalModuleAlarmThresholds(t *model.PacketTransponder_OpticalModule) (AlarmThresholds, error) {
+	th := AlarmThresholds{SdFecBerRisingSamples: DEFAULT_SD_FEC_BER_RISING_SAMPLES}
+	c := t.AlarmThresholds
+	if c == nil {
+		return th, nil
+	}
+	if c.PostFecBer != nil {
+		if *c.PostFecBer < 0 {
+			return th, fmt.Errorf("invalid post-fec-ber threshold: %g", *c.PostFecBer)
+		}
+		th.PostFecBer = *c.PostFecBer
+	}
+	if c.SdFecBerRisingSamples != nil {
+		th.SdFecBerRisingSamples = int(*c.SdFecBerRisingSamples)
+	}
+	return th, nil
+}
+
+// SampleAlarmState returns a copy of the config filled with the state the alarms are evaluated on
+func SampleAlarmState(config *model.PacketTransponder) (*model.PacketTransponder, error) {
+	c, err := ygot.DeepCopy(config)
+	if err != nil {
+		return nil, err
+	}
+	t := c.(*model.PacketTransponder)
+	for name, module := range t.OpticalModule {
+		if err = FillTransportState(name, module); err != nil {
+			return nil, err
+		}
+	}
+	for name, intf := range t.Interface {
+		if c := intf.OpticalModuleConnection; c == nil || c.OpticalModule == nil || c.OpticalModule.Name == nil {
+			continue
+		}
+		if err = FillInterfaceState(name, intf); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+type alarmCondition struct {
+	resource string
+	typeID   string
+	severity string
+	text     string
+}
+
+func (c alarmCondition) id() string {
+	return fmt.Sprintf("%s:%s", c.resource, c.typeID)
+}
+
+// AlarmManager raises and clears the alarms by evaluating the state
+// and keeps them in memory and in a JSON file
+type AlarmManager struct {
+	sync.Mutex
+	path        string
+	clearedSize int
+	active      map[string]Alarm
+	cleared     []Alarm
+	// optical modules which have reached STATE_READY
+	ready map[string]bool
+	// the latest SD-FEC BER samples of the channels
+	sdFecBer    map[string][]float64
+	subscribers map[chan Alarm]bool
+}
+
+// NewAlarmManager loads the alarms kept in path. empty path keeps the alarms only in memory
+func NewAlarmManager(path string, clearedSize int) (*AlarmManager, error) {
+	if clearedSize < 0 {
+		return nil, fmt.Errorf("invalid cleared alarm size: %d", clearedSize)
+	}
+	m := &AlarmManager{
+		path:        path,
+		clearedSize: clearedSize,
+		active:      map[string]Alarm{},
+		cleared:     []Alarm{},
+		ready:       map[string]bool{},
+		sdFecBer:    map[string][]float64{},
+		subscribers: map[chan Alarm]bool{},
+	}
+	if path == "" {
+		return m, nil
+	}
+	alarms, err := LoadAlarms(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range alarms {
+		if !a.Active() {
+			m.cleared = append(m.cleared, a)
+			continue
+		}
+		m.active[a.ID] = a
+		// the module left STATE_READY before the restart
+		if a.TypeID == ALARM_OPERATION_STATUS {
+			m.ready[a.Resource] = true
+		}
+	}
+	m.trimCleared()
+	return m, nil
+}
+
+// LoadAlarms reads the alarms kept by AlarmManager in path
+func LoadAlarms(path string) ([]Alarm, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Alarm{}, nil
+		}
+		return nil, err
+	}
+	alarms := []Alarm{}
+	if err = json.Unmarshal(data, &alarms); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return alarms, nil
+}
+
+func (m *AlarmManager) trimCleared() {
+	if len(m.cleared) > m.clearedSize {
+		m.cleared = append([]Alarm(nil), m.cleared[len(m.cleared)-m.clearedSize:]...)
+	}
+}
+
+func (m *AlarmManager) alarms(includeCleared bool) []Alarm {
+	alarms := make([]Alarm, 0, len(m.active))
+	for _, a := range m.active {
+		alarms = append(alarms, a)
+	}
+	sort.Slice(alarms, func(i, j int) bool {
+		if !alarms[i].TimeCreated.Equal(alarms[j].TimeCreated) {
+			return alarms[i].TimeCreated.Before(alarms[j].TimeCreated)
+		}
+		return alarms[i].ID < alarms[j].ID
+	})
+	if includeCleared {
+		alarms = append(alarms, m.cleared...)
+	}
+	return alarms
+}
+
+// Alarms returns the active alarms in the order they were raised
+// followed by the cleared alarms in the order they were cleared
+func (m *AlarmManager) Alarms(includeCleared bool) []Alarm {
+	m.Lock()
+	defer m.Unlock()
+	return m.alarms(includeCleared)
+}
+
+// Subscribe returns a channel receiving the alarms raised or cleared after the call
+// and the function to stop the subscription
+func (m *AlarmManager) Subscribe() (<-chan Alarm, func()) {
+	ch := make(chan Alarm, ALARM_SUBSCRIPTION_BUFFER)
+	m.Lock()
+	m.subscribers[ch] = true
+	m.Unlock()
+	return ch, func() {
+		m.Lock()
+		defer m.Unlock()
+		if m.subscribers[ch] {
+			delete(m.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (m *AlarmManager) notify(a Alarm) {
+	for ch := range m.subscribers {
+		// slow subscribers lose alarms instead of blocking the evaluation
+		select {
+		case ch <- a:
+		default:
+		}
+	}
+}
+
+func (m *AlarmManager) save() error {
+	if m.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(m.path), 0755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(m.alarms(true), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := m.path + ".tmp"
+	if err = ioutil.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, m.path)
+}
+
+func parseBer(s *string) (float64, bool) {
+	if s == nil {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(*s, 64)
+	return v, err == nil
+}
+
+// rising returns true when each sample is greater than the previous one
+func rising(samples []float64) bool {
+	for i := 1; i < len(samples); i++ {
+		if samples[i] <= samples[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+// conditions returns the alarm conditions of the state and updates the samples kept for the trends
+func (m *AlarmManager) conditions(t *model.PacketTransponder) ([]alarmCondition, error) {
+	conds := []alarmCondition{}
+	sdFecBer := map[string][]float64{}
+	ready := map[string]bool{}
+	for name, module := range t.OpticalModule {
+		if module.Enabled != nil && !*module.Enabled {
+			continue
+		}
+		th, err := OpticalModuleAlarmThresholds(module)
+		if err != nil {
+			return nil, fmt.Errorf("optical module %s: %v", name, err)
+		}
+		for ch, s := range module.ChannelStats {
+			resource := fmt.Sprintf("%s/%s", name, ch)
+			if v, ok := parseBer(s.PostFecBer); ok && v > th.PostFecBer {
+				conds = append(conds, alarmCondition{resource, ALARM_POST_FEC_BER, ALARM_SEVERITY_CRITICAL,
+					fmt.Sprintf("post-FEC BER %s exceeds %g", *s.PostFecBer, th.PostFecBer)})
+			}
+			v, ok := parseBer(s.SdFecBer)
+			if !ok || th.SdFecBerRisingSamples <= 0 {
+				continue
+			}
+			samples := append(m.sdFecBer[resource], v)
+			if len(samples) > th.SdFecBerRisingSamples+1 {
+				samples = samples[len(samples)-th.SdFecBerRisingSamples-1:]
+			}
+			sdFecBer[resource] = samples
+			if len(samples) == th.SdFecBerRisingSamples+1 && rising(samples) {
+				conds = append(conds, alarmCondition{resource, ALARM_SD_FEC_BER_RISING, ALARM_SEVERITY_MINOR,
+					fmt.Sprintf("SD-FEC BER rose in %d consecutive samples to %s", th.SdFecBerRisingSamples, *s.SdFecBer)})
+			}
+		}
+		if module.SyncError != nil && *module.SyncError {
+			conds = append(conds, alarmCondition{name, ALARM_SYNC_ERROR, ALARM_SEVERITY_MAJOR, "sync error"})
+		}
+		// not to raise the alarm while booting, it is raised only after the module has been ready
+		switch status := module.OperationStatus; {
+		case status == model.PacketTransport_OpticalModuleStatusType_STATE_READY:
+			ready[name] = true
+		case m.ready[name]:
+			ready[name] = true
+			if status != model.PacketTransport_OpticalModuleStatusType_UNSET {
+				conds = append(conds, alarmCondition{name, ALARM_OPERATION_STATUS, ALARM_SEVERITY_MAJOR,
+					fmt.Sprintf("operation status left STATE_READY: %s", model.ΛEnum["E_PacketTransport_OpticalModuleStatusType"][int64(status)].Name)})
+			}
+		}
+	}
+	for name, intf := range t.Interface {
+		c := intf.OpticalModuleConnection
+		if c == nil || c.OpticalModule == nil || c.OpticalModule.Name == nil {
+			continue
+		}
+		if (intf.Enabled != nil && !*intf.Enabled) || intf.AdminStatus == model.OpenconfigInterfaces_Interface_AdminStatus_DOWN {
+			continue
+		}
+		if intf.OperStatus == model.OpenconfigInterfaces_Interface_OperStatus_DOWN {
+			conds = append(conds, alarmCondition{name, ALARM_CLIENT_OPER_DOWN, ALARM_SEVERITY_MAJOR,
+				fmt.Sprintf("oper-status of the client of %s is down", *c.OpticalModule.Name)})
+		}
+	}
+	m.sdFecBer = sdFecBer
+	m.ready = ready
+	return conds, nil
+}
+
+// Evaluate raises the alarms whose conditions are met in the state
+// and clears the active alarms whose conditions are no longer met
+func (m *AlarmManager) Evaluate(t *model.PacketTransponder, now time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+	conds, err := m.conditions(t)
+	if err != nil {
+		return err
+	}
+	changed := false
+	met := make(map[string]bool, len(conds))
+	for _, c := range conds {
+		id := c.id()
+		met[id] = true
+		if _, ok := m.active[id]; ok {
+			continue
+		}
+		a := Alarm{
+			ID:          id,
+			Resource:    c.resource,
+			TypeID:      c.typeID,
+			Severity:    c.severity,
+			Text:        c.text,
+			TimeCreated: now,
+		}
+		m.active[id] = a
+		m.notify(a)
+		changed = true
+	}
+	for _, a := range m.alarms(false) {
+		if met[a.ID] {
+			continue
+		}
+		cleared := now
+		a.TimeCleared = &cleared
+		delete(m.active, a.ID)
+		m.cleared = append(m.cleared, a)
+		m.notify(a)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	m.trimCleared()
+	return m.save()
+}
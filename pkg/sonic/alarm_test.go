@@ -0,0 +1,138 @@
+package sonic
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+func alarmTestState(status model.E_PacketTransport_OpticalModuleStatusType, postFecBer, sdFecBer string) *model.PacketTransponder {
+	return &model.PacketTransponder{
+		OpticalModule: map[string]*model.PacketTransponder_OpticalModule{
+			"Opt1": {
+				Name:            ygot.String("Opt1"),
+				OperationStatus: status,
+				SyncError:       ygot.Bool(false),
+				ChannelStats: map[string]*model.PacketTransponder_OpticalModule_ChannelStats{
+					"A": {Name: ygot.String("A"), PostFecBer: ygot.String(postFecBer), SdFecBer: ygot.String(sdFecBer)},
+				},
+			},
+		},
+	}
+}
+
+func activeAlarmIDs(m *AlarmManager) []string {
+	ids := []string{}
+	for _, a := range m.Alarms(false) {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+func TestAlarmManager(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ALARM_FILE)
+	m, err := NewAlarmManager(path, DEFAULT_CLEARED_ALARM_SIZE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, cancel := m.Subscribe()
+	defer cancel()
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ready := model.PacketTransport_OpticalModuleStatusType_STATE_READY
+	down := model.PacketTransport_OpticalModuleStatusType_STATE_DOWN
+
+	// booting modules don't raise the operation status alarm
+	if err = m.Evaluate(alarmTestState(down, "0", "1e-5"), base); err != nil {
+		t.Fatal(err)
+	}
+	if ids := activeAlarmIDs(m); len(ids) != 0 {
+		t.Errorf("unexpected alarms while booting: %v", ids)
+	}
+
+	for i, s := range []*model.PacketTransponder{
+		alarmTestState(ready, "0", "2e-5"),
+		alarmTestState(ready, "0", "3e-5"),
+		alarmTestState(ready, "1e-9", "4e-5"),
+	} {
+		if err = m.Evaluate(s, base.Add(time.Duration(i+1)*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := []string{"Opt1/A:POST_FEC_BER", "Opt1/A:SD_FEC_BER_RISING"}
+	if ids := activeAlarmIDs(m); !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+	if a := <-ch; a.ID != "Opt1/A:POST_FEC_BER" || a.Severity != ALARM_SEVERITY_CRITICAL || !a.Active() {
+		t.Errorf("unexpected notification: %v", a)
+	}
+
+	// the module leaves STATE_READY and BER stops rising
+	if err = m.Evaluate(alarmTestState(down, "0", "4e-5"), base.Add(4*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if ids := activeAlarmIDs(m); !reflect.DeepEqual(ids, []string{"Opt1:OPERATION_STATUS"}) {
+		t.Errorf("unexpected alarms after leaving STATE_READY: %v", ids)
+	}
+	all := m.Alarms(true)
+	if len(all) != 3 || all[1].Active() || !all[1].TimeCleared.Equal(base.Add(4*time.Minute)) {
+		t.Errorf("unexpected cleared alarms: %v", all)
+	}
+
+	// the alarms are reloaded and the module is still regarded as it was ready
+	m, err = NewAlarmManager(path, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all = m.Alarms(true); len(all) != 2 || all[0].ID != "Opt1:OPERATION_STATUS" {
+		t.Errorf("unexpected reloaded alarms: %v", all)
+	}
+	if err = m.Evaluate(alarmTestState(down, "0", "4e-5"), base.Add(5*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if ids := activeAlarmIDs(m); !reflect.DeepEqual(ids, []string{"Opt1:OPERATION_STATUS"}) {
+		t.Errorf("operation status alarm is cleared after reload: %v", ids)
+	}
+}
+
+func TestAlarmThresholds(t *testing.T) {
+	m, err := NewAlarmManager("", DEFAULT_CLEARED_ALARM_SIZE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := alarmTestState(model.PacketTransport_OpticalModuleStatusType_STATE_READY, "1e-9", "1e-5")
+	s.OpticalModule["Opt1"].AlarmThresholds = &model.PacketTransponder_OpticalModule_AlarmThresholds{
+		PostFecBer:            ygot.Float64(1e-6),
+		SdFecBerRisingSamples: ygot.Uint32(0),
+	}
+	s.OpticalModule["Opt1"].SyncError = ygot.Bool(true)
+	s.Interface = map[string]*model.PacketTransponder_Interface{
+		"Ethernet1": {
+			Name:       ygot.String("Ethernet1"),
+			OperStatus: model.OpenconfigInterfaces_Interface_OperStatus_DOWN,
+			OpticalModuleConnection: &model.PacketTransponder_Interface_OpticalModuleConnection{
+				OpticalModule: &model.PacketTransponder_Interface_OpticalModuleConnection_OpticalModule{Name: ygot.String("Opt1")},
+			},
+		},
+		"Ethernet2": {
+			Name:       ygot.String("Ethernet2"),
+			OperStatus: model.OpenconfigInterfaces_Interface_OperStatus_DOWN,
+		},
+	}
+	if err = m.Evaluate(s, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"Ethernet1:CLIENT_OPER_DOWN", "Opt1:SYNC_ERROR"}
+	if ids := activeAlarmIDs(m); !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+
+	s.OpticalModule["Opt1"].AlarmThresholds.PostFecBer = ygot.Float64(-1)
+	if err = m.Evaluate(s, time.Now()); err == nil {
+		t.Error("invalid threshold is accepted")
+	}
+}
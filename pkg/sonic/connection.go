@@ -0,0 +1,251 @@
+package sonic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+const (
+	DEFAULT_DATABASE_CONFIG_FILE = "/var/run/redis/sonic-db/database_config.json"
+	DEFAULT_DB_INSTANCE          = "redis"
+	// DEFAULT_DB_TIMEOUT bounds dialing and each command sent to redis
+	DEFAULT_DB_TIMEOUT = 5 * time.Second
+	// DEFAULT_CONNECT_TIMEOUT bounds waiting for redis to come up in GetClient
+	DEFAULT_CONNECT_TIMEOUT = 30 * time.Second
+)
+
+var (
+	connectBackoff    = 100 * time.Millisecond
+	connectMaxBackoff = 2 * time.Second
+)
+
+var dbNames = map[int]string{
+	APPL_DB:             "APPL_DB",
+	ASIC_DB:             "ASIC_DB",
+	COUNTERS_DB:         "COUNTERS_DB",
+	CONFIG_DB:           "CONFIG_DB",
+	TRANSPORT_CONFIG_DB: "TRANSPORT_CONFIG_DB",
+	TRANSPORT_STATE_DB:  "TRANSPORT_STATE_DB",
+}
+
+// DatabaseInstance is a redis server in database_config.json
+type DatabaseInstance struct {
+	Hostname       string `json:"hostname"`
+	Port           int    `json:"port"`
+	UnixSocketPath string `json:"unix_socket_path"`
+}
+
+// Database is a database in database_config.json
+type Database struct {
+	ID        int    `json:"id"`
+	Separator string `json:"separator"`
+	Instance  string `json:"instance"`
+}
+
+// DatabaseConfig is the content of SONiC's database_config.json
+type DatabaseConfig struct {
+	Instances map[string]DatabaseInstance `json:"INSTANCES"`
+	Databases map[string]Database         `json:"DATABASES"`
+	Version   string                      `json:"VERSION,omitempty"`
+}
+
+// DefaultDatabaseConfig returns the layout used when database_config.json doesn't exist
+func DefaultDatabaseConfig() *DatabaseConfig {
+	return &DatabaseConfig{
+		Instances: map[string]DatabaseInstance{
+			DEFAULT_DB_INSTANCE: {
+				Hostname:       "127.0.0.1",
+				Port:           6379,
+				UnixSocketPath: DEFAULT_REDIS_UNIX_SOCKET,
+			},
+		},
+		Databases: map[string]Database{
+			"APPL_DB":             {ID: APPL_DB, Separator: ":", Instance: DEFAULT_DB_INSTANCE},
+			"ASIC_DB":             {ID: ASIC_DB, Separator: ":", Instance: DEFAULT_DB_INSTANCE},
+			"COUNTERS_DB":         {ID: COUNTERS_DB, Separator: ":", Instance: DEFAULT_DB_INSTANCE},
+			"CONFIG_DB":           {ID: CONFIG_DB, Separator: "|", Instance: DEFAULT_DB_INSTANCE},
+			"TRANSPORT_CONFIG_DB": {ID: TRANSPORT_CONFIG_DB, Separator: "|", Instance: DEFAULT_DB_INSTANCE},
+			"TRANSPORT_STATE_DB":  {ID: TRANSPORT_STATE_DB, Separator: "|", Instance: DEFAULT_DB_INSTANCE},
+		},
+	}
+}
+
+// LoadDatabaseConfig reads database_config.json.
+// the databases and the instances missing in the file are taken from DefaultDatabaseConfig
+// since SONiC doesn't define the transport databases
+func LoadDatabaseConfig(path string) (*DatabaseConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &DatabaseConfig{}
+	if err = json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	def := DefaultDatabaseConfig()
+	if config.Instances == nil {
+		config.Instances = map[string]DatabaseInstance{}
+	}
+	if config.Databases == nil {
+		config.Databases = map[string]Database{}
+	}
+	for k, v := range def.Databases {
+		if _, ok := config.Databases[k]; !ok {
+			config.Databases[k] = v
+		}
+	}
+	for k, v := range config.Databases {
+		if _, ok := config.Instances[v.Instance]; ok {
+			continue
+		}
+		i, ok := def.Instances[v.Instance]
+		if !ok {
+			return nil, fmt.Errorf("instance %s of %s not found in %s", v.Instance, k, path)
+		}
+		config.Instances[v.Instance] = i
+	}
+	return config, nil
+}
+
+// address returns where db is served. unix sockets are preferred over TCP
+func (c *DatabaseConfig) address(db int) (network, addr string, d Database, err error) {
+	name, ok := dbNames[db]
+	if !ok {
+		return "", "", d, fmt.Errorf("unknown database %d", db)
+	}
+	d, ok = c.Databases[name]
+	if !ok {
+		return "", "", d, fmt.Errorf("database %s not found in the database config", name)
+	}
+	i, ok := c.Instances[d.Instance]
+	if !ok {
+		return "", "", d, fmt.Errorf("instance %s of %s not found in the database config", d.Instance, name)
+	}
+	if i.UnixSocketPath != "" {
+		return "unix", i.UnixSocketPath, d, nil
+	}
+	return "tcp", fmt.Sprintf("%s:%d", i.Hostname, i.Port), d, nil
+}
+
+// ConnectionManager shares a client per database
+type ConnectionManager struct {
+	sync.Mutex
+	config  *DatabaseConfig
+	clients map[int]*SONiCDBClient
+	// Timeout bounds dialing and each command sent to redis
+	Timeout time.Duration
+}
+
+func NewConnectionManager(config *DatabaseConfig) *ConnectionManager {
+	return &ConnectionManager{
+		config:  config,
+		clients: map[int]*SONiCDBClient{},
+		Timeout: DEFAULT_DB_TIMEOUT,
+	}
+}
+
+func (m *ConnectionManager) client(db int) (*SONiCDBClient, error) {
+	m.Lock()
+	defer m.Unlock()
+	if c, ok := m.clients[db]; ok {
+		return c, nil
+	}
+	network, addr, d, err := m.config.address(db)
+	if err != nil {
+		return nil, err
+	}
+	b, err := connect(network, addr, d.ID, m.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	c := &SONiCDBClient{
+		client:    b,
+		db:        db,
+		separator: d.Separator,
+	}
+	m.clients[db] = c
+	return c, nil
+}
+
+// Client returns the shared client of db.
+// while redis is not reachable, e.g. restarting, it retries with backoff until ctx is done
+func (m *ConnectionManager) Client(ctx context.Context, db int) (*SONiCDBClient, error) {
+	backoff := connectBackoff
+	for {
+		c, err := m.client(db)
+		if err != nil {
+			return nil, err
+		}
+		if err = c.client.Ping(); err == nil {
+			return c, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s is not reachable: %v", dbNames[db], err)
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > connectMaxBackoff {
+			backoff = connectMaxBackoff
+		}
+	}
+}
+
+// Close closes the clients. the manager reconnects when Client is called again
+func (m *ConnectionManager) Close() error {
+	m.Lock()
+	defer m.Unlock()
+	var err error
+	for db, c := range m.clients {
+		if e := c.client.Close(); e != nil && err == nil {
+			err = e
+		}
+		delete(m.clients, db)
+	}
+	return err
+}
+
+var manager struct {
+	sync.Mutex
+	m *ConnectionManager
+}
+
+func getManager() *ConnectionManager {
+	manager.Lock()
+	defer manager.Unlock()
+	if manager.m == nil {
+		manager.m = NewConnectionManager(DefaultDatabaseConfig())
+	}
+	return manager.m
+}
+
+// SetDatabaseConfig replaces the config used by GetClient and closes the current clients
+func SetDatabaseConfig(config *DatabaseConfig) {
+	manager.Lock()
+	old := manager.m
+	manager.m = NewConnectionManager(config)
+	manager.Unlock()
+	if old != nil {
+		old.Close()
+	}
+}
+
+// GetClientContext returns the shared client of db waiting for redis until ctx is done
+func GetClientContext(ctx context.Context, db int) (*SONiCDBClient, error) {
+	return getManager().Client(ctx, db)
+}
+
+// GetClient returns the shared client of db waiting for redis up to DEFAULT_CONNECT_TIMEOUT
+func GetClient(db int) (*SONiCDBClient, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), DEFAULT_CONNECT_TIMEOUT)
+	defer cancel()
+	return GetClientContext(ctx, db)
+}
+
+// CloseClients closes the shared clients
+func CloseClients() error {
+	return getManager().Close()
+}
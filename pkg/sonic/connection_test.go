@@ -0,0 +1,102 @@
+package sonic
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadDatabaseConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "database_config.json")
+	data := `{
+    "INSTANCES": {
+        "redis": {"hostname": "10.0.0.1", "port": 6380}
+    },
+    "DATABASES": {
+        "APPL_DB": {"id": 10, "separator": ":", "instance": "redis"},
+        "CONFIG_DB": {"id": 11, "separator": "|", "instance": "redis"}
+    },
+    "VERSION": "1.0"
+}`
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config, err := LoadDatabaseConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	network, addr, d, err := config.address(CONFIG_DB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if network != "tcp" || addr != "10.0.0.1:6380" || d.ID != 11 {
+		t.Errorf("unexpected address: %s %s %d", network, addr, d.ID)
+	}
+	// the transport databases are taken from the default config
+	if _, _, d, err = config.address(TRANSPORT_STATE_DB); err != nil || d.ID != TRANSPORT_STATE_DB || d.Separator != "|" {
+		t.Errorf("unexpected database: %v %v", d, err)
+	}
+}
+
+func TestConnectionManager(t *testing.T) {
+	store := NewMemoryStore()
+	t.Cleanup(UseMemoryStore(store))
+	config := DefaultDatabaseConfig()
+	config.Databases["CONFIG_DB"] = Database{ID: 14, Separator: "|", Instance: DEFAULT_DB_INSTANCE}
+	m := NewConnectionManager(config)
+	defer m.Close()
+
+	c, err := m.Client(context.Background(), CONFIG_DB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.ModEntry("PORT", "Ethernet1", map[string]interface{}{"mtu": 9000}); err != nil {
+		t.Fatal(err)
+	}
+	if h, _ := store.DB(14).HGetAll("PORT|Ethernet1"); h["mtu"] != "9000" {
+		t.Errorf("entry is not written to the configured database: %v", h)
+	}
+	if c2, _ := m.Client(context.Background(), CONFIG_DB); c2 != c {
+		t.Errorf("client is not reused")
+	}
+}
+
+type flakyDB struct {
+	*MemoryDB
+	fails int
+}
+
+func (f *flakyDB) Ping() error {
+	if f.fails > 0 {
+		f.fails--
+		return errors.New("LOADING Redis is loading the dataset in memory")
+	}
+	return nil
+}
+
+func TestConnectionManagerRetry(t *testing.T) {
+	orig := connectBackoff
+	connectBackoff = time.Millisecond
+	defer func() { connectBackoff = orig }()
+
+	db := &flakyDB{MemoryDB: NewMemoryDB(), fails: 3}
+	t.Cleanup(useConnector(func(network, addr string, id int, timeout time.Duration) (Backend, error) {
+		return db, nil
+	}))
+	if _, err := GetClient(CONFIG_DB); err != nil {
+		t.Fatal(err)
+	}
+	if db.fails != 0 {
+		t.Errorf("client returned before redis is ready")
+	}
+
+	db.fails = 1000
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := GetClientContext(ctx, CONFIG_DB); err == nil {
+		t.Errorf("expected error while redis is not ready")
+	}
+}
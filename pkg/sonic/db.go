@@ -0,0 +1,541 @@
+package sonic
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis"
+)
+
+// Command is a write command of a batch
+type Command struct {
+	// HMSET, HDEL or DEL
+	Op     string
+	Key    string
+	Fields map[string]interface{}
+	// the fields to delete by HDEL
+	Names []string
+}
+
+// Backend executes the commands SONiCDBClient uses on a database
+type Backend interface {
+	Ping() error
+	// Get returns "" when the key doesn't exist
+	Get(key string) (string, error)
+	HGetAll(key string) (map[string]string, error)
+	// Scan returns a page of the keys matching pattern and the cursor of the next page.
+	// the cursor is 0 when the iteration is completed
+	Scan(cursor uint64, pattern string, count int64) ([]string, uint64, error)
+	Publish(channel, message string) (int, error)
+	// Exec executes the commands atomically
+	Exec(cmds []Command) error
+	// Eval runs the script atomically
+	Eval(s *Script, keys []string, args ...string) (interface{}, error)
+	// Subscribe returns after the subscription is confirmed,
+	// so the messages published after it returns are not lost
+	Subscribe(channel string) (Subscription, error)
+	Close() error
+}
+
+// Subscription receives the messages published to a channel
+type Subscription interface {
+	// Messages is closed when the subscription is closed
+	Messages() <-chan Message
+	Close() error
+}
+
+// Script is a Lua script run by redis.
+// MemoryDB runs the Go implementation of the script instead
+type Script struct {
+	redis *redis.Script
+	// memory runs with the lock of the MemoryDB held
+	memory func(m *MemoryDB, keys []string, args []string) (interface{}, error)
+}
+
+func newScript(src string, memory func(m *MemoryDB, keys []string, args []string) (interface{}, error)) *Script {
+	return &Script{
+		redis:  redis.NewScript(src),
+		memory: memory,
+	}
+}
+
+type redisBackend struct {
+	client *redis.Client
+}
+
+// newRedisBackend doesn't connect until the first command.
+// timeout bounds dialing and each read and write of the commands
+func newRedisBackend(network, addr string, db int, timeout time.Duration) (Backend, error) {
+	return &redisBackend{
+		client: redis.NewClient(&redis.Options{
+			Network:      network,
+			Addr:         addr,
+			DB:           db,
+			DialTimeout:  timeout,
+			ReadTimeout:  timeout,
+			WriteTimeout: timeout,
+		}),
+	}, nil
+}
+
+func (b *redisBackend) Ping() error {
+	return b.client.Ping().Err()
+}
+
+func (b *redisBackend) Get(key string) (string, error) {
+	r := b.client.Get(key)
+	if r.Err() == redis.Nil {
+		return "", nil
+	}
+	return r.Result()
+}
+
+func (b *redisBackend) HGetAll(key string) (map[string]string, error) {
+	return b.client.HGetAll(key).Result()
+}
+
+func (b *redisBackend) Scan(cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	return b.client.Scan(cursor, pattern, count).Result()
+}
+
+func (b *redisBackend) Publish(channel, message string) (int, error) {
+	r := b.client.Publish(channel, message)
+	return int(r.Val()), r.Err()
+}
+
+func (b *redisBackend) Exec(cmds []Command) error {
+	_, err := b.client.TxPipelined(func(p redis.Pipeliner) error {
+		for _, c := range cmds {
+			switch c.Op {
+			case "HMSET":
+				p.HMSet(c.Key, c.Fields)
+			case "HDEL":
+				p.HDel(c.Key, c.Names...)
+			case "DEL":
+				p.Del(c.Key)
+			default:
+				return fmt.Errorf("unsupported command in a batch: %s", c.Op)
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+func (b *redisBackend) Eval(s *Script, keys []string, args ...string) (interface{}, error) {
+	values := make([]interface{}, 0, len(args))
+	for _, a := range args {
+		values = append(values, a)
+	}
+	v, err := s.redis.Run(b.client, keys, values...).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	return v, err
+}
+
+type redisSubscription struct {
+	ps   *redis.PubSub
+	ch   chan Message
+	done chan struct{}
+	once sync.Once
+}
+
+func (b *redisBackend) Subscribe(channel string) (Subscription, error) {
+	ps := b.client.Subscribe(channel)
+	if _, err := ps.Receive(); err != nil {
+		ps.Close()
+		return nil, err
+	}
+	s := &redisSubscription{
+		ps:   ps,
+		ch:   make(chan Message),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(s.ch)
+		// go-redis reconnects and resubscribes when the connection is lost
+		for m := range ps.Channel() {
+			select {
+			case s.ch <- Message{Channel: m.Channel, Message: m.Payload}:
+			case <-s.done:
+				return
+			}
+		}
+	}()
+	return s, nil
+}
+
+func (s *redisSubscription) Messages() <-chan Message {
+	return s.ch
+}
+
+func (s *redisSubscription) Close() error {
+	var err error
+	s.once.Do(func() {
+		close(s.done)
+		err = s.ps.Close()
+	})
+	return err
+}
+
+func (b *redisBackend) Close() error {
+	return b.client.Close()
+}
+
+var connector struct {
+	sync.Mutex
+	connect func(network, addr string, db int, timeout time.Duration) (Backend, error)
+}
+
+func connect(network, addr string, db int, timeout time.Duration) (Backend, error) {
+	connector.Lock()
+	c := connector.connect
+	connector.Unlock()
+	if c == nil {
+		return newRedisBackend(network, addr, db, timeout)
+	}
+	return c(network, addr, db, timeout)
+}
+
+// Message is a message published to a MemoryDB
+type Message struct {
+	Channel string
+	Message string
+}
+
+// MemoryDB is a database kept in memory
+type MemoryDB struct {
+	sync.Mutex
+	keys      map[string]string
+	hashes    map[string]map[string]string
+	sets      map[string]map[string]bool
+	Published []Message
+	subs      map[*memorySubscription]bool
+}
+
+func NewMemoryDB() *MemoryDB {
+	return &MemoryDB{
+		keys:   map[string]string{},
+		hashes: map[string]map[string]string{},
+		sets:   map[string]map[string]bool{},
+		subs:   map[*memorySubscription]bool{},
+	}
+}
+
+func (m *MemoryDB) Ping() error {
+	return nil
+}
+
+func (m *MemoryDB) Get(key string) (string, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.keys[key], nil
+}
+
+// Set is not used by SONiCDBClient. it is for tests to prepare the database
+func (m *MemoryDB) Set(key, value string) {
+	m.Lock()
+	defer m.Unlock()
+	m.keys[key] = value
+}
+
+func (m *MemoryDB) HGetAll(key string) (map[string]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	h := make(map[string]string, len(m.hashes[key]))
+	for k, v := range m.hashes[key] {
+		h[k] = v
+	}
+	return h, nil
+}
+
+func (m *MemoryDB) HMSet(key string, fields map[string]interface{}) error {
+	m.Lock()
+	defer m.Unlock()
+	m.hmset(key, fields)
+	return nil
+}
+
+func (m *MemoryDB) hmset(key string, fields map[string]interface{}) {
+	h, ok := m.hashes[key]
+	if !ok {
+		h = map[string]string{}
+		m.hashes[key] = h
+	}
+	for k, v := range fields {
+		h[k] = fmt.Sprintf("%v", v)
+	}
+}
+
+func (m *MemoryDB) HDel(key string, fields ...string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.hdel(key, fields...)
+	return nil
+}
+
+func (m *MemoryDB) hdel(key string, fields ...string) {
+	h, ok := m.hashes[key]
+	if !ok {
+		return
+	}
+	for _, f := range fields {
+		delete(h, f)
+	}
+	if len(h) == 0 {
+		delete(m.hashes, key)
+	}
+}
+
+func (m *MemoryDB) Del(key string) error {
+	m.Lock()
+	defer m.Unlock()
+	m.del(key)
+	return nil
+}
+
+func (m *MemoryDB) del(key string) {
+	delete(m.keys, key)
+	delete(m.hashes, key)
+	delete(m.sets, key)
+}
+
+// sadd returns the number of the members added
+func (m *MemoryDB) sadd(key string, members ...string) int {
+	set, ok := m.sets[key]
+	if !ok {
+		set = map[string]bool{}
+		m.sets[key] = set
+	}
+	added := 0
+	for _, v := range members {
+		if !set[v] {
+			set[v] = true
+			added++
+		}
+	}
+	return added
+}
+
+// spop pops up to count members in the sorted order
+func (m *MemoryDB) spop(key string, count int) []string {
+	members := make([]string, 0, len(m.sets[key]))
+	for v := range m.sets[key] {
+		members = append(members, v)
+	}
+	sort.Strings(members)
+	if len(members) > count {
+		members = members[:count]
+	}
+	for _, v := range members {
+		delete(m.sets[key], v)
+	}
+	if len(m.sets[key]) == 0 {
+		delete(m.sets, key)
+	}
+	return members
+}
+
+// SMembers returns the members of the set in the sorted order
+func (m *MemoryDB) SMembers(key string) []string {
+	m.Lock()
+	defer m.Unlock()
+	members := make([]string, 0, len(m.sets[key]))
+	for v := range m.sets[key] {
+		members = append(members, v)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// Scan pages the keys in the sorted order. the cursor is the index of the next key
+func (m *MemoryDB) Scan(cursor uint64, pattern string, count int64) ([]string, uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+	keys := []string{}
+	for _, set := range []map[string]bool{m.keySet(), m.hashSet(), m.setSet()} {
+		for k := range set {
+			ok, err := path.Match(pattern, k)
+			if err != nil {
+				return nil, 0, err
+			}
+			if ok {
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	if count <= 0 {
+		count = 10
+	}
+	if cursor >= uint64(len(keys)) {
+		return []string{}, 0, nil
+	}
+	next := cursor + uint64(count)
+	if next >= uint64(len(keys)) {
+		return keys[cursor:], 0, nil
+	}
+	return keys[cursor:next], next, nil
+}
+
+func (m *MemoryDB) keySet() map[string]bool {
+	s := make(map[string]bool, len(m.keys))
+	for k := range m.keys {
+		s[k] = true
+	}
+	return s
+}
+
+func (m *MemoryDB) hashSet() map[string]bool {
+	s := make(map[string]bool, len(m.hashes))
+	for k := range m.hashes {
+		s[k] = true
+	}
+	return s
+}
+
+func (m *MemoryDB) setSet() map[string]bool {
+	s := make(map[string]bool, len(m.sets))
+	for k := range m.sets {
+		s[k] = true
+	}
+	return s
+}
+
+func (m *MemoryDB) Publish(channel, message string) (int, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.publish(channel, message), nil
+}
+
+// publish returns the number of the subscribers like PUBLISH
+func (m *MemoryDB) publish(channel, message string) int {
+	msg := Message{Channel: channel, Message: message}
+	m.Published = append(m.Published, msg)
+	n := 0
+	for s := range m.subs {
+		if s.channel != channel {
+			continue
+		}
+		n++
+		// like redis, slow subscribers lose messages instead of blocking the publisher
+		select {
+		case s.ch <- msg:
+		default:
+		}
+	}
+	return n
+}
+
+// MEMORY_SUBSCRIPTION_BUFFER is the number of the messages a memorySubscription keeps
+const MEMORY_SUBSCRIPTION_BUFFER = 100
+
+type memorySubscription struct {
+	m       *MemoryDB
+	channel string
+	ch      chan Message
+}
+
+func (m *MemoryDB) Subscribe(channel string) (Subscription, error) {
+	m.Lock()
+	defer m.Unlock()
+	s := &memorySubscription{m: m, channel: channel, ch: make(chan Message, MEMORY_SUBSCRIPTION_BUFFER)}
+	m.subs[s] = true
+	return s, nil
+}
+
+func (s *memorySubscription) Messages() <-chan Message {
+	return s.ch
+}
+
+func (s *memorySubscription) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.m.subs[s] {
+		delete(s.m.subs, s)
+		close(s.ch)
+	}
+	return nil
+}
+
+func (m *MemoryDB) Exec(cmds []Command) error {
+	m.Lock()
+	defer m.Unlock()
+	for _, c := range cmds {
+		switch c.Op {
+		case "HMSET", "HDEL", "DEL":
+		default:
+			return fmt.Errorf("unsupported command in a batch: %s", c.Op)
+		}
+	}
+	for _, c := range cmds {
+		switch c.Op {
+		case "HMSET":
+			m.hmset(c.Key, c.Fields)
+		case "HDEL":
+			m.hdel(c.Key, c.Names...)
+		case "DEL":
+			m.del(c.Key)
+		}
+	}
+	return nil
+}
+
+func (m *MemoryDB) Eval(s *Script, keys []string, args ...string) (interface{}, error) {
+	m.Lock()
+	defer m.Unlock()
+	return s.memory(m, keys, args)
+}
+
+func (m *MemoryDB) Close() error {
+	return nil
+}
+
+// MemoryStore is a set of MemoryDBs indexed by the database number
+type MemoryStore struct {
+	sync.Mutex
+	dbs map[int]*MemoryDB
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{dbs: map[int]*MemoryDB{}}
+}
+
+// DB returns the database creating it when it doesn't exist
+func (s *MemoryStore) DB(db int) *MemoryDB {
+	s.Lock()
+	defer s.Unlock()
+	m, ok := s.dbs[db]
+	if !ok {
+		m = NewMemoryDB()
+		s.dbs[db] = m
+	}
+	return m
+}
+
+// UseMemoryStore makes the clients connect to the store instead of redis
+// until the returned function is called
+func UseMemoryStore(s *MemoryStore) func() {
+	return useConnector(func(network, addr string, db int, timeout time.Duration) (Backend, error) {
+		return s.DB(db), nil
+	})
+}
+
+// useConnector drops the shared clients so that they reconnect with c
+func useConnector(c func(network, addr string, db int, timeout time.Duration) (Backend, error)) func() {
+	connector.Lock()
+	orig := connector.connect
+	connector.connect = c
+	connector.Unlock()
+	getManager().Close()
+	return func() {
+		connector.Lock()
+		connector.connect = orig
+		connector.Unlock()
+		getManager().Close()
+	}
+}
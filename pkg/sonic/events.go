@@ -0,0 +1,278 @@
+package sonic
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	EVENT_HISTORY_FILE = "events.jsonl"
+	// DEFAULT_EVENT_HISTORY_SIZE is the number of the events EventHistory keeps
+	DEFAULT_EVENT_HISTORY_SIZE = 1000
+)
+
+// Event is a notification published on TRANSPORT_NOTIFICATION
+type Event struct {
+	Time time.Time `json:"time"`
+	// the operation of the notification, e.g. the kind of the state change or alarm
+	Op string `json:"op"`
+	// the data of the notification. transyncd sets the optical module name
+	Module string            `json:"module,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// ParseNotification parses a message sent by SendNotification: [op, data, field, value, ...]
+func ParseNotification(message string, t time.Time) (Event, error) {
+	var raw []interface{}
+	if err := json.Unmarshal([]byte(message), &raw); err != nil {
+		return Event{}, fmt.Errorf("invalid notification %s: %v", message, err)
+	}
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		} else {
+			values = append(values, fmt.Sprintf("%v", v))
+		}
+	}
+	if len(values) < 2 {
+		return Event{}, fmt.Errorf("invalid notification %s: op and data are required", message)
+	}
+	if len(values)%2 != 0 {
+		return Event{}, fmt.Errorf("invalid notification %s: field without value", message)
+	}
+	e := Event{Time: t, Op: values[0], Module: values[1]}
+	if len(values) > 2 {
+		e.Fields = make(map[string]string, (len(values)-2)/2)
+		for i := 2; i < len(values); i += 2 {
+			e.Fields[values[i]] = values[i+1]
+		}
+	}
+	return e, nil
+}
+
+// EventFilter selects events. the zero value selects all events
+type EventFilter struct {
+	Module string
+	Since  time.Time
+}
+
+func (f EventFilter) Match(e Event) bool {
+	if f.Module != "" && e.Module != f.Module {
+		return false
+	}
+	return f.Since.IsZero() || !e.Time.Before(f.Since)
+}
+
+// EventHistory keeps the latest events in memory and in a JSON lines file
+type EventHistory struct {
+	sync.Mutex
+	path        string
+	size        int
+	events      []Event
+	lines       int
+	subscribers map[chan Event]bool
+}
+
+// NewEventHistory loads the events kept in path. empty path keeps the events only in memory
+func NewEventHistory(path string, size int) (*EventHistory, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid event history size: %d", size)
+	}
+	h := &EventHistory{
+		path:        path,
+		size:        size,
+		subscribers: map[chan Event]bool{},
+	}
+	if path == "" {
+		return h, nil
+	}
+	events, length, err := loadEvents(path)
+	if err != nil {
+		return nil, err
+	}
+	// drop the partial last line so that the next event starts on a new line
+	if info, err := os.Stat(path); err == nil && info.Size() > length {
+		if err = os.Truncate(path, length); err != nil {
+			return nil, err
+		}
+	}
+	h.lines = len(events)
+	if len(events) > size {
+		events = events[len(events)-size:]
+	}
+	h.events = events
+	return h, nil
+}
+
+// LoadEvents reads the latest size events kept by EventHistory in path.
+// all the events in the file are returned when size is 0
+func LoadEvents(path string, size int) ([]Event, error) {
+	events, _, err := loadEvents(path)
+	if err != nil {
+		return nil, err
+	}
+	if size > 0 && len(events) > size {
+		events = events[len(events)-size:]
+	}
+	return events, nil
+}
+
+// loadEvents returns the events in path and the length of the lines they are read from.
+// the last line without the newline is left by an interrupted write and is skipped
+func loadEvents(path string) ([]Event, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Event{}, 0, nil
+		}
+		return nil, 0, err
+	}
+	defer f.Close()
+	events := []Event{}
+	r := bufio.NewReader(f)
+	var length int64
+	for n := 1; ; n++ {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			return events, length, nil
+		} else if err != nil {
+			return nil, 0, err
+		}
+		var e Event
+		if err = json.Unmarshal(line, &e); err != nil {
+			return nil, 0, fmt.Errorf("%s:%d: %v", path, n, err)
+		}
+		events = append(events, e)
+		length += int64(len(line))
+	}
+}
+
+func (h *EventHistory) save(e Event) error {
+	if h.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return err
+	}
+	// the file is rewritten with the kept events when it grows twice the size
+	if h.lines >= 2*h.size {
+		tmp := h.path + ".tmp"
+		f, err := os.Create(tmp)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(f)
+		for _, kept := range h.events {
+			if err = enc.Encode(kept); err != nil {
+				f.Close()
+				return err
+			}
+		}
+		if err = f.Close(); err != nil {
+			return err
+		}
+		if err = os.Rename(tmp, h.path); err != nil {
+			return err
+		}
+		h.lines = len(h.events)
+		return nil
+	}
+	f, err := os.OpenFile(h.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if err = json.NewEncoder(f).Encode(e); err != nil {
+		f.Close()
+		return err
+	}
+	h.lines++
+	return f.Close()
+}
+
+// Add keeps the event dropping the oldest one when the history is full
+// and sends it to the subscribers
+func (h *EventHistory) Add(e Event) error {
+	h.Lock()
+	defer h.Unlock()
+	h.events = append(h.events, e)
+	if len(h.events) > h.size {
+		h.events = append([]Event(nil), h.events[len(h.events)-h.size:]...)
+	}
+	for ch := range h.subscribers {
+		// slow subscribers lose events instead of blocking the listener
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+	return h.save(e)
+}
+
+// Events returns the kept events selected by f in the order they were added
+func (h *EventHistory) Events(f EventFilter) []Event {
+	h.Lock()
+	defer h.Unlock()
+	events := []Event{}
+	for _, e := range h.events {
+		if f.Match(e) {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// Subscribe returns a channel receiving the events added after the call
+// and the function to stop the subscription
+func (h *EventHistory) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, h.size)
+	h.Lock()
+	h.subscribers[ch] = true
+	h.Unlock()
+	return ch, func() {
+		h.Lock()
+		defer h.Unlock()
+		if h.subscribers[ch] {
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// ListenEvents adds the notifications published on TRANSPORT_NOTIFICATION to h until ctx is done.
+// invalid notifications are reported by onError and skipped
+func ListenEvents(ctx context.Context, h *EventHistory, onError func(error)) error {
+	client, err := GetClientContext(ctx, TRANSPORT_STATE_DB)
+	if err != nil {
+		return err
+	}
+	sub, err := client.client.Subscribe(TRANSPORT_NOTIFICATION)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case m, ok := <-sub.Messages():
+			if !ok {
+				return fmt.Errorf("subscription to %s is closed", TRANSPORT_NOTIFICATION)
+			}
+			e, err := ParseNotification(m.Message, time.Now())
+			if err == nil {
+				err = h.Add(e)
+			}
+			if err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
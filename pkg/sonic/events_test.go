@@ -0,0 +1,153 @@
+package sonic
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseNotification(t *testing.T) {
+	now := time.Now()
+	e, err := ParseNotification(`["los","Port1","severity","major","count",3]`, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Event{Time: now, Op: "los", Module: "Port1", Fields: map[string]string{"severity": "major", "count": "3"}}
+	if !reflect.DeepEqual(e, expected) {
+		t.Errorf("expected %v, got %v", expected, e)
+	}
+	for _, m := range []string{`["los"]`, `["los","Port1","severity"]`, `{"op":"los"}`} {
+		if _, err := ParseNotification(m, now); err == nil {
+			t.Errorf("%s is accepted", m)
+		}
+	}
+}
+
+func TestEventHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EVENT_HISTORY_FILE)
+	h, err := NewEventHistory(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	ch, cancel := h.Subscribe()
+	defer cancel()
+	for i, m := range []string{"Port1", "Port2", "Port1", "Port1", "Port2"} {
+		if err := h.Add(Event{Time: base.Add(time.Duration(i) * time.Minute), Op: "los", Module: m}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if e := <-ch; e.Module != "Port1" || !e.Time.Equal(base) {
+		t.Errorf("unexpected first event: %v", e)
+	}
+
+	// only the latest events are kept
+	events := h.Events(EventFilter{})
+	if len(events) != 2 || events[0].Module != "Port1" || events[1].Module != "Port2" {
+		t.Errorf("unexpected events: %v", events)
+	}
+	if events = h.Events(EventFilter{Module: "Port2"}); len(events) != 1 {
+		t.Errorf("unexpected events of Port2: %v", events)
+	}
+	if events = h.Events(EventFilter{Since: base.Add(4 * time.Minute)}); len(events) != 1 {
+		t.Errorf("unexpected events since 4m: %v", events)
+	}
+
+	// the file is compacted but keeps the latest events
+	h, err = NewEventHistory(path, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events = h.Events(EventFilter{})
+	if len(events) != 2 || !events[1].Time.Equal(base.Add(4*time.Minute)) {
+		t.Errorf("unexpected reloaded events: %v", events)
+	}
+	if loaded, err := LoadEvents(path, 0); err != nil || len(loaded) > 4 {
+		t.Errorf("history file is not compacted: %v, %v", loaded, err)
+	}
+}
+
+func TestEventHistoryPartialLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), EVENT_HISTORY_FILE)
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := `{"time":"2020-01-01T00:00:00Z","op":"los","module":"Port1"}
+{"time":"2020-01-01T00:01:00Z","op":"los","module":"Port2"}
+{"time":"2020-01-01T00:02:00Z","op":"lo`
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	events, err := LoadEvents(path, 0)
+	if err != nil || len(events) != 2 {
+		t.Fatalf("unexpected events: %v, %v", events, err)
+	}
+	if events, err = LoadEvents(path, 1); err != nil || len(events) != 1 || events[0].Module != "Port2" {
+		t.Errorf("unexpected latest events: %v, %v", events, err)
+	}
+
+	// the partial line is dropped before the next event is appended
+	h, err := NewEventHistory(path, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = h.Add(Event{Time: base.Add(3 * time.Minute), Op: "los", Module: "Port1"}); err != nil {
+		t.Fatal(err)
+	}
+	if events, err = LoadEvents(path, 0); err != nil || len(events) != 3 || !events[2].Time.Equal(base.Add(3*time.Minute)) {
+		t.Errorf("unexpected events after the partial line: %v, %v", events, err)
+	}
+
+	// an invalid line in the middle is still an error
+	if err = ioutil.WriteFile(path, []byte("{\n"+data+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = LoadEvents(path, 0); err == nil {
+		t.Error("expected error for an invalid line")
+	}
+}
+
+func TestListenEvents(t *testing.T) {
+	client, _ := newTestClient(t, TRANSPORT_STATE_DB)
+	h, err := NewEventHistory("", DEFAULT_EVENT_HISTORY_SIZE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch, cancel := h.Subscribe()
+	defer cancel()
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan error)
+	errors := make(chan error, 1)
+	go func() {
+		done <- ListenEvents(ctx, h, func(err error) { errors <- err })
+	}()
+
+	// wait for the listener to subscribe
+	deadline := time.Now().Add(time.Second)
+	for {
+		if n, err := client.SendNotification(TRANSPORT_NOTIFICATION, "los", "Port1", []interface{}{"severity", "major"}); err != nil {
+			t.Fatal(err)
+		} else if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("listener didn't subscribe")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	select {
+	case e := <-ch:
+		if e.Op != "los" || e.Module != "Port1" || e.Fields["severity"] != "major" {
+			t.Errorf("unexpected event: %v", e)
+		}
+	case err := <-errors:
+		t.Fatal(err)
+	case <-time.After(time.Second):
+		t.Fatal("event is not received")
+	}
+	stop()
+	if err := <-done; err != nil {
+		t.Error(err)
+	}
+}
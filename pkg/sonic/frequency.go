@@ -0,0 +1,212 @@
+package sonic
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+// the channels of all fixed grids start from the lowest frequency of the C-band.
+// channel n is at C_BAND_FIRST_FREQUENCY + (n - 1) * spacing.
+// FLEXGRID has the central frequency at FLEXGRID_ANCHOR_FREQUENCY + n * 6.25GHz
+// and the slot width of m * 12.5GHz like ITU-T G.694.1
+
+const (
+	// C_BAND_FIRST_FREQUENCY and C_BAND_LAST_FREQUENCY are in GHz
+	C_BAND_FIRST_FREQUENCY = 191300
+	C_BAND_LAST_FREQUENCY  = 196100
+	// FREQUENCY_TOLERANCE is the difference in GHz from the centre of a channel
+	// accepted when a frequency is given. wavelengths rounded to 0.01nm differ up to 0.6GHz
+	FREQUENCY_TOLERANCE = 1.0
+	// SPEED_OF_LIGHT is in nm * THz
+	SPEED_OF_LIGHT = 299792.458
+	// FLEXGRID_ANCHOR_FREQUENCY, FLEXGRID_GRANULARITY and FLEXGRID_SLOT_WIDTH_GRANULARITY are in GHz
+	FLEXGRID_ANCHOR_FREQUENCY       = 193100
+	FLEXGRID_GRANULARITY            = 6.25
+	FLEXGRID_SLOT_WIDTH_GRANULARITY = 12.5
+	// DEFAULT_SLOT_WIDTH_M is the slot width of 50GHz
+	DEFAULT_SLOT_WIDTH_M = 4
+)
+
+// GridSpacing returns the channel spacing of the grid in GHz
+func GridSpacing(grid model.E_PacketTransport_FrequencyGridType) (float64, error) {
+	switch grid {
+	case model.PacketTransport_FrequencyGridType_GRID_100GHZ:
+		return 100, nil
+	case model.PacketTransport_FrequencyGridType_GRID_50GHZ:
+		return 50, nil
+	case model.PacketTransport_FrequencyGridType_GRID_33GHZ:
+		return 100.0 / 3, nil
+	case model.PacketTransport_FrequencyGridType_GRID_25GHZ:
+		return 25, nil
+	case model.PacketTransport_FrequencyGridType_FLEXGRID:
+		return 0, fmt.Errorf("FLEXGRID doesn't have channels")
+	}
+	return 0, fmt.Errorf("unknown grid: %d", grid)
+}
+
+// MaxChannel returns the last channel of the grid within the C-band
+func MaxChannel(grid model.E_PacketTransport_FrequencyGridType) (uint8, error) {
+	spacing, err := GridSpacing(grid)
+	if err != nil {
+		return 0, err
+	}
+	return uint8(math.Floor((C_BAND_LAST_FREQUENCY-C_BAND_FIRST_FREQUENCY)/spacing+1e-9)) + 1, nil
+}
+
+// ChannelFrequency returns the centre frequency of the channel in THz
+func ChannelFrequency(grid model.E_PacketTransport_FrequencyGridType, channel uint8) (float64, error) {
+	max, err := MaxChannel(grid)
+	if err != nil {
+		return 0, err
+	}
+	if channel < 1 || channel > max {
+		return 0, fmt.Errorf("channel must be in the range of 1..%d for %s", max, enumName(grid))
+	}
+	spacing, _ := GridSpacing(grid)
+	return (C_BAND_FIRST_FREQUENCY + float64(channel-1)*spacing) / 1000, nil
+}
+
+// FrequencyChannel returns the channel of the grid whose centre is the frequency in THz
+func FrequencyChannel(grid model.E_PacketTransport_FrequencyGridType, frequency float64) (uint8, error) {
+	spacing, err := GridSpacing(grid)
+	if err != nil {
+		return 0, err
+	}
+	max, _ := MaxChannel(grid)
+	n := math.Round((frequency*1000-C_BAND_FIRST_FREQUENCY)/spacing) + 1
+	if n < 1 || n > float64(max) {
+		first, _ := ChannelFrequency(grid, 1)
+		last, _ := ChannelFrequency(grid, max)
+		return 0, fmt.Errorf("%sTHz is out of the C-band of %s: %sTHz..%sTHz", formatFrequency(frequency), enumName(grid), formatFrequency(first), formatFrequency(last))
+	}
+	ch := uint8(n)
+	centre, _ := ChannelFrequency(grid, ch)
+	if math.Abs(frequency-centre)*1000 > FREQUENCY_TOLERANCE {
+		return 0, fmt.Errorf("%sTHz isn't on %s, the nearest channel %d is at %sTHz", formatFrequency(frequency), enumName(grid), ch, formatFrequency(centre))
+	}
+	return ch, nil
+}
+
+// FlexgridFrequency returns the central frequency in THz of n of FLEXGRID
+func FlexgridFrequency(n int16) (float64, error) {
+	f := FLEXGRID_ANCHOR_FREQUENCY + float64(n)*FLEXGRID_GRANULARITY
+	if f < C_BAND_FIRST_FREQUENCY || f > C_BAND_LAST_FREQUENCY {
+		return 0, fmt.Errorf("central frequency %sTHz of n %d is out of the C-band", formatFrequency(f/1000), n)
+	}
+	return f / 1000, nil
+}
+
+// FlexgridN returns n of FLEXGRID whose central frequency is the frequency in THz
+func FlexgridN(frequency float64) (int16, error) {
+	n := math.Round((frequency*1000 - FLEXGRID_ANCHOR_FREQUENCY) / FLEXGRID_GRANULARITY)
+	if n < math.MinInt16 || n > math.MaxInt16 {
+		return 0, fmt.Errorf("%sTHz is out of the C-band", formatFrequency(frequency))
+	}
+	centre, err := FlexgridFrequency(int16(n))
+	if err != nil {
+		return 0, err
+	}
+	if math.Abs(frequency-centre)*1000 > FREQUENCY_TOLERANCE {
+		return 0, fmt.Errorf("%sTHz isn't on the 6.25GHz granularity of FLEXGRID, the nearest n %d is at %sTHz", formatFrequency(frequency), int16(n), formatFrequency(centre))
+	}
+	return int16(n), nil
+}
+
+// CentreFrequency returns the centre frequency in THz of the frequency config filled by FillTransportDefaultConfig
+func CentreFrequency(f *model.PacketTransponder_OpticalModule_OpticalModuleFrequency) (float64, error) {
+	if f.Grid != model.PacketTransport_FrequencyGridType_FLEXGRID {
+		if f.Channel == nil {
+			return 0, fmt.Errorf("channel isn't configured")
+		}
+		return ChannelFrequency(f.Grid, *f.Channel)
+	}
+	if f.CentralFrequencyN == nil || f.SlotWidthM == nil {
+		return 0, fmt.Errorf("central-frequency-n and slot-width-m are required for FLEXGRID")
+	}
+	if *f.SlotWidthM < 1 {
+		return 0, fmt.Errorf("slot-width-m can't be 0")
+	}
+	return FlexgridFrequency(*f.CentralFrequencyN)
+}
+
+func fillDefaultFrequencyConfig(f *model.PacketTransponder_OpticalModule_OpticalModuleFrequency) {
+	if f.Channel == nil {
+		f.Channel = ygot.Uint8(1)
+	}
+	if f.Grid == model.PacketTransport_FrequencyGridType_UNSET {
+		f.Grid = model.PacketTransport_FrequencyGridType_GRID_50GHZ
+	}
+	if f.Grid == model.PacketTransport_FrequencyGridType_FLEXGRID {
+		if f.CentralFrequencyN == nil {
+			f.CentralFrequencyN = ygot.Int16(0)
+		}
+		if f.SlotWidthM == nil {
+			f.SlotWidthM = ygot.Uint8(DEFAULT_SLOT_WIDTH_M)
+		}
+	}
+}
+
+// Wavelength converts the frequency in THz to the wavelength in vacuum in nm and vice versa
+func Wavelength(v float64) float64 {
+	return SPEED_OF_LIGHT / v
+}
+
+// ParseFrequency parses a frequency like "193.1THz" or a wavelength like "1552.52nm" and returns the frequency in THz
+func ParseFrequency(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	lower := strings.ToLower(s)
+	var unit string
+	for _, u := range []string{"thz", "nm"} {
+		if strings.HasSuffix(lower, u) {
+			unit = u
+			break
+		}
+	}
+	if unit == "" {
+		return 0, fmt.Errorf("unit of %s must be THz or nm", s)
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(s[:len(s)-len(unit)]), 64)
+	if err != nil || v <= 0 || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid frequency: %s", s)
+	}
+	if unit == "nm" {
+		return Wavelength(v), nil
+	}
+	return v, nil
+}
+
+func formatFrequency(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e6)/1e6, 'f', -1, 64)
+}
+
+func enumName(e ygot.GoEnum) string {
+	name, err := ygot.EnumName(e)
+	if err != nil || name == "" {
+		return "UNKNOWN"
+	}
+	return name
+}
+
+// fillFrequencyState fills the centre frequency and the wavelength of the configured channel.
+// the defaults of FillTransportDefaultConfig are used when they aren't configured.
+// nothing is filled for a channel out of the C-band which validation rejects
+func fillFrequencyState(t *model.PacketTransponder_OpticalModule) {
+	if t.OpticalModuleFrequency == nil {
+		t.OpticalModuleFrequency = &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{}
+	}
+	f := t.OpticalModuleFrequency
+	config := *f
+	fillDefaultFrequencyConfig(&config)
+	frequency, err := CentreFrequency(&config)
+	if err != nil {
+		return
+	}
+	f.Frequency = ygot.Float64(math.Round(frequency*1e6) / 1e6)
+	f.Wavelength = ygot.Float64(math.Round(Wavelength(frequency)*1e3) / 1e3)
+}
@@ -0,0 +1,122 @@
+package sonic
+
+import (
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+func TestFrequencyChannel(t *testing.T) {
+	for _, c := range []struct {
+		grid      model.E_PacketTransport_FrequencyGridType
+		frequency string
+		channel   uint8
+	}{
+		{model.PacketTransport_FrequencyGridType_GRID_100GHZ, "191.3THz", 1},
+		{model.PacketTransport_FrequencyGridType_GRID_100GHZ, "196.1THz", 49},
+		{model.PacketTransport_FrequencyGridType_GRID_50GHZ, "193.10THz", 37},
+		{model.PacketTransport_FrequencyGridType_GRID_50GHZ, "1552.52nm", 37},
+		{model.PacketTransport_FrequencyGridType_GRID_33GHZ, "191.3333THz", 2},
+		{model.PacketTransport_FrequencyGridType_GRID_33GHZ, "196.1thz", 145},
+		{model.PacketTransport_FrequencyGridType_GRID_25GHZ, "193.125THz", 74},
+	} {
+		f, err := ParseFrequency(c.frequency)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ch, err := FrequencyChannel(c.grid, f)
+		if err != nil {
+			t.Errorf("%s: %v", c.frequency, err)
+		} else if ch != c.channel {
+			t.Errorf("%s: expected channel %d, got %d", c.frequency, c.channel, ch)
+		}
+	}
+
+	for _, c := range []struct {
+		grid      model.E_PacketTransport_FrequencyGridType
+		frequency float64
+	}{
+		// out of the C-band
+		{model.PacketTransport_FrequencyGridType_GRID_50GHZ, 191.25},
+		{model.PacketTransport_FrequencyGridType_GRID_25GHZ, 196.125},
+		// not on the grid
+		{model.PacketTransport_FrequencyGridType_GRID_100GHZ, 193.15},
+		{model.PacketTransport_FrequencyGridType_GRID_33GHZ, 191.33},
+	} {
+		if _, err := FrequencyChannel(c.grid, c.frequency); err == nil {
+			t.Errorf("%vTHz is accepted for %s", c.frequency, enumName(c.grid))
+		}
+	}
+	for _, s := range []string{"193.1", "193.1GHz", "-1nm", "THz"} {
+		if _, err := ParseFrequency(s); err == nil {
+			t.Errorf("%s is accepted", s)
+		}
+	}
+	if _, err := ChannelFrequency(model.PacketTransport_FrequencyGridType_GRID_100GHZ, 50); err == nil {
+		t.Error("channel out of the C-band is accepted")
+	}
+}
+
+func TestFlexgrid(t *testing.T) {
+	for _, c := range []struct {
+		frequency string
+		n         int16
+	}{
+		{"193.1THz", 0},
+		{"193.05625THz", -7},
+		{"1552.52nm", 0},
+		{"196.1THz", 480},
+		{"191.3THz", -288},
+	} {
+		f, err := ParseFrequency(c.frequency)
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := FlexgridN(f)
+		if err != nil {
+			t.Errorf("%s: %v", c.frequency, err)
+		} else if n != c.n {
+			t.Errorf("%s: expected n %d, got %d", c.frequency, c.n, n)
+		}
+	}
+	for _, f := range []float64{193.103, 191.29375, 196.10625, 1000} {
+		if _, err := FlexgridN(f); err == nil {
+			t.Errorf("%vTHz is accepted", f)
+		}
+	}
+
+	config := &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{
+		Grid:              model.PacketTransport_FrequencyGridType_FLEXGRID,
+		CentralFrequencyN: ygot.Int16(-7),
+	}
+	if _, err := CentreFrequency(config); err == nil {
+		t.Error("FLEXGRID without slot-width-m is accepted")
+	}
+	fillDefaultFrequencyConfig(config)
+	if f, err := CentreFrequency(config); err != nil || f != 193.05625 || *config.SlotWidthM != DEFAULT_SLOT_WIDTH_M {
+		t.Errorf("unexpected frequency: %v, %v", f, err)
+	}
+}
+
+func TestFillFrequencyState(t *testing.T) {
+	m := &model.PacketTransponder_OpticalModule{}
+	fillFrequencyState(m)
+	// channel 1 of GRID_50GHZ by default
+	if f := m.OpticalModuleFrequency; *f.Frequency != 191.3 || *f.Wavelength != 1567.133 {
+		t.Errorf("unexpected state: %v, %v", *f.Frequency, *f.Wavelength)
+	}
+
+	m.OpticalModuleFrequency = &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{
+		Grid:    model.PacketTransport_FrequencyGridType_GRID_33GHZ,
+		Channel: ygot.Uint8(2),
+	}
+	fillFrequencyState(m)
+	if f := m.OpticalModuleFrequency; *f.Frequency != 191.333333 || *f.Wavelength != 1566.860 {
+		t.Errorf("unexpected state: %v, %v", *f.Frequency, *f.Wavelength)
+	}
+	if err := m.OpticalModuleFrequency.Validate(); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,6 +1,7 @@
 package sonic
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,39 +9,157 @@ import (
 	"github.com/openconfig/ygot/ygot"
 
 	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
 )
 
 const (
-	VLAN_TABLE        = "VLAN"
-	VLAN_MEMBER_TABLE = "VLAN_MEMBER"
-	PORT_TABLE        = "PORT_TABLE"
+	VLAN_TABLE             = "VLAN"
+	VLAN_MEMBER_TABLE      = "VLAN_MEMBER"
+	PORT_TABLE             = "PORT_TABLE"
+	CONFIG_PORT_TABLE      = "PORT"
+	COUNTERS_PORT_NAME_MAP = "COUNTERS_PORT_NAME_MAP"
+	COUNTERS_TABLE         = "COUNTERS"
 )
 
+const (
+	DEFAULT_MTU = 1500
+)
+
+// interface config leaves and CONFIG_DB PORT fields they are written to
+var portConfigFieldMap = map[string]string{
+	"enabled":        "admin_status",
+	"mtu":            "mtu",
+	"auto-negotiate": "autoneg",
+	"description":    "description",
+	"port-speed":     "speed",
+}
+
+var portSpeedMap = map[model.E_OpenconfigIfEthernet_ETHERNET_SPEED]int{
+	model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_10GB:  10000,
+	model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_20GB:  20000,
+	model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB:  25000,
+	model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB:  40000,
+	model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_50GB:  50000,
+	model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB: 100000,
+}
+
+type counterField func(*model.PacketTransponder_Interface_Counters) **uint64
+
+// SAI port statistics stored in COUNTERS_DB and the counters they are mapped to
+var portStatMap = map[string]counterField{
+	"SAI_PORT_STAT_IF_IN_OCTETS":                     func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InOctets },
+	"SAI_PORT_STAT_IF_IN_UCAST_PKTS":                 func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InUnicastPkts },
+	"SAI_PORT_STAT_IF_IN_BROADCAST_PKTS":             func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InBroadcastPkts },
+	"SAI_PORT_STAT_IF_IN_MULTICAST_PKTS":             func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InMulticastPkts },
+	"SAI_PORT_STAT_IF_IN_DISCARDS":                   func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InDiscards },
+	"SAI_PORT_STAT_IF_IN_ERRORS":                     func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InErrors },
+	"SAI_PORT_STAT_IF_IN_UNKNOWN_PROTOS":             func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InUnknownProtos },
+	"SAI_PORT_STAT_ETHER_STATS_CRC_ALIGN_ERRORS":     func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InFcsErrors },
+	"SAI_PORT_STAT_IF_IN_FEC_CORRECTABLE_FRAMES":     func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InRsFecCorrectedCodewords },
+	"SAI_PORT_STAT_IF_IN_FEC_NOT_CORRECTABLE_FRAMES": func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InRsFecUncorrectedCodewords },
+	"SAI_PORT_STAT_IF_OUT_OCTETS":                    func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.OutOctets },
+	"SAI_PORT_STAT_IF_OUT_UCAST_PKTS":                func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.OutUnicastPkts },
+	"SAI_PORT_STAT_IF_OUT_BROADCAST_PKTS":            func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.OutBroadcastPkts },
+	"SAI_PORT_STAT_IF_OUT_MULTICAST_PKTS":            func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.OutMulticastPkts },
+	"SAI_PORT_STAT_IF_OUT_DISCARDS":                  func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.OutDiscards },
+	"SAI_PORT_STAT_IF_OUT_ERRORS":                    func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.OutErrors },
+}
+
+// counters which are the sum of SAI port statistics
+var portStatSumMap = map[string]struct {
+	field counterField
+	stats []string
+}{
+	"in-pkts": {
+		field: func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.InPkts },
+		stats: []string{"SAI_PORT_STAT_IF_IN_UCAST_PKTS", "SAI_PORT_STAT_IF_IN_NON_UCAST_PKTS"},
+	},
+	"out-pkts": {
+		field: func(c *model.PacketTransponder_Interface_Counters) **uint64 { return &c.OutPkts },
+		stats: []string{"SAI_PORT_STAT_IF_OUT_UCAST_PKTS", "SAI_PORT_STAT_IF_OUT_NON_UCAST_PKTS"},
+	},
+}
+
 func OptEthernetName(m *model.PacketTransponder_Interface_OpticalModuleConnection_OpticalModule) (string, error) {
 	if m == nil || m.Name == nil || m.Channel == nil {
 		return "", fmt.Errorf("module is nil")
 	}
-	name := *m.Name
-	ch := *m.Channel
-
-	index, err := strconv.Atoi(name[len("Opt"):])
+	module, err := platform.Current().OpticalModule(*m.Name)
+	if err != nil {
+		return "", err
+	}
+	ch, err := module.Channel(*m.Channel)
 	if err != nil {
 		return "", err
 	}
-	subindex := 0
-	if ch == "B" {
-		subindex = 1
+	return ch.Interface, nil
+}
+
+func onOff(v *bool) string {
+	if v != nil && *v {
+		return "on"
 	}
-	return fmt.Sprintf("Ethernet%d", 2*index-1+subindex+16), nil
+	return "off"
+}
+
+// PortConfigEntry returns the CONFIG_DB PORT fields of the interface.
+// leaves which are not configured are filled with the default values
+func PortConfigEntry(i *model.PacketTransponder_Interface) map[string]string {
+	adminStatus := "up"
+	if i.Enabled != nil && !*i.Enabled {
+		adminStatus = "down"
+	}
+	mtu := uint16(DEFAULT_MTU)
+	if i.Mtu != nil {
+		mtu = *i.Mtu
+	}
+	description := ""
+	if i.Description != nil {
+		description = *i.Description
+	}
+	speed := ""
+	if v, ok := portSpeedMap[i.PortSpeed]; ok {
+		speed = strconv.Itoa(v)
+	}
+	return map[string]string{
+		"speed":        speed,
+		"admin_status": adminStatus,
+		"mtu":          strconv.Itoa(int(mtu)),
+		"autoneg":      onOff(i.AutoNegotiate),
+		"description":  description,
+	}
+}
+
+// SONiC has no knob for IEEE 802.3x PAUSE, so enable-flow-control can't be enabled
+var ErrFlowControlUnsupported = errors.New("enable-flow-control is not supported: SONiC can't configure IEEE 802.3x PAUSE")
+
+// ValidateInterface returns an error when the interface has leaves SONiC can't apply
+func ValidateInterface(i *model.PacketTransponder_Interface) error {
+	if i.EnableFlowControl != nil && *i.EnableFlowControl {
+		return ErrFlowControlUnsupported
+	}
+	return nil
+}
+
+// BreakoutChanged returns true when the breakout mode of the port is changed.
+// the caller is responsible to reconfigure the port
+func BreakoutChanged(task []DiffTask) bool {
+	for _, t := range task {
+		if strings.HasPrefix(t.Path.String(), "breakout-mode.") {
+			return true
+		}
+	}
+	return false
 }
 
 func HandlePortDiff(name string, task []DiffTask) (bool, error) {
-	if !strings.HasPrefix(name, "Port") {
-		return false, fmt.Errorf("invalid optical-module name: %s", name)
+	if _, err := platform.Current().Port(name); err != nil {
+		return false, err
 	}
 	for _, t := range task {
 		switch path := t.Path.String(); path {
 		case "description":
+		case "breakout-mode.num-channels", "breakout-mode.channel-speed":
 		default:
 			return true, nil
 		}
@@ -49,20 +168,26 @@ func HandlePortDiff(name string, task []DiffTask) (bool, error) {
 }
 
 func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name string, task []DiffTask) error {
-	if !strings.HasPrefix(name, "Ethernet") {
-		return fmt.Errorf("invalid optical-module name: %s", name)
+	if _, _, err := platform.Current().PortOf(name); err != nil {
+		return err
 	}
 
 	modEther := false
 	modOpt := false
 	delOld := false
+	portFields := []string{}
 
 	for _, t := range task {
+		path := t.Path.String()
+		if f, ok := portConfigFieldMap[path]; ok {
+			portFields = append(portFields, f)
+			continue
+		}
 		if t.Type == DiffDeleted {
 			delOld = true
 			break
 		}
-		switch path := t.Path.String(); path {
+		switch path {
 		case "optical-module-connection.optical-module.channel":
 			modOpt = true
 		case "optical-module-connection.optical-module.name":
@@ -70,31 +195,46 @@ func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name str
 		case "optical-module-connection.id":
 			modOpt = true
 			modEther = true
-		case "mtu", "name", "port-speed":
+		case "name":
+		case "enable-flow-control":
+			// only disabled flow control passes the validation, which is what the ports do
+		case "mac-address":
+			// SONiC uses the system MAC address from DEVICE_METADATA for all ports
+			fmt.Printf("mac-address of %s is not applied to the data plane\n", name)
 		default:
 			fmt.Println("unhandled task:", path)
 		}
 	}
 
-	if i := newConfig.Interface[name]; i == nil || i.OpticalModuleConnection == nil {
-		return nil
-	}
-
-	client, err := NewSONiCDBClient("unix", DEFAULT_REDIS_UNIX_SOCKET, CONFIG_DB)
+	client, err := GetClient(CONFIG_DB)
 	if err != nil {
 		return err
 	}
+	// the writes are applied at once so that the VLAN and its members stay consistent
+	b := client.Batch()
+
+	if i := newConfig.Interface[name]; i != nil && len(portFields) > 0 {
+		port := PortConfigEntry(i)
+		entry := make(map[string]interface{}, len(portFields))
+		for _, f := range portFields {
+			entry[f] = port[f]
+		}
+		b.ModEntry(CONFIG_PORT_TABLE, name, entry)
+	}
+
+	if i := newConfig.Interface[name]; !delOld && (i == nil || i.OpticalModuleConnection == nil) {
+		return b.Commit()
+	}
 
 	// get current vlan
 	var oldVlanName string
-	var oldVlan map[string]interface{}
+	oldVlan := &VlanEntry{}
 	var oldOptName string
 	if i := oldConfig.Interface[name]; i != nil {
 		if c := i.OpticalModuleConnection; c != nil && c.Id != nil {
 			oldVid := *c.Id
 			oldVlanName = fmt.Sprintf("Vlan%d", oldVid)
-			oldVlan, err = client.GetEntry(VLAN_TABLE, oldVlanName)
-			if err != nil {
+			if _, err = client.LoadEntry(VLAN_TABLE, oldVlanName, oldVlan); err != nil {
 				return err
 			}
 			if c.OpticalModule != nil {
@@ -105,46 +245,34 @@ func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name str
 
 	if delOld {
 		if oldVlanName == "" {
-			return nil
+			return b.Commit()
 		}
-		err = client.ModEntry(VLAN_TABLE, oldVlanName, nil)
-		if err != nil {
-			return err
-		}
-		value, ok := oldVlan["members"]
-		if ok {
-			members := value.([]string)
-			for _, m := range members {
-				key := strings.Join([]string{oldVlanName, m}, "|")
-				err = client.ModEntry(VLAN_MEMBER_TABLE, key, nil)
-				if err != nil {
-					return err
-				}
-			}
+		b.ModEntry(VLAN_TABLE, oldVlanName, nil)
+		for _, m := range oldVlan.Members {
+			key := strings.Join([]string{oldVlanName, m}, "|")
+			b.ModEntry(VLAN_MEMBER_TABLE, key, nil)
 		}
-		return nil
+		return b.Commit()
 	}
 
 	// OpticalModuleConnection always exists
 	// since we do validation before coming here
 	newVid := *newConfig.Interface[name].OpticalModuleConnection.Id
 	vlanName := fmt.Sprintf("Vlan%d", newVid)
-	newVlan, err := client.GetEntry(VLAN_TABLE, vlanName)
+	newVlan := &VlanEntry{}
+	exists, err := client.LoadEntry(VLAN_TABLE, vlanName, newVlan)
 	if err != nil {
 		return err
 	}
 
 	entry := map[string]interface{}{}
 
-	if len(newVlan) == 0 {
+	if !exists {
 		entry["vlanid"] = newVid
 	}
 
-	var newVlanMembers []string
-	m, ok := newVlan["members"]
-	if ok {
-		newVlanMembers = m.([]string)
-	} else {
+	newVlanMembers := newVlan.Members
+	if newVlanMembers == nil {
 		newVlanMembers = []string{}
 	}
 
@@ -161,7 +289,7 @@ func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name str
 	}
 
 	if !modOpt && !modEther {
-		return nil
+		return b.Commit()
 	}
 
 	if modEther {
@@ -170,13 +298,10 @@ func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name str
 			key := strings.Join([]string{oldVlanName, name}, "|")
 			// Interface is currently restricted to belongs to only one VLAN
 			// we can safely remove the old VLAN_MEMBER entry
-			err = client.ModEntry(VLAN_MEMBER_TABLE, key, nil)
-			if err != nil {
-				return err
-			}
+			b.ModEntry(VLAN_MEMBER_TABLE, key, nil)
 		}
 		key := strings.Join([]string{vlanName, name}, "|")
-		err = client.SetEntry(VLAN_MEMBER_TABLE, key, map[string]interface{}{
+		err = b.SetEntry(VLAN_MEMBER_TABLE, key, map[string]interface{}{
 			"tagging_mode": "untagged",
 		})
 		if err != nil {
@@ -185,14 +310,10 @@ func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name str
 	}
 
 	if modOpt {
-		newVlanMembers = append(newVlanMembers, optName)
 		if oldVlanName != "" && oldOptName != "" {
 			key := strings.Join([]string{oldVlanName, oldOptName}, "|")
-			err = client.ModEntry(VLAN_MEMBER_TABLE, key, nil)
-			if err != nil {
-				return err
-			}
-			ms := make([]string, 0, len(newVlanMembers)-1)
+			b.ModEntry(VLAN_MEMBER_TABLE, key, nil)
+			ms := make([]string, 0, len(newVlanMembers))
 			for _, m := range newVlanMembers {
 				if m == oldOptName {
 					continue
@@ -201,8 +322,9 @@ func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name str
 			}
 			newVlanMembers = ms
 		}
+		newVlanMembers = append(newVlanMembers, optName)
 		key := strings.Join([]string{vlanName, optName}, "|")
-		err = client.SetEntry(VLAN_MEMBER_TABLE, key, map[string]interface{}{
+		err = b.SetEntry(VLAN_MEMBER_TABLE, key, map[string]interface{}{
 			"tagging_mode": "tagged",
 		})
 		if err != nil {
@@ -213,70 +335,122 @@ func HandleInterfaceDiff(newConfig, oldConfig *model.PacketTransponder, name str
 	entry["members"] = newVlanMembers
 
 	if oldVlanName != "" {
-		oldVlanMembers := oldVlan["members"].([]string)
 		oldVlanUpdatedMembers := []string{}
-		for _, v := range oldVlanMembers {
+		for _, v := range oldVlan.Members {
 			if v == name && modEther {
 				continue
 			}
 			if v == optName && modOpt {
 				continue
 			}
-			oldVlanUpdatedMembers = append(oldVlanUpdatedMembers, name)
+			oldVlanUpdatedMembers = append(oldVlanUpdatedMembers, v)
 		}
 		if len(oldVlanUpdatedMembers) == 0 {
-			err = client.ModEntry(VLAN_TABLE, oldVlanName, nil)
-			if err != nil {
-				return err
-			}
+			b.ModEntry(VLAN_TABLE, oldVlanName, nil)
 		} else {
-			err = client.ModEntry(VLAN_TABLE, oldVlanName, map[string]interface{}{
+			b.ModEntry(VLAN_TABLE, oldVlanName, map[string]interface{}{
 				"members": oldVlanUpdatedMembers,
 			})
 		}
 	}
-	return client.ModEntry(VLAN_TABLE, vlanName, entry)
+	b.ModEntry(VLAN_TABLE, vlanName, entry)
+	return b.Commit()
 }
 
 func FillInterfaceState(name string, t *model.PacketTransponder_Interface) error {
 	if t == nil {
 		return fmt.Errorf("model is nil")
 	}
-	client, err := NewSONiCDBClient("unix", DEFAULT_REDIS_UNIX_SOCKET, APPL_DB)
+	client, err := GetClient(APPL_DB)
 	if err != nil {
 		return err
 	}
 
-	entry, err := client.GetEntry(PORT_TABLE, name)
-	if err != nil {
+	port := &PortEntry{}
+	if _, err = client.LoadEntry(PORT_TABLE, name, port); err != nil {
 		return err
 	}
-	if s, ok := entry["mtu"]; ok {
-		mtu, err := strconv.ParseUint(s.(string), 10, 16)
+	if port.MTU != "" {
+		mtu, err := strconv.ParseUint(port.MTU, 10, 16)
 		if err != nil {
 			return err
 		}
 		t.Mtu = ygot.Uint16(uint16(mtu))
 	}
 
-	if s, ok := entry["admin_status"]; ok {
-		switch s.(string) {
-		case "up":
-			t.AdminStatus = model.OpenconfigInterfaces_Interface_AdminStatus_UP
-		case "down":
-			t.AdminStatus = model.OpenconfigInterfaces_Interface_AdminStatus_DOWN
-		}
+	switch port.AdminStatus {
+	case "up":
+		t.AdminStatus = model.OpenconfigInterfaces_Interface_AdminStatus_UP
+	case "down":
+		t.AdminStatus = model.OpenconfigInterfaces_Interface_AdminStatus_DOWN
+	}
+
+	switch port.OperStatus {
+	case "up":
+		t.OperStatus = model.OpenconfigInterfaces_Interface_OperStatus_UP
+	case "down":
+		t.OperStatus = model.OpenconfigInterfaces_Interface_OperStatus_DOWN
+	}
+
+	return FillInterfaceCounters(name, t)
+}
+
+func FillInterfaceCounters(name string, t *model.PacketTransponder_Interface) error {
+	if t == nil {
+		return fmt.Errorf("model is nil")
+	}
+	client, err := GetClient(COUNTERS_DB)
+	if err != nil {
+		return err
+	}
+
+	nameMap, err := client.GetHash(COUNTERS_PORT_NAME_MAP)
+	if err != nil {
+		return err
+	}
+	oid, ok := nameMap[name]
+	if !ok {
+		// orchagent hasn't created the port yet
+		return nil
 	}
 
-	if s, ok := entry["oper_status"]; ok {
-		switch s.(string) {
-		case "up":
-			t.OperStatus = model.OpenconfigInterfaces_Interface_OperStatus_UP
-		case "down":
-			t.OperStatus = model.OpenconfigInterfaces_Interface_OperStatus_DOWN
+	entry, err := client.GetEntry(COUNTERS_TABLE, oid)
+	if err != nil {
+		return err
+	}
+
+	stats := make(map[string]uint64, len(entry))
+	for k, v := range entry {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid counter %s for %s: %v", k, name, err)
 		}
+		stats[k] = n
 	}
 
+	counters := &model.PacketTransponder_Interface_Counters{}
+	for stat, field := range portStatMap {
+		if v, ok := stats[stat]; ok {
+			*field(counters) = ygot.Uint64(v)
+		}
+	}
+	for _, sum := range portStatSumMap {
+		var acc uint64
+		found := false
+		for _, stat := range sum.stats {
+			if v, ok := stats[stat]; ok {
+				acc += v
+				found = true
+			}
+		}
+		if found {
+			*sum.field(counters) = ygot.Uint64(acc)
+		}
+	}
+	t.Counters = counters
 	return nil
-
 }
@@ -0,0 +1,214 @@
+package sonic
+
+import (
+	"reflect"
+	"testing"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+func connectInterface(t *testing.T, m *model.PacketTransponder, name string, id uint32, module, channel string) {
+	i, err := m.NewInterface(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i.OpticalModuleConnection = &model.PacketTransponder_Interface_OpticalModuleConnection{
+		Id: ygot.Uint32(id),
+		OpticalModule: &model.PacketTransponder_Interface_OpticalModuleConnection_OpticalModule{
+			Name:    ygot.String(module),
+			Channel: ygot.String(channel),
+		},
+	}
+}
+
+func uintVal(v uint64) *gnmipb.TypedValue {
+	return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_UintVal{UintVal: v}}
+}
+
+func TestHandleInterfaceDiffPortFields(t *testing.T) {
+	_, store := newTestClient(t, CONFIG_DB)
+	db := store.DB(CONFIG_DB)
+	db.HMSet("PORT|Ethernet1", map[string]interface{}{"lanes": "1", "mtu": "1500"})
+
+	newConfig := &model.PacketTransponder{}
+	i, _ := newConfig.NewInterface("Ethernet1")
+	i.Mtu = ygot.Uint16(9000)
+	i.Enabled = ygot.Bool(false)
+	err := HandleInterfaceDiff(newConfig, &model.PacketTransponder{}, "Ethernet1", []DiffTask{
+		{Type: DiffModified, Path: configPath("config", "mtu"), Value: uintVal(9000)},
+		{Type: DiffModified, Path: configPath("config", "enabled"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_BoolVal{BoolVal: false}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _ := db.HGetAll("PORT|Ethernet1")
+	expected := map[string]string{"lanes": "1", "mtu": "9000", "admin_status": "down"}
+	if !reflect.DeepEqual(h, expected) {
+		t.Errorf("expected %v, got %v", expected, h)
+	}
+}
+
+func TestInterfaceFlowControl(t *testing.T) {
+	_, store := newTestClient(t, CONFIG_DB)
+	db := store.DB(CONFIG_DB)
+	db.HMSet("PORT|Ethernet1", map[string]interface{}{"lanes": "1", "mtu": "1500"})
+
+	newConfig := &model.PacketTransponder{}
+	i, _ := newConfig.NewInterface("Ethernet1")
+	i.EnableFlowControl = ygot.Bool(true)
+	if err := ValidateInterface(i); err != ErrFlowControlUnsupported {
+		t.Errorf("unexpected error for enabled flow control: %v", err)
+	}
+	i.EnableFlowControl = ygot.Bool(false)
+	if err := ValidateInterface(i); err != nil {
+		t.Fatal(err)
+	}
+	// disabled flow control doesn't touch PFC
+	err := HandleInterfaceDiff(newConfig, &model.PacketTransponder{}, "Ethernet1", []DiffTask{
+		{Type: DiffModified, Path: configPath("config", "enable-flow-control"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_BoolVal{BoolVal: false}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _ := db.HGetAll("PORT|Ethernet1")
+	expected := map[string]string{"lanes": "1", "mtu": "1500"}
+	if !reflect.DeepEqual(h, expected) {
+		t.Errorf("expected %v, got %v", expected, h)
+	}
+	if _, ok := PortConfigEntry(i)["pfc_asym"]; ok {
+		t.Error("pfc_asym is written for flow control")
+	}
+}
+
+func TestHandleInterfaceDiffVlan(t *testing.T) {
+	client, store := newTestClient(t, CONFIG_DB)
+	db := store.DB(CONFIG_DB)
+
+	// connect Ethernet1 to channel A of Opt1
+	oldConfig := &model.PacketTransponder{}
+	oldConfig.NewInterface("Ethernet1")
+	newConfig := &model.PacketTransponder{}
+	connectInterface(t, newConfig, "Ethernet1", 100, "Opt1", "A")
+	err := HandleInterfaceDiff(newConfig, oldConfig, "Ethernet1", []DiffTask{
+		{Type: DiffModified, Path: configPath("optical-module-connection", "config", "id"), Value: uintVal(100)},
+		{Type: DiffModified, Path: configPath("optical-module-connection", "optical-module", "config", "name"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{StringVal: "Opt1"}}},
+		{Type: DiffModified, Path: configPath("optical-module-connection", "optical-module", "config", "channel"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{StringVal: "A"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vlans, _ := client.GetTable(VLAN_TABLE)
+	expectedVlans := map[string]map[string]interface{}{
+		"Vlan100": {"vlanid": "100", "members": []string{"Ethernet1", "Ethernet17"}},
+	}
+	if !reflect.DeepEqual(vlans, expectedVlans) {
+		t.Errorf("expected %v, got %v", expectedVlans, vlans)
+	}
+	members, _ := client.GetTable(VLAN_MEMBER_TABLE)
+	expectedMembers := map[string]map[string]interface{}{
+		"Vlan100|Ethernet1":  {"tagging_mode": "untagged"},
+		"Vlan100|Ethernet17": {"tagging_mode": "tagged"},
+	}
+	if !reflect.DeepEqual(members, expectedMembers) {
+		t.Errorf("expected %v, got %v", expectedMembers, members)
+	}
+
+	// move Ethernet1 to VLAN 200. the other members of VLAN 100 stay
+	db.HMSet("VLAN|Vlan100", map[string]interface{}{"members@": "Ethernet1,Ethernet17,Ethernet2"})
+	oldConfig, newConfig = newConfig, &model.PacketTransponder{}
+	connectInterface(t, newConfig, "Ethernet1", 200, "Opt1", "A")
+	err = HandleInterfaceDiff(newConfig, oldConfig, "Ethernet1", []DiffTask{
+		{Type: DiffModified, Path: configPath("optical-module-connection", "config", "id"), Value: uintVal(200)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	vlans, _ = client.GetTable(VLAN_TABLE)
+	expectedVlans = map[string]map[string]interface{}{
+		"Vlan100": {"vlanid": "100", "members": []string{"Ethernet2"}},
+		"Vlan200": {"vlanid": "200", "members": []string{"Ethernet1", "Ethernet17"}},
+	}
+	if !reflect.DeepEqual(vlans, expectedVlans) {
+		t.Errorf("expected %v, got %v", expectedVlans, vlans)
+	}
+	members, _ = client.GetTable(VLAN_MEMBER_TABLE)
+	expectedMembers = map[string]map[string]interface{}{
+		"Vlan200|Ethernet1":  {"tagging_mode": "untagged"},
+		"Vlan200|Ethernet17": {"tagging_mode": "tagged"},
+	}
+	if !reflect.DeepEqual(members, expectedMembers) {
+		t.Errorf("expected %v, got %v", expectedMembers, members)
+	}
+
+	// remove the interface
+	oldConfig, newConfig = newConfig, &model.PacketTransponder{}
+	err = HandleInterfaceDiff(newConfig, oldConfig, "Ethernet1", []DiffTask{
+		{Type: DiffDeleted, Path: configPath("name")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, _ := db.HGetAll("VLAN|Vlan200"); len(h) != 0 {
+		t.Errorf("Vlan200 is not removed: %v", h)
+	}
+	if members, _ = client.GetTable(VLAN_MEMBER_TABLE); len(members) != 0 {
+		t.Errorf("members are not removed: %v", members)
+	}
+}
+
+func TestFillInterfaceCounters(t *testing.T) {
+	_, store := newTestClient(t, COUNTERS_DB)
+	db := store.DB(COUNTERS_DB)
+	db.HMSet("COUNTERS_PORT_NAME_MAP", map[string]interface{}{"Ethernet1": "oid:0x1000000000002"})
+	db.HMSet("COUNTERS:oid:0x1000000000002", map[string]interface{}{
+		"SAI_PORT_STAT_IF_IN_OCTETS":                     "123456",
+		"SAI_PORT_STAT_IF_IN_UCAST_PKTS":                 "100",
+		"SAI_PORT_STAT_IF_IN_NON_UCAST_PKTS":             "20",
+		"SAI_PORT_STAT_IF_IN_MULTICAST_PKTS":             "15",
+		"SAI_PORT_STAT_IF_IN_ERRORS":                     "3",
+		"SAI_PORT_STAT_ETHER_STATS_CRC_ALIGN_ERRORS":     "2",
+		"SAI_PORT_STAT_IF_IN_FEC_CORRECTABLE_FRAMES":     "4000",
+		"SAI_PORT_STAT_IF_IN_FEC_NOT_CORRECTABLE_FRAMES": "5",
+		"SAI_PORT_STAT_IF_OUT_OCTETS":                    "654321",
+		"SAI_PORT_STAT_IF_OUT_UCAST_PKTS":                "200",
+		"SAI_PORT_STAT_IF_OUT_DISCARDS":                  "7",
+		// statistics which aren't mapped are ignored
+		"SAI_PORT_STAT_PFC_0_RX_PKTS": "9",
+	})
+
+	i := &model.PacketTransponder_Interface{}
+	if err := FillInterfaceCounters("Ethernet1", i); err != nil {
+		t.Fatal(err)
+	}
+	expected := &model.PacketTransponder_Interface_Counters{
+		InOctets:                    ygot.Uint64(123456),
+		InUnicastPkts:               ygot.Uint64(100),
+		InMulticastPkts:             ygot.Uint64(15),
+		InPkts:                      ygot.Uint64(120),
+		InErrors:                    ygot.Uint64(3),
+		InFcsErrors:                 ygot.Uint64(2),
+		InRsFecCorrectedCodewords:   ygot.Uint64(4000),
+		InRsFecUncorrectedCodewords: ygot.Uint64(5),
+		OutOctets:                   ygot.Uint64(654321),
+		OutUnicastPkts:              ygot.Uint64(200),
+		OutPkts:                     ygot.Uint64(200),
+		OutDiscards:                 ygot.Uint64(7),
+	}
+	if !reflect.DeepEqual(i.Counters, expected) {
+		t.Errorf("expected %+v, got %+v", expected, i.Counters)
+	}
+
+	// orchagent hasn't created the port yet
+	i = &model.PacketTransponder_Interface{}
+	if err := FillInterfaceCounters("Ethernet2", i); err != nil || i.Counters != nil {
+		t.Errorf("unexpected counters of the port without oid: %v %v", i.Counters, err)
+	}
+
+	db.HMSet("COUNTERS:oid:0x1000000000002", map[string]interface{}{"SAI_PORT_STAT_IF_IN_OCTETS": "-1"})
+	if err := FillInterfaceCounters("Ethernet1", i); err == nil {
+		t.Error("expected error for an invalid counter")
+	}
+}
@@ -0,0 +1,284 @@
+package sonic
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+// performance monitoring bins of the optical modules like G.7710.
+// the bins are aligned to UTC and kept in <dir>/<module>.json
+
+const (
+	PM_DIR     = "pm"
+	PM_BIN_15M = "15m"
+	PM_BIN_24H = "24h"
+	// DEFAULT_PM_INTERVAL is the interval PMHistory samples the state
+	DEFAULT_PM_INTERVAL = time.Second
+	// the number of the completed bins kept by default
+	DEFAULT_PM_HISTORY_15M = 32
+	DEFAULT_PM_HISTORY_24H = 7
+	// PM_SAVE_INTERVAL is the interval the current bins are saved
+	PM_SAVE_INTERVAL = time.Minute
+	// PM_MIN_SAMPLE_RATIO is the ratio of the samples a completed bin needs not to be suspect
+	PM_MIN_SAMPLE_RATIO = 0.9
+)
+
+var pmBinDurations = map[string]time.Duration{
+	PM_BIN_15M: 15 * time.Minute,
+	PM_BIN_24H: 24 * time.Hour,
+}
+
+// PM_BIN_TYPES are the types of the bins in the order they are kept
+var PM_BIN_TYPES = []string{PM_BIN_15M, PM_BIN_24H}
+
+// PM_BER_NAMES are the BER values monitored per channel
+var PM_BER_NAMES = []string{"sd-fec-ber", "hd-fec-ber", "post-fec-ber"}
+
+// PM_RMS_NAMES are the RMS values monitored per optical module
+var PM_RMS_NAMES = []string{"xi", "xq", "yi", "yq"}
+
+// PMStats is the statistics of a value in a bin
+type PMStats struct {
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+	Sum     float64 `json:"sum"`
+	Samples int     `json:"samples"`
+}
+
+func (s *PMStats) add(v float64) {
+	if s.Samples == 0 || v < s.Min {
+		s.Min = v
+	}
+	if s.Samples == 0 || v > s.Max {
+		s.Max = v
+	}
+	s.Sum += v
+	s.Samples++
+}
+
+func (s *PMStats) Avg() float64 {
+	if s.Samples == 0 {
+		return 0
+	}
+	return s.Sum / float64(s.Samples)
+}
+
+// PMBin is a 15 minutes or 24 hours bin of an optical module
+type PMBin struct {
+	Module string    `json:"module"`
+	Type   string    `json:"type"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+	// true when the completed bin lacks samples, e.g. the server was down in the period
+	Suspect          bool      `json:"suspect,omitempty"`
+	Samples          int       `json:"samples"`
+	LastSample       time.Time `json:"last-sample"`
+	SyncErrorSeconds int       `json:"sync-error-seconds"`
+	// PM_RMS_NAMES to the statistics
+	Rms map[string]*PMStats `json:"rms,omitempty"`
+	// channel name to PM_BER_NAMES to the statistics
+	Channels map[string]map[string]*PMStats `json:"channels,omitempty"`
+}
+
+// Current returns true while the period of the bin hasn't ended
+func (b *PMBin) Current(now time.Time) bool {
+	return now.Before(b.End)
+}
+
+func (b *PMBin) stats(m map[string]*PMStats, name string) *PMStats {
+	s, ok := m[name]
+	if !ok {
+		s = &PMStats{}
+		m[name] = s
+	}
+	return s
+}
+
+func (b *PMBin) add(t *model.PacketTransponder_OpticalModule, interval time.Duration, now time.Time) {
+	b.Samples++
+	b.LastSample = now
+	if t.SyncError != nil && *t.SyncError {
+		b.SyncErrorSeconds += int(interval / time.Second)
+	}
+	if r := t.OpticalModuleRms; r != nil {
+		if b.Rms == nil {
+			b.Rms = map[string]*PMStats{}
+		}
+		for i, v := range []*uint16{r.Xi, r.Xq, r.Yi, r.Yq} {
+			if v != nil {
+				b.stats(b.Rms, PM_RMS_NAMES[i]).add(float64(*v))
+			}
+		}
+	}
+	for ch, s := range t.ChannelStats {
+		for i, v := range []*string{s.SdFecBer, s.HdFecBer, s.PostFecBer} {
+			ber, ok := parseBer(v)
+			if !ok {
+				continue
+			}
+			if b.Channels == nil {
+				b.Channels = map[string]map[string]*PMStats{}
+			}
+			if b.Channels[ch] == nil {
+				b.Channels[ch] = map[string]*PMStats{}
+			}
+			b.stats(b.Channels[ch], PM_BER_NAMES[i]).add(ber)
+		}
+	}
+}
+
+// PMHistory samples the state of the optical modules into the bins
+// and keeps the current bins and the latest completed bins
+type PMHistory struct {
+	sync.Mutex
+	dir      string
+	interval time.Duration
+	depth    map[string]int
+	// module name to bin type to the bins in the order of the start
+	bins  map[string]map[string][]*PMBin
+	saved map[string]time.Time
+}
+
+// NewPMHistory loads the bins kept in dir. empty dir keeps the bins only in memory.
+// depth is the number of the completed bins kept for each bin type
+func NewPMHistory(dir string, interval time.Duration, depth map[string]int) (*PMHistory, error) {
+	if interval < time.Second || interval%time.Second != 0 {
+		return nil, fmt.Errorf("PM interval must be a multiple of a second: %s", interval)
+	}
+	h := &PMHistory{
+		dir:      dir,
+		interval: interval,
+		depth:    map[string]int{},
+		bins:     map[string]map[string][]*PMBin{},
+		saved:    map[string]time.Time{},
+	}
+	for _, typ := range PM_BIN_TYPES {
+		d, ok := depth[typ]
+		if !ok || d < 0 {
+			return nil, fmt.Errorf("invalid PM history depth of %s bins: %d", typ, d)
+		}
+		h.depth[typ] = d
+	}
+	if dir == "" {
+		return h, nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		bins, err := loadPMFile(f)
+		if err != nil {
+			return nil, err
+		}
+		for i := range bins {
+			b := &bins[i]
+			if h.bins[b.Module] == nil {
+				h.bins[b.Module] = map[string][]*PMBin{}
+			}
+			h.bins[b.Module][b.Type] = append(h.bins[b.Module][b.Type], b)
+		}
+	}
+	return h, nil
+}
+
+func loadPMFile(path string) ([]PMBin, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	bins := []PMBin{}
+	if err = json.Unmarshal(data, &bins); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return bins, nil
+}
+
+// LoadPMBins reads the bins of the type kept by PMHistory in dir for the optical module
+func LoadPMBins(dir, module, typ string) ([]PMBin, error) {
+	if _, ok := pmBinDurations[typ]; !ok {
+		return nil, fmt.Errorf("unknown bin type: %s", typ)
+	}
+	bins, err := loadPMFile(filepath.Join(dir, module+".json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []PMBin{}, nil
+		}
+		return nil, err
+	}
+	selected := []PMBin{}
+	for _, b := range bins {
+		if b.Type == typ {
+			selected = append(selected, b)
+		}
+	}
+	return selected, nil
+}
+
+func (h *PMHistory) save(module string) error {
+	if h.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(h.dir, 0755); err != nil {
+		return err
+	}
+	bins := []*PMBin{}
+	for _, typ := range PM_BIN_TYPES {
+		bins = append(bins, h.bins[module][typ]...)
+	}
+	data, err := json.MarshalIndent(bins, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(h.dir, module+".json")
+	tmp := path + ".tmp"
+	if err = ioutil.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Add samples the state of the optical module filled by FillTransportState
+func (h *PMHistory) Add(name string, t *model.PacketTransponder_OpticalModule, now time.Time) error {
+	h.Lock()
+	defer h.Unlock()
+	if h.bins[name] == nil {
+		h.bins[name] = map[string][]*PMBin{}
+	}
+	completed := false
+	for _, typ := range PM_BIN_TYPES {
+		d := pmBinDurations[typ]
+		start := now.UTC().Truncate(d)
+		bins := h.bins[name][typ]
+		var cur *PMBin
+		if n := len(bins); n > 0 && bins[n-1].Start.Equal(start) {
+			cur = bins[n-1]
+		} else {
+			if n := len(bins); n > 0 {
+				prev := bins[n-1]
+				prev.Suspect = float64(time.Duration(prev.Samples)*h.interval) < float64(d)*PM_MIN_SAMPLE_RATIO
+			}
+			cur = &PMBin{Module: name, Type: typ, Start: start, End: start.Add(d)}
+			bins = append(bins, cur)
+			// the completed bins and the current bin
+			if len(bins) > h.depth[typ]+1 {
+				bins = append([]*PMBin(nil), bins[len(bins)-h.depth[typ]-1:]...)
+			}
+			h.bins[name][typ] = bins
+			completed = true
+		}
+		cur.add(t, h.interval, now)
+	}
+	if !completed && now.Sub(h.saved[name]) < PM_SAVE_INTERVAL {
+		return nil
+	}
+	h.saved[name] = now
+	return h.save(name)
+}
@@ -0,0 +1,94 @@
+package sonic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+)
+
+func pmTestState(syncError bool, xi uint16, sdFecBer string) *model.PacketTransponder_OpticalModule {
+	return &model.PacketTransponder_OpticalModule{
+		Name:             ygot.String("Opt1"),
+		SyncError:        ygot.Bool(syncError),
+		OpticalModuleRms: &model.PacketTransponder_OpticalModule_OpticalModuleRms{Xi: ygot.Uint16(xi)},
+		ChannelStats: map[string]*model.PacketTransponder_OpticalModule_ChannelStats{
+			"A": {Name: ygot.String("A"), SdFecBer: ygot.String(sdFecBer)},
+		},
+	}
+}
+
+func TestPMHistory(t *testing.T) {
+	dir := t.TempDir()
+	depth := map[string]int{PM_BIN_15M: 2, PM_BIN_24H: 1}
+	h, err := NewPMHistory(dir, 10*time.Second, depth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	// the first bin is sampled fully and the second bin only once
+	for i := 0; i < 90; i++ {
+		if err = h.Add("Opt1", pmTestState(i < 3, uint16(100+i), "1e-5"), base.Add(time.Duration(i)*10*time.Second)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, m := range []int{15, 45, 60} {
+		if err = h.Add("Opt1", pmTestState(false, 100, "2e-5"), base.Add(time.Duration(m)*time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bins, err := LoadPMBins(dir, "Opt1", PM_BIN_15M)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the completed bins within the depth and the current bin
+	if len(bins) != 3 {
+		t.Fatalf("unexpected bins: %v", bins)
+	}
+	if !bins[0].Start.Equal(base.Add(15*time.Minute)) || !bins[0].Suspect {
+		t.Errorf("the partially sampled bin isn't suspect: %v", bins[0])
+	}
+	if !bins[2].Current(base.Add(61 * time.Minute)) {
+		t.Errorf("the last bin isn't current: %v", bins[2])
+	}
+
+	bins, err = LoadPMBins(dir, "Opt1", PM_BIN_24H)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bins) != 1 {
+		t.Fatalf("unexpected 24h bins: %v", bins)
+	}
+	b := bins[0]
+	if b.Samples != 93 || b.SyncErrorSeconds != 30 {
+		t.Errorf("unexpected samples: %d, sync-error seconds: %d", b.Samples, b.SyncErrorSeconds)
+	}
+	if s := b.Rms["xi"]; s.Min != 100 || s.Max != 189 {
+		t.Errorf("unexpected xi: %v", s)
+	}
+	if s := b.Channels["A"]["sd-fec-ber"]; s.Min != 1e-5 || s.Max != 2e-5 || s.Samples != 93 {
+		t.Errorf("unexpected sd-fec-ber: %v", s)
+	}
+
+	// the current bins continue after reload
+	h, err = NewPMHistory(dir, 10*time.Second, depth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = h.Add("Opt1", pmTestState(false, 100, "2e-5"), base.Add(61*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if bins, err = LoadPMBins(dir, "Opt1", PM_BIN_15M); err != nil || len(bins) != 3 || bins[2].Samples != 2 {
+		t.Errorf("current bin isn't continued: %v, %v", bins, err)
+	}
+
+	if _, err = NewPMHistory(dir, 1500*time.Millisecond, depth); err == nil {
+		t.Error("interval which isn't a multiple of a second is accepted")
+	}
+	if _, err = LoadPMBins(dir, "Opt1", "1h"); err == nil {
+		t.Error("unknown bin type is accepted")
+	}
+}
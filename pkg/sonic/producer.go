@@ -0,0 +1,233 @@
+package sonic
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// the producer/consumer protocol orchagent uses to consume APPL_DB tables.
+// a producer writes the fields to the temporary hash "_<TABLE>:<key>",
+// adds the key to "<TABLE>_KEY_SET" and notifies "<TABLE>_CHANNEL".
+// a consumer pops the keys and moves the fields to "<TABLE>:<key>".
+// a key whose temporary hash is empty is deleted
+
+const (
+	STATE_TABLE_NOTIFICATION = "G"
+	STATE_TABLE_SET          = "SET"
+	STATE_TABLE_DEL          = "DEL"
+	// DEFAULT_POP_BATCH_SIZE is the number of the keys a consumer pops at once
+	DEFAULT_POP_BATCH_SIZE = 128
+)
+
+// KEYS: channel, key set, temporary hash
+// ARGV: notification, key, field, value, ...
+var producerSetScript = newScript(`
+local added = redis.call('SADD', KEYS[2], ARGV[2])
+for i = 3, #ARGV, 2 do
+    redis.call('HSET', KEYS[3], ARGV[i], ARGV[i + 1])
+end
+if added > 0 then
+    redis.call('PUBLISH', KEYS[1], ARGV[1])
+end
+return added
+`, func(m *MemoryDB, keys []string, args []string) (interface{}, error) {
+	added := m.sadd(keys[1], args[1])
+	fields := make(map[string]interface{}, (len(args)-2)/2)
+	for i := 2; i+1 < len(args); i += 2 {
+		fields[args[i]] = args[i+1]
+	}
+	m.hmset(keys[2], fields)
+	if added > 0 {
+		m.publish(keys[0], args[0])
+	}
+	return int64(added), nil
+})
+
+// KEYS: channel, key set, temporary hash
+// ARGV: notification, key
+var producerDelScript = newScript(`
+local added = redis.call('SADD', KEYS[2], ARGV[2])
+redis.call('DEL', KEYS[3])
+if added > 0 then
+    redis.call('PUBLISH', KEYS[1], ARGV[1])
+end
+return added
+`, func(m *MemoryDB, keys []string, args []string) (interface{}, error) {
+	added := m.sadd(keys[1], args[1])
+	m.del(keys[2])
+	if added > 0 {
+		m.publish(keys[0], args[0])
+	}
+	return int64(added), nil
+})
+
+// KEYS: key set
+// ARGV: count, temporary hash prefix, table prefix.
+// redis before 5.0 refuses writes after SPOP, which is non deterministic,
+// unless the script replicates its effects instead of itself.
+// SPOP with a count and redis.replicate_commands need redis 3.2 or later
+var consumerPopScript = newScript(`
+redis.replicate_commands()
+local ret = {}
+local keys = redis.call('SPOP', KEYS[1], ARGV[1])
+for i = 1, #keys do
+    local key = keys[i]
+    local values = redis.call('HGETALL', ARGV[2] .. key)
+    redis.call('DEL', ARGV[2] .. key)
+    if #values == 0 then
+        redis.call('DEL', ARGV[3] .. key)
+    else
+        for j = 1, #values, 2 do
+            redis.call('HSET', ARGV[3] .. key, values[j], values[j + 1])
+        end
+    end
+    table.insert(ret, {key, values})
+end
+return ret
+`, func(m *MemoryDB, keys []string, args []string) (interface{}, error) {
+	count, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, err
+	}
+	ret := []interface{}{}
+	for _, key := range m.spop(keys[0], count) {
+		temp := args[1] + key
+		values := []interface{}{}
+		fields := make([]string, 0, len(m.hashes[temp]))
+		for f := range m.hashes[temp] {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+		entry := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			values = append(values, f, m.hashes[temp][f])
+			entry[f] = m.hashes[temp][f]
+		}
+		m.del(temp)
+		if len(values) == 0 {
+			m.del(args[2] + key)
+		} else {
+			m.hmset(args[2]+key, entry)
+		}
+		ret = append(ret, []interface{}{key, values})
+	}
+	return ret, nil
+})
+
+// KeyOpFieldValues is an operation popped by ConsumerStateTable
+type KeyOpFieldValues struct {
+	Key string
+	// STATE_TABLE_SET or STATE_TABLE_DEL
+	Op     string
+	Fields map[string]string
+}
+
+// ProducerStateTable writes an APPL_DB table consumed by orchagent
+type ProducerStateTable struct {
+	c     *SONiCDBClient
+	table string
+}
+
+func (c *SONiCDBClient) ProducerStateTable(table string) *ProducerStateTable {
+	return &ProducerStateTable{c: c, table: strings.ToUpper(table)}
+}
+
+func (t *ProducerStateTable) keys(key string) []string {
+	return []string{
+		fmt.Sprintf("%s_CHANNEL", t.table),
+		fmt.Sprintf("%s_KEY_SET", t.table),
+		fmt.Sprintf("_%s", t.c.hashKey(t.table, key)),
+	}
+}
+
+// Set stages the fields of the entry and notifies the consumers
+func (t *ProducerStateTable) Set(key string, entry map[string]interface{}) error {
+	fields := serializeEntry(entry)
+	if fields == nil {
+		return fmt.Errorf("entry of %s is nil", key)
+	}
+	names := make([]string, 0, len(fields))
+	for f := range fields {
+		names = append(names, f)
+	}
+	sort.Strings(names)
+	args := []string{STATE_TABLE_NOTIFICATION, key}
+	for _, f := range names {
+		args = append(args, f, fmt.Sprintf("%v", fields[f]))
+	}
+	if record(t.c.db, "PRODUCER_SET", t.c.hashKey(t.table, key), fieldArgs(fields)...) {
+		return nil
+	}
+	_, err := t.c.client.Eval(producerSetScript, t.keys(key), args...)
+	return err
+}
+
+// Del stages the deletion of the entry and notifies the consumers
+func (t *ProducerStateTable) Del(key string) error {
+	if record(t.c.db, "PRODUCER_DEL", t.c.hashKey(t.table, key)) {
+		return nil
+	}
+	_, err := t.c.client.Eval(producerDelScript, t.keys(key), STATE_TABLE_NOTIFICATION, key)
+	return err
+}
+
+// ConsumerStateTable pops the operations staged by the producers of an APPL_DB table
+// and applies them to the table
+type ConsumerStateTable struct {
+	c     *SONiCDBClient
+	table string
+	// BatchSize is the maximum number of the keys Pop returns
+	BatchSize int
+}
+
+func (c *SONiCDBClient) ConsumerStateTable(table string) *ConsumerStateTable {
+	return &ConsumerStateTable{c: c, table: strings.ToUpper(table), BatchSize: DEFAULT_POP_BATCH_SIZE}
+}
+
+// Channel is the channel the producers notify
+func (t *ConsumerStateTable) Channel() string {
+	return fmt.Sprintf("%s_CHANNEL", t.table)
+}
+
+// Pop returns the pending operations. it returns an empty slice when there is nothing to pop
+func (t *ConsumerStateTable) Pop() ([]KeyOpFieldValues, error) {
+	prefix := t.c.hashKey(t.table, "")
+	v, err := t.c.client.Eval(consumerPopScript,
+		[]string{fmt.Sprintf("%s_KEY_SET", t.table)},
+		strconv.Itoa(t.BatchSize), "_"+prefix, prefix)
+	if err != nil {
+		return nil, err
+	}
+	entries, ok := v.([]interface{})
+	if !ok && v != nil {
+		return nil, fmt.Errorf("unexpected result of pop: %v", v)
+	}
+	ops := make([]KeyOpFieldValues, 0, len(entries))
+	for _, e := range entries {
+		pair, ok := e.([]interface{})
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("unexpected entry in the result of pop: %v", e)
+		}
+		key, ok := pair[0].(string)
+		values, ok2 := pair[1].([]interface{})
+		if !ok || !ok2 || len(values)%2 != 0 {
+			return nil, fmt.Errorf("unexpected entry in the result of pop: %v", e)
+		}
+		op := KeyOpFieldValues{Key: key, Op: STATE_TABLE_SET, Fields: map[string]string{}}
+		if len(values) == 0 {
+			op.Op = STATE_TABLE_DEL
+		}
+		for i := 0; i < len(values); i += 2 {
+			f, ok := values[i].(string)
+			v, ok2 := values[i+1].(string)
+			if !ok || !ok2 {
+				return nil, fmt.Errorf("unexpected field of %s in the result of pop: %v", key, values)
+			}
+			op.Fields[f] = v
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
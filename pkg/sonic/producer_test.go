@@ -0,0 +1,208 @@
+package sonic
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// REDIS_TEST_DB is flushed by the tests running against a real redis
+const REDIS_TEST_DB = 15
+
+// newRedisTestClient connects the clients of every db to REDIS_TEST_DB of the redis
+// at $OOPT_TEST_REDIS (localhost:6379 by default) and skips the test when it isn't reachable.
+// the scripts need redis 3.2 or later, so run the tests against the version of the target image
+func newRedisTestClient(t *testing.T, db int) (*SONiCDBClient, *redisBackend) {
+	addr := os.Getenv("OOPT_TEST_REDIS")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	b, _ := newRedisBackend("tcp", addr, REDIS_TEST_DB, time.Second)
+	r := b.(*redisBackend)
+	t.Cleanup(func() { r.Close() })
+	if err := r.Ping(); err != nil {
+		t.Skipf("redis is not available at %s: %v", addr, err)
+	}
+	// tells which version the scripts ran on
+	if version, err := redisVersion(r); err == nil {
+		t.Logf("redis %s at %s", version, addr)
+	}
+	flush := func() {
+		if err := r.client.FlushDB().Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	flush()
+	t.Cleanup(flush)
+	t.Cleanup(useConnector(func(network, _ string, _ int, timeout time.Duration) (Backend, error) {
+		return newRedisBackend("tcp", addr, REDIS_TEST_DB, timeout)
+	}))
+	client, err := GetClient(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, r
+}
+
+func redisVersion(r *redisBackend) (string, error) {
+	info, err := r.client.Info("server").Result()
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(info, "\n") {
+		if strings.HasPrefix(line, "redis_version:") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "redis_version:")), nil
+		}
+	}
+	return "", fmt.Errorf("no redis_version in INFO server")
+}
+
+func TestProducerConsumerStateTable(t *testing.T) {
+	client, store := newTestClient(t, APPL_DB)
+	db := store.DB(APPL_DB)
+	p := client.ProducerStateTable(PORT_TABLE)
+	if err := p.Set("Ethernet1", map[string]interface{}{"mtu": 9100, "admin_status": "up"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Set("Ethernet1", map[string]interface{}{"description": "uplink"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Del("Ethernet2"); err != nil {
+		t.Fatal(err)
+	}
+	db.HMSet("PORT_TABLE:Ethernet2", map[string]interface{}{"mtu": "1500"})
+
+	// the consumers are notified once per pending key
+	expected := []Message{{Channel: "PORT_TABLE_CHANNEL", Message: "G"}, {Channel: "PORT_TABLE_CHANNEL", Message: "G"}}
+	if !reflect.DeepEqual(db.Published, expected) {
+		t.Errorf("expected %v, got %v", expected, db.Published)
+	}
+	if m := db.SMembers("PORT_TABLE_KEY_SET"); !reflect.DeepEqual(m, []string{"Ethernet1", "Ethernet2"}) {
+		t.Errorf("unexpected key set: %v", m)
+	}
+	// orchagent doesn't see the fields until they are popped
+	if h, _ := db.HGetAll("PORT_TABLE:Ethernet1"); len(h) != 0 {
+		t.Errorf("fields are visible before pop: %v", h)
+	}
+
+	c := client.ConsumerStateTable(PORT_TABLE)
+	ops, err := c.Pop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedOps := []KeyOpFieldValues{
+		{Key: "Ethernet1", Op: STATE_TABLE_SET, Fields: map[string]string{"mtu": "9100", "admin_status": "up", "description": "uplink"}},
+		{Key: "Ethernet2", Op: STATE_TABLE_DEL, Fields: map[string]string{}},
+	}
+	if !reflect.DeepEqual(ops, expectedOps) {
+		t.Errorf("expected %v, got %v", expectedOps, ops)
+	}
+	if h, _ := db.HGetAll("PORT_TABLE:Ethernet1"); h["mtu"] != "9100" || h["description"] != "uplink" {
+		t.Errorf("fields are not applied: %v", h)
+	}
+	if h, _ := db.HGetAll("PORT_TABLE:Ethernet2"); len(h) != 0 {
+		t.Errorf("entry is not deleted: %v", h)
+	}
+	if h, _ := db.HGetAll("_PORT_TABLE:Ethernet1"); len(h) != 0 {
+		t.Errorf("temporary hash is not deleted: %v", h)
+	}
+	if ops, err = c.Pop(); err != nil || len(ops) != 0 {
+		t.Errorf("unexpected pop result: %v %v", ops, err)
+	}
+}
+
+// TestProducerConsumerStateTableRedis runs the Lua scripts MemoryDB emulates on a real redis
+func TestProducerConsumerStateTableRedis(t *testing.T) {
+	client, r := newRedisTestClient(t, APPL_DB)
+	sub, err := r.Subscribe("PORT_TABLE_CHANNEL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+
+	p := client.ProducerStateTable(PORT_TABLE)
+	if err := p.Set("Ethernet1", map[string]interface{}{"mtu": 9100, "admin_status": "up"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Set("Ethernet1", map[string]interface{}{"description": "uplink"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Del("Ethernet2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.client.HSet("PORT_TABLE:Ethernet2", "mtu", "1500").Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the consumers are notified once per pending key
+	for i := 0; i < 2; i++ {
+		select {
+		case m := <-sub.Messages():
+			if m != (Message{Channel: "PORT_TABLE_CHANNEL", Message: "G"}) {
+				t.Errorf("unexpected notification: %v", m)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("notification %d is not published", i)
+		}
+	}
+	select {
+	case m := <-sub.Messages():
+		t.Errorf("unexpected notification: %v", m)
+	case <-time.After(100 * time.Millisecond):
+	}
+	if m := r.client.SMembers("PORT_TABLE_KEY_SET").Val(); len(m) != 2 {
+		t.Errorf("unexpected key set: %v", m)
+	}
+	if h := r.client.HGetAll("PORT_TABLE:Ethernet1").Val(); len(h) != 0 {
+		t.Errorf("fields are visible before pop: %v", h)
+	}
+
+	c := client.ConsumerStateTable(PORT_TABLE)
+	ops, err := c.Pop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// SPOP returns the keys in any order
+	byKey := map[string]KeyOpFieldValues{}
+	for _, op := range ops {
+		byKey[op.Key] = op
+	}
+	expectedOps := map[string]KeyOpFieldValues{
+		"Ethernet1": {Key: "Ethernet1", Op: STATE_TABLE_SET, Fields: map[string]string{"mtu": "9100", "admin_status": "up", "description": "uplink"}},
+		"Ethernet2": {Key: "Ethernet2", Op: STATE_TABLE_DEL, Fields: map[string]string{}},
+	}
+	if len(ops) != 2 || !reflect.DeepEqual(byKey, expectedOps) {
+		t.Errorf("expected %v, got %v", expectedOps, ops)
+	}
+	if h := r.client.HGetAll("PORT_TABLE:Ethernet1").Val(); h["mtu"] != "9100" || h["description"] != "uplink" {
+		t.Errorf("fields are not applied: %v", h)
+	}
+	if n := r.client.Exists("PORT_TABLE:Ethernet2", "_PORT_TABLE:Ethernet1", "_PORT_TABLE:Ethernet2", "PORT_TABLE_KEY_SET").Val(); n != 0 {
+		t.Errorf("%d keys are left", n)
+	}
+	if ops, err = c.Pop(); err != nil || len(ops) != 0 {
+		t.Errorf("unexpected pop result: %v %v", ops, err)
+	}
+}
+
+func TestProducerStateTableRecord(t *testing.T) {
+	client, store := newTestClient(t, APPL_DB)
+	stop := RecordWrites()
+	p := client.ProducerStateTable(PORT_TABLE)
+	p.Set("Ethernet1", map[string]interface{}{"mtu": 9100})
+	p.Del("Ethernet2")
+	writes := stop()
+	expected := []Write{
+		{DB: APPL_DB, Op: "PRODUCER_SET", Key: "PORT_TABLE:Ethernet1", Args: []string{"mtu=9100"}},
+		{DB: APPL_DB, Op: "PRODUCER_DEL", Key: "PORT_TABLE:Ethernet2"},
+	}
+	if !reflect.DeepEqual(writes, expected) {
+		t.Errorf("expected %v, got %v", expected, writes)
+	}
+	if m := store.DB(APPL_DB).SMembers("PORT_TABLE_KEY_SET"); len(m) != 0 {
+		t.Errorf("recorded writes are applied: %v", m)
+	}
+}
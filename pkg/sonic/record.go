@@ -0,0 +1,73 @@
+package sonic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Write is a redis write command recorded instead of being executed
+type Write struct {
+	DB  int
+	Op  string
+	Key string
+	// field=value pairs of HMSET, fields of HDEL or the message of PUBLISH
+	Args []string
+}
+
+func (w Write) String() string {
+	name, ok := dbNames[w.DB]
+	if !ok {
+		name = fmt.Sprintf("DB%d", w.DB)
+	}
+	s := fmt.Sprintf("%s %s %s", name, w.Op, w.Key)
+	if len(w.Args) > 0 {
+		s = fmt.Sprintf("%s %s", s, strings.Join(w.Args, " "))
+	}
+	return s
+}
+
+var recorder struct {
+	sync.Mutex
+	enabled bool
+	writes  []Write
+}
+
+// RecordWrites makes the clients record write commands instead of executing them
+// until the returned function is called. the function returns the recorded writes.
+// reads still go to redis, so the recorded writes are not visible to later reads
+func RecordWrites() func() []Write {
+	recorder.Lock()
+	recorder.enabled = true
+	recorder.writes = nil
+	recorder.Unlock()
+	return func() []Write {
+		recorder.Lock()
+		defer recorder.Unlock()
+		recorder.enabled = false
+		writes := recorder.writes
+		recorder.writes = nil
+		return writes
+	}
+}
+
+// record returns false when recording is disabled
+func record(db int, op, key string, args ...string) bool {
+	recorder.Lock()
+	defer recorder.Unlock()
+	if !recorder.enabled {
+		return false
+	}
+	recorder.writes = append(recorder.writes, Write{DB: db, Op: op, Key: key, Args: args})
+	return true
+}
+
+func fieldArgs(fields map[string]interface{}) []string {
+	args := make([]string, 0, len(fields))
+	for k, v := range fields {
+		args = append(args, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(args)
+	return args
+}
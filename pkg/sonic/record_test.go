@@ -0,0 +1,32 @@
+package sonic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRecordWrites(t *testing.T) {
+	// the redis client isn't used while recording
+	c := &SONiCDBClient{db: CONFIG_DB, separator: "|"}
+	stop := RecordWrites()
+	if err := c.ModEntry("PORT", "Ethernet1", map[string]interface{}{"mtu": 9100, "lanes": []int{1, 2}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ModEntry("VLAN", "Vlan100", nil); err != nil {
+		t.Fatal(err)
+	}
+	writes := stop()
+	expected := []Write{
+		{DB: CONFIG_DB, Op: "HMSET", Key: "PORT|Ethernet1", Args: []string{"lanes@=1,2", "mtu=9100"}},
+		{DB: CONFIG_DB, Op: "DEL", Key: "VLAN|Vlan100"},
+	}
+	if !reflect.DeepEqual(writes, expected) {
+		t.Errorf("expected %v, got %v", expected, writes)
+	}
+	if s := writes[0].String(); s != "CONFIG_DB HMSET PORT|Ethernet1 lanes@=1,2 mtu=9100" {
+		t.Errorf("unexpected string: %s", s)
+	}
+	if record(CONFIG_DB, "DEL", "VLAN|Vlan100") {
+		t.Errorf("recording is not stopped")
+	}
+}
@@ -4,9 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
-
-	"github.com/go-redis/redis"
 )
 
 const (
@@ -18,50 +17,45 @@ const (
 	TRANSPORT_STATE_DB        = 8
 	TRANSPORT_NOTIFICATION    = "TRANSPORT_NOTIFICATION"
 	DEFAULT_REDIS_UNIX_SOCKET = "/var/run/redis/redis.sock"
+	// SCAN_COUNT is the number of keys a SCAN call looks at
+	SCAN_COUNT = 100
 )
 
-var keySeparatorMap = map[int]string{
-	APPL_DB:             ":",
-	CONFIG_DB:           "|",
-	TRANSPORT_CONFIG_DB: "|",
-	TRANSPORT_STATE_DB:  "|",
-}
-
-var tableNameSeparatorMap = map[int]string{
-	APPL_DB:             ":",
-	CONFIG_DB:           "|",
-	TRANSPORT_CONFIG_DB: "|",
-	TRANSPORT_STATE_DB:  "|",
-}
-
-func serializeKey(db int, keys ...string) string {
-	if len(keys) == 1 {
-		return keys[0]
-	}
-	return strings.Join(keys, keySeparatorMap[db])
-}
-
 type SONiCDBClient struct {
-	client *redis.Client
-	db     int
+	client    Backend
+	db        int
+	separator string
 }
 
+// NewSONiCDBClient connects to redis, or to the MemoryStore given to UseMemoryStore.
+// GetClient should be used instead to share the connections configured by SetDatabaseConfig
 func NewSONiCDBClient(network string, addr string, db int) (*SONiCDBClient, error) {
-	client := redis.NewClient(&redis.Options{
-		Network: network,
-		Addr:    addr,
-		DB:      db,
-	})
-	_, err := client.Ping().Result()
+	client, err := connect(network, addr, db, DEFAULT_DB_TIMEOUT)
 	if err != nil {
 		return nil, err
 	}
+	if err = client.Ping(); err != nil {
+		client.Close()
+		return nil, err
+	}
+	separator := ""
+	if d, ok := DefaultDatabaseConfig().Databases[dbNames[db]]; ok {
+		separator = d.Separator
+	}
 	return &SONiCDBClient{
-		client: client,
-		db:     db,
+		client:    client,
+		db:        db,
+		separator: separator,
 	}, nil
 }
 
+func (c *SONiCDBClient) serializeKey(keys ...string) string {
+	if len(keys) == 1 {
+		return keys[0]
+	}
+	return strings.Join(keys, c.separator)
+}
+
 func (c *SONiCDBClient) SendNotification(channel, op, data string, message []interface{}) (int, error) {
 	if message != nil {
 		message = append([]interface{}{op, data}, message...)
@@ -72,20 +66,29 @@ func (c *SONiCDBClient) SendNotification(channel, op, data string, message []int
 	if err != nil {
 		return 0, err
 	}
+	if record(c.db, "PUBLISH", channel, string(buf)) {
+		return 0, nil
+	}
 	fmt.Println(string(buf))
-	r := c.client.Publish(channel, buf)
-	return int(r.Val()), r.Err()
+	return c.client.Publish(channel, string(buf))
+}
+
+func (c *SONiCDBClient) GetKey(key string) (string, error) {
+	return c.client.Get(key)
+}
+
+func (c *SONiCDBClient) GetHash(key string) (map[string]string, error) {
+	return c.client.HGetAll(key)
 }
 
 func (c *SONiCDBClient) GetEntry(table string, keys ...string) (map[string]interface{}, error) {
-	key := serializeKey(c.db, keys...)
-	_hash := fmt.Sprintf("%s%s%s", strings.ToUpper(table), tableNameSeparatorMap[c.db], key)
-	result := c.client.HGetAll(_hash)
-	if err := result.Err(); err != nil {
+	_hash := c.hashKey(table, c.serializeKey(keys...))
+	result, err := c.client.HGetAll(_hash)
+	if err != nil {
 		return nil, err
 	}
 	ret := make(map[string]interface{})
-	for k, v := range result.Val() {
+	for k, v := range result {
 		if k[len(k)-1] == '@' {
 			vs := strings.Split(v, ",")
 			ret[k[:len(k)-1]] = vs
@@ -96,20 +99,44 @@ func (c *SONiCDBClient) GetEntry(table string, keys ...string) (map[string]inter
 	return ret, nil
 }
 
+// keys iterates the keys matching pattern with SCAN, which doesn't block redis like KEYS
+func (c *SONiCDBClient) keys(pattern string) ([]string, error) {
+	seen := map[string]bool{}
+	keys := []string{}
+	var cursor uint64
+	for {
+		page, next, err := c.client.Scan(cursor, pattern, SCAN_COUNT)
+		if err != nil {
+			return nil, err
+		}
+		// SCAN may return a key more than once
+		for _, k := range page {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+		if next == 0 {
+			return keys, nil
+		}
+		cursor = next
+	}
+}
+
 func (c *SONiCDBClient) GetTable(table string) (map[string]map[string]interface{}, error) {
-	pattern := fmt.Sprintf("%s%s*", strings.ToUpper(table), tableNameSeparatorMap[c.db])
-	keys := c.client.Keys(pattern)
-	if err := keys.Err(); err != nil {
+	pattern := fmt.Sprintf("%s%s*", strings.ToUpper(table), c.separator)
+	keys, err := c.keys(pattern)
+	if err != nil {
 		return nil, err
 	}
 	t := make(map[string]map[string]interface{})
-	for _, key := range keys.Val() {
-		ks := strings.Split(key, keySeparatorMap[c.db])
+	for _, key := range keys {
+		ks := strings.Split(key, c.separator)
 		v, err := c.GetEntry(table, ks[1:]...)
 		if err != nil {
 			return nil, err
 		}
-		key = strings.Join(ks[1:], keySeparatorMap[c.db])
+		key = strings.Join(ks[1:], c.separator)
 		t[key] = v
 	}
 	return t, nil
@@ -139,33 +166,107 @@ func serializeEntry(entry map[string]interface{}) map[string]interface{} {
 	return ret
 }
 
+func (c *SONiCDBClient) hashKey(table, key string) string {
+	return fmt.Sprintf("%s%s%s", strings.ToUpper(table), c.separator, key)
+}
+
+// ModEntry updates the fields in entry keeping the other fields. nil entry deletes the entry
 func (c *SONiCDBClient) ModEntry(table, key string, entry map[string]interface{}) error {
-	_hash := fmt.Sprintf("%s%s%s", strings.ToUpper(table), tableNameSeparatorMap[c.db], key)
-	if entry == nil {
-		r := c.client.Del(_hash)
-		return r.Err()
-	}
-	r := c.client.HMSet(_hash, serializeEntry(entry))
-	return r.Err()
+	b := c.Batch()
+	b.ModEntry(table, key, entry)
+	return b.Commit()
 }
 
+// SetEntry replaces the entry removing the fields not in entry
 func (c *SONiCDBClient) SetEntry(table, key string, entry map[string]interface{}) error {
-	original, err := c.GetEntry(table, key)
-	if err != nil {
+	b := c.Batch()
+	if err := b.SetEntry(table, key, entry); err != nil {
 		return err
 	}
-	err = c.ModEntry(table, key, entry)
+	return b.Commit()
+}
+
+// Batch stages writes to the database of the client.
+// Commit applies them atomically in a MULTI/EXEC transaction
+// so that readers never see a half written set of entries
+type Batch struct {
+	c    *SONiCDBClient
+	cmds []Command
+}
+
+func (c *SONiCDBClient) Batch() *Batch {
+	return &Batch{c: c}
+}
+
+// ModEntry stages the update of the fields in entry. nil entry deletes the entry
+func (b *Batch) ModEntry(table, key string, entry map[string]interface{}) {
+	_hash := b.c.hashKey(table, key)
+	if entry == nil {
+		b.cmds = append(b.cmds, Command{Op: "DEL", Key: _hash})
+		return
+	}
+	b.cmds = append(b.cmds, Command{Op: "HMSET", Key: _hash, Fields: serializeEntry(entry)})
+}
+
+// DelFields stages the removal of the fields from the entry
+func (b *Batch) DelFields(table, key string, names ...string) {
+	if len(names) == 0 {
+		return
+	}
+	b.cmds = append(b.cmds, Command{Op: "HDEL", Key: b.c.hashKey(table, key), Names: names})
+}
+
+// SetEntry stages the replacement of the entry.
+// the fields to remove are read from the database now,
+// so writes staged earlier in the batch are not taken into account
+func (b *Batch) SetEntry(table, key string, entry map[string]interface{}) error {
+	if entry == nil {
+		b.ModEntry(table, key, nil)
+		return nil
+	}
+	original, err := b.c.GetEntry(table, key)
 	if err != nil {
 		return err
 	}
-	_hash := fmt.Sprintf("%s%s%s", strings.ToUpper(table), tableNameSeparatorMap[c.db], key)
+	b.ModEntry(table, key, entry)
+	stale := []string{}
 	for k, v := range original {
-		_, ok := entry[k]
+		if _, ok := entry[k]; ok {
+			continue
+		}
 		if reflect.TypeOf(v).Kind() == reflect.Slice {
 			k += "@"
 		}
-		if !ok {
-			c.client.HDel(_hash, k)
+		stale = append(stale, k)
+	}
+	sort.Strings(stale)
+	b.DelFields(table, key, stale...)
+	return nil
+}
+
+// Len returns the number of the staged commands
+func (b *Batch) Len() int {
+	return len(b.cmds)
+}
+
+// Commit applies the staged writes atomically and empties the batch
+func (b *Batch) Commit() error {
+	cmds := b.cmds
+	b.cmds = nil
+	if len(cmds) == 0 {
+		return nil
+	}
+	for _, c := range cmds {
+		var args []string
+		switch c.Op {
+		case "HMSET":
+			args = fieldArgs(c.Fields)
+		case "HDEL":
+			args = c.Names
+		}
+		if !record(b.c.db, c.Op, c.Key, args...) {
+			// recording is disabled
+			return b.c.client.Exec(cmds)
 		}
 	}
 	return nil
@@ -1,24 +1,135 @@
 package sonic
 
 import (
-	"fmt"
+	"reflect"
 	"testing"
 )
 
+func newTestClient(t *testing.T, db int) (*SONiCDBClient, *MemoryStore) {
+	store := NewMemoryStore()
+	t.Cleanup(UseMemoryStore(store))
+	client, err := GetClient(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, store
+}
+
 func TestSetEntry(t *testing.T) {
-	client := NewSONiCDBClient("unix", "/var/run/redis/redis.sock", 8)
-	client.SetEntry("HELLO", "WORLD", map[string]interface{}{"field": []int{1, 2, 3, 4}, "field2": []float32{1.234, 1435}})
+	client, store := newTestClient(t, TRANSPORT_STATE_DB)
+	err := client.SetEntry("HELLO", "WORLD", map[string]interface{}{"field": []int{1, 2, 3, 4}, "field2": []float32{1.234, 1435}})
+	if err != nil {
+		t.Fatal(err)
+	}
 	v, err := client.GetEntry("HELLO", "WORLD")
-	fmt.Println(v, err)
-	client.ModEntry("HELLO", "WORLD", map[string]interface{}{"field": []int{1, 2, 3, 4, 5}})
-	v, err = client.GetEntry("HELLO", "WORLD")
-	fmt.Println(v, err)
-	client.SetEntry("HELLO", "WORLD", map[string]interface{}{"field": []int{1, 2, 3, 4, 5}})
-	v, err = client.GetEntry("HELLO", "WORLD")
-	fmt.Println(v, err)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"field": []string{"1", "2", "3", "4"}, "field2": []string{"1.234", "1435"}}
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected %v, got %v", expected, v)
+	}
+
+	// ModEntry keeps the other fields
+	if err = client.ModEntry("HELLO", "WORLD", map[string]interface{}{"field": []int{1, 2, 3, 4, 5}}); err != nil {
+		t.Fatal(err)
+	}
+	v, _ = client.GetEntry("HELLO", "WORLD")
+	expected["field"] = []string{"1", "2", "3", "4", "5"}
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected %v, got %v", expected, v)
+	}
+
+	// SetEntry removes the other fields
+	if err = client.SetEntry("HELLO", "WORLD", map[string]interface{}{"field": []int{1, 2, 3, 4, 5}}); err != nil {
+		t.Fatal(err)
+	}
+	h, _ := store.DB(TRANSPORT_STATE_DB).HGetAll("HELLO|WORLD")
+	if !reflect.DeepEqual(h, map[string]string{"field@": "1,2,3,4,5"}) {
+		t.Errorf("unexpected hash: %v", h)
+	}
+
+	if err = client.ModEntry("HELLO", "WORLD", nil); err != nil {
+		t.Fatal(err)
+	}
+	table, err := client.GetTable("HELLO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table) != 0 {
+		t.Errorf("entry is not deleted: %v", table)
+	}
+}
+
+func TestGetTable(t *testing.T) {
+	client, _ := newTestClient(t, APPL_DB)
+	client.ModEntry("PORT_TABLE", "Ethernet1", map[string]interface{}{"oper_status": "up"})
+	client.ModEntry("PORT_TABLE", "Ethernet2", map[string]interface{}{})
+	client.ModEntry("VLAN_TABLE", "Vlan100", map[string]interface{}{"vlanid": 100})
+	table, err := client.GetTable("PORT_TABLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]map[string]interface{}{
+		"Ethernet1": {"oper_status": "up"},
+		"Ethernet2": {"NULL": "NULL"},
+	}
+	if !reflect.DeepEqual(table, expected) {
+		t.Errorf("expected %v, got %v", expected, table)
+	}
 }
 
 func TestNotification(t *testing.T) {
-	client := NewSONiCDBClient("unix", "/var/run/redis/redis.sock", 8)
-	client.SendNotification(TRANSPORT_NOTIFICATION, "OP", "DATA", nil)
+	client, store := newTestClient(t, TRANSPORT_STATE_DB)
+	if _, err := client.SendNotification(TRANSPORT_NOTIFICATION, "OP", "DATA", nil); err != nil {
+		t.Fatal(err)
+	}
+	expected := []Message{{Channel: TRANSPORT_NOTIFICATION, Message: `["OP","DATA"]`}}
+	if p := store.DB(TRANSPORT_STATE_DB).Published; !reflect.DeepEqual(p, expected) {
+		t.Errorf("expected %v, got %v", expected, p)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	client, store := newTestClient(t, CONFIG_DB)
+	db := store.DB(CONFIG_DB)
+	db.HMSet("PORT|Ethernet1", map[string]interface{}{"lanes": "1", "mtu": "1500"})
+	db.HMSet("VLAN|Vlan100", map[string]interface{}{"vlanid": "100"})
+
+	b := client.Batch()
+	if err := b.SetEntry("PORT", "Ethernet1", map[string]interface{}{"mtu": 9000}); err != nil {
+		t.Fatal(err)
+	}
+	b.ModEntry("VLAN", "Vlan100", nil)
+	b.ModEntry("VLAN_MEMBER", "Vlan200|Ethernet1", map[string]interface{}{"tagging_mode": "untagged"})
+	if b.Len() != 4 {
+		t.Errorf("unexpected number of commands: %d", b.Len())
+	}
+	if h, _ := db.HGetAll("PORT|Ethernet1"); h["mtu"] != "1500" {
+		t.Errorf("staged write is visible before commit: %v", h)
+	}
+	if err := b.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if h, _ := db.HGetAll("PORT|Ethernet1"); !reflect.DeepEqual(h, map[string]string{"mtu": "9000"}) {
+		t.Errorf("unexpected hash: %v", h)
+	}
+	if h, _ := db.HGetAll("VLAN|Vlan100"); len(h) != 0 {
+		t.Errorf("entry is not deleted: %v", h)
+	}
+	if h, _ := db.HGetAll("VLAN_MEMBER|Vlan200|Ethernet1"); h["tagging_mode"] != "untagged" {
+		t.Errorf("unexpected hash: %v", h)
+	}
+	if b.Len() != 0 {
+		t.Errorf("batch is not emptied by commit")
+	}
+
+	// nothing is applied when a command fails
+	err := db.Exec([]Command{{Op: "DEL", Key: "PORT|Ethernet1"}, {Op: "SET", Key: "foo"}})
+	if err == nil {
+		t.Errorf("expected error")
+	}
+	if h, _ := db.HGetAll("PORT|Ethernet1"); len(h) == 0 {
+		t.Errorf("failed batch is partially applied")
+	}
 }
@@ -0,0 +1,216 @@
+package sonic
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// typed entries of the tables oopt reads and writes.
+// the json tags are the field names in redis and in config_db.json.
+// []string fields are stored as "<name>@" lists
+
+// PortEntry is an entry of PORT in CONFIG_DB and PORT_TABLE in APPL_DB
+type PortEntry struct {
+	Lanes       string `json:"lanes"`
+	Speed       string `json:"speed,omitempty"`
+	AdminStatus string `json:"admin_status,omitempty"`
+	MTU         string `json:"mtu,omitempty"`
+	Autoneg     string `json:"autoneg,omitempty"`
+	PfcAsym     string `json:"pfc_asym,omitempty"`
+	Description string `json:"description,omitempty"`
+	// only in APPL_DB
+	OperStatus string `json:"oper_status,omitempty"`
+}
+
+// VlanEntry is an entry of VLAN in CONFIG_DB
+type VlanEntry struct {
+	Members []string `json:"members"`
+	VID     int      `json:"vlanid"`
+}
+
+// VlanMemberEntry is an entry of VLAN_MEMBER in CONFIG_DB
+type VlanMemberEntry struct {
+	Mode string `json:"tagging_mode"`
+}
+
+// ModuleConfigEntry is an entry of MODULE_CONFIG_TABLE in TRANSPORT_CONFIG_DB
+type ModuleConfigEntry struct {
+	Index           int    `json:"index"`
+	TxFrequencyCh   int    `json:"tx-frequency-ch,omitempty"`
+	TxFrequencyGrid string `json:"tx-frequency-grid"`
+	Losi            string `json:"losi"`
+	Prbs            string `json:"prbs"`
+	ModulationType  string `json:"modulation-type"`
+	BerInterval     int    `json:"ber-interval"`
+	Enabled         string `json:"enabled"`
+	// the central frequency and the slot width of FLEXGRID
+	TxFrequencyN string `json:"tx-frequency-n,omitempty"`
+	TxSlotWidthM int    `json:"tx-slot-width-m,omitempty"`
+}
+
+// ModuleMappingEntry is an entry of MODULE_MAPPING in TRANSPORT_STATE_DB
+type ModuleMappingEntry struct {
+	Netif []string `json:"netif,omitempty"`
+}
+
+// NetifStateEntry is an entry of NETIF_STATE_TABLE in TRANSPORT_STATE_DB
+type NetifStateEntry struct {
+	Rms        string   `json:"rms,omitempty"`
+	SyncError  string   `json:"sync-error,omitempty"`
+	Status     string   `json:"status,omitempty"`
+	HdFecBer   []string `json:"hd-fec-ber,omitempty"`
+	SdFecBer   []string `json:"sd-fec-ber,omitempty"`
+	PostFecBer []string `json:"post-fec-ber,omitempty"`
+	// decimal numbers in the units of packet-transport.yang
+	TxPower              string `json:"tx-power,omitempty"`
+	RxPower              string `json:"rx-power,omitempty"`
+	Osnr                 string `json:"osnr,omitempty"`
+	ChromaticDispersion  string `json:"chromatic-dispersion,omitempty"`
+	Dgd                  string `json:"dgd,omitempty"`
+	LaserFrequencyOffset string `json:"laser-frequency-offset,omitempty"`
+	CaseTemperature      string `json:"case-temperature,omitempty"`
+}
+
+type entryField struct {
+	name      string
+	omitempty bool
+	index     int
+}
+
+func entryFields(t reflect.Type) []entryField {
+	fields := make([]entryField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		opts := strings.Split(tag, ",")
+		f := entryField{name: opts[0], index: i}
+		for _, o := range opts[1:] {
+			if o == "omitempty" {
+				f.omitempty = true
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func entryStruct(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("entry must be a struct: %T", v)
+	}
+	return rv, nil
+}
+
+// MarshalEntry converts a typed entry to the fields ModEntry and SetEntry take
+func MarshalEntry(v interface{}) (map[string]interface{}, error) {
+	rv, err := entryStruct(v)
+	if err != nil {
+		return nil, err
+	}
+	entry := map[string]interface{}{}
+	for _, f := range entryFields(rv.Type()) {
+		fv := rv.Field(f.index)
+		switch fv.Kind() {
+		case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			entry[f.name] = fv.Interface()
+		case reflect.Slice:
+			if fv.Type().Elem().Kind() != reflect.String {
+				return nil, fmt.Errorf("unsupported type of %s: %s", f.name, fv.Type())
+			}
+			if f.omitempty && fv.Len() == 0 {
+				continue
+			}
+			entry[f.name] = fv.Interface()
+		default:
+			return nil, fmt.Errorf("unsupported type of %s: %s", f.name, fv.Type())
+		}
+	}
+	return entry, nil
+}
+
+// UnmarshalEntry fills a typed entry from the fields GetEntry returns.
+// fields which are not in the entry are left untouched
+func UnmarshalEntry(entry map[string]interface{}, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr {
+		return fmt.Errorf("entry must be a pointer to a struct: %T", v)
+	}
+	rv, err := entryStruct(v)
+	if err != nil {
+		return err
+	}
+	for _, f := range entryFields(rv.Type()) {
+		value, ok := entry[f.name]
+		if !ok {
+			continue
+		}
+		fv := rv.Field(f.index)
+		if fv.Kind() == reflect.Slice {
+			l, ok := value.([]string)
+			if !ok {
+				return fmt.Errorf("%s is not a list: %v", f.name, value)
+			}
+			fv.Set(reflect.ValueOf(append([]string(nil), l...)))
+			continue
+		}
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s is a list: %v", f.name, value)
+		}
+		switch fv.Kind() {
+		case reflect.String:
+			fv.SetString(s)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n, err := strconv.ParseInt(s, 10, fv.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("invalid %s: %v", f.name, err)
+			}
+			fv.SetInt(n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n, err := strconv.ParseUint(s, 10, fv.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("invalid %s: %v", f.name, err)
+			}
+			fv.SetUint(n)
+		default:
+			return fmt.Errorf("unsupported type of %s: %s", f.name, fv.Type())
+		}
+	}
+	return nil
+}
+
+// LoadEntry reads the entry into v. it returns false when the entry doesn't exist
+func (c *SONiCDBClient) LoadEntry(table, key string, v interface{}) (bool, error) {
+	entry, err := c.GetEntry(table, key)
+	if err != nil {
+		return false, err
+	}
+	if len(entry) == 0 {
+		return false, nil
+	}
+	if err = UnmarshalEntry(entry, v); err != nil {
+		return true, fmt.Errorf("%s%s%s: %v", strings.ToUpper(table), c.separator, key, err)
+	}
+	return true, nil
+}
+
+// StoreEntry stages the replacement of the entry with the typed entry v
+func (b *Batch) StoreEntry(table, key string, v interface{}) error {
+	entry, err := MarshalEntry(v)
+	if err != nil {
+		return err
+	}
+	return b.SetEntry(table, key, entry)
+}
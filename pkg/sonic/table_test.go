@@ -0,0 +1,154 @@
+package sonic
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
+)
+
+func TestMarshalEntry(t *testing.T) {
+	entry, err := MarshalEntry(VlanEntry{VID: 100, Members: []string{"Ethernet1", "Ethernet17"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"vlanid": 100, "members": []string{"Ethernet1", "Ethernet17"}}
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("expected %v, got %v", expected, entry)
+	}
+	// omitempty fields are not written
+	entry, err = MarshalEntry(&PortEntry{Lanes: "1", MTU: "9100"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = map[string]interface{}{"lanes": "1", "mtu": "9100"}
+	if !reflect.DeepEqual(entry, expected) {
+		t.Errorf("expected %v, got %v", expected, entry)
+	}
+}
+
+func TestLoadEntry(t *testing.T) {
+	client, store := newTestClient(t, CONFIG_DB)
+	db := store.DB(CONFIG_DB)
+	db.HMSet("VLAN|Vlan100", map[string]interface{}{"vlanid": "100", "members@": "Ethernet1,Ethernet17"})
+	db.HMSet("VLAN|Vlan200", map[string]interface{}{"vlanid": "abc"})
+	db.HMSet("VLAN|Vlan300", map[string]interface{}{"members": "Ethernet1"})
+
+	v := &VlanEntry{}
+	ok, err := client.LoadEntry(VLAN_TABLE, "Vlan100", v)
+	if err != nil || !ok {
+		t.Fatal(ok, err)
+	}
+	if !reflect.DeepEqual(v, &VlanEntry{VID: 100, Members: []string{"Ethernet1", "Ethernet17"}}) {
+		t.Errorf("unexpected entry: %v", v)
+	}
+	if ok, err = client.LoadEntry(VLAN_TABLE, "Vlan400", &VlanEntry{}); ok || err != nil {
+		t.Errorf("unexpected result for a missing entry: %v %v", ok, err)
+	}
+	// type errors are returned instead of panics
+	if _, err = client.LoadEntry(VLAN_TABLE, "Vlan200", &VlanEntry{}); err == nil {
+		t.Error("expected error for an invalid vlanid")
+	}
+	if _, err = client.LoadEntry(VLAN_TABLE, "Vlan300", &VlanEntry{}); err == nil {
+		t.Error("expected error for members which is not a list")
+	}
+}
+
+func TestGetTableScan(t *testing.T) {
+	client, store := newTestClient(t, CONFIG_DB)
+	db := store.DB(CONFIG_DB)
+	// more keys than a SCAN page
+	for i := 0; i < SCAN_COUNT*2+5; i++ {
+		db.HMSet(fmt.Sprintf("PORT|Ethernet%d", i), map[string]interface{}{"lanes": i})
+	}
+	db.HMSet("VLAN|Vlan100", map[string]interface{}{"vlanid": "100"})
+	table, err := client.GetTable("PORT")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table) != SCAN_COUNT*2+5 {
+		t.Errorf("unexpected number of entries: %d", len(table))
+	}
+}
+
+func TestFillTransportStateInvalidBer(t *testing.T) {
+	_, store := newTestClient(t, TRANSPORT_STATE_DB)
+	db := store.DB(TRANSPORT_STATE_DB)
+	db.HMSet("MODULE_MAPPING|Opt1", map[string]interface{}{"netif@": "Netif1"})
+	db.HMSet("NETIF_STATE_TABLE|Netif1", map[string]interface{}{"status": "ready", "hd-fec-ber@": "0.1"})
+	m := &model.PacketTransponder_OpticalModule{}
+	if err := FillTransportState("Opt1", m); err == nil {
+		t.Error("expected error for hd-fec-ber without a value per channel")
+	}
+}
+
+func TestFillTransportStateBerChannels(t *testing.T) {
+	if _, err := platform.Get("test-single-channel"); err != nil {
+		platform.Register(&platform.Platform{
+			Name: "test-single-channel",
+			OpticalModules: []*platform.OpticalModule{{
+				Name:     "Opt1",
+				Channels: []*platform.Channel{{Name: "A"}},
+			}},
+		})
+	}
+	if err := platform.Select("test-single-channel"); err != nil {
+		t.Fatal(err)
+	}
+	defer platform.Select(platform.DEFAULT_PLATFORM)
+
+	_, store := newTestClient(t, TRANSPORT_STATE_DB)
+	db := store.DB(TRANSPORT_STATE_DB)
+	db.HMSet("MODULE_MAPPING|Opt1", map[string]interface{}{"netif@": "Netif1"})
+	db.HMSet("NETIF_STATE_TABLE|Netif1", map[string]interface{}{"status": "ready", "hd-fec-ber@": "0.1"})
+	m := &model.PacketTransponder_OpticalModule{}
+	if err := FillTransportState("Opt1", m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.ChannelStats) != 1 || *m.ChannelStats["A"].HdFecBerValue != 0.1 {
+		t.Errorf("unexpected channel stats: %v", m.ChannelStats)
+	}
+
+	db.HMSet("NETIF_STATE_TABLE|Netif1", map[string]interface{}{"hd-fec-ber@": "0.1,0.2"})
+	if err := FillTransportState("Opt1", &model.PacketTransponder_OpticalModule{}); err == nil {
+		t.Error("expected error for hd-fec-ber with more values than the channels")
+	}
+}
+
+func TestFillTransportStateDecimal(t *testing.T) {
+	_, store := newTestClient(t, TRANSPORT_STATE_DB)
+	db := store.DB(TRANSPORT_STATE_DB)
+	db.HMSet("MODULE_MAPPING|Opt1", map[string]interface{}{"netif@": "Netif1"})
+	db.HMSet("NETIF_STATE_TABLE|Netif1", map[string]interface{}{
+		"status":           "ready",
+		"tx-power":         "1.234",
+		"rx-power":         "-12.5",
+		"osnr":             "30.01",
+		"case-temperature": "45.25",
+		"sd-fec-ber@":      "1.0e-3,0",
+		"post-fec-ber@":    "0.0,N/A",
+	})
+	m := &model.PacketTransponder_OpticalModule{}
+	if err := FillTransportState("Opt1", m); err != nil {
+		t.Fatal(err)
+	}
+	if *m.TxPower != 1.23 || *m.RxPower != -12.5 || *m.Osnr != 30.01 || *m.CaseTemperature != 45.25 || m.Dgd != nil {
+		t.Errorf("unexpected state: %v %v %v %v %v", *m.TxPower, *m.RxPower, *m.Osnr, *m.CaseTemperature, m.Dgd)
+	}
+	a, b := m.ChannelStats["A"], m.ChannelStats["B"]
+	// Q-factor of BER 1e-3 is about 9.8dB
+	if *a.SdFecBerValue != 1e-3 || a.QFactor == nil || *a.QFactor != 9.8 {
+		t.Errorf("unexpected channel A: %v %v", a.SdFecBerValue, a.QFactor)
+	}
+	// the string is kept even when it isn't a number
+	if b.QFactor != nil || *b.PostFecBer != "N/A" || b.PostFecBerValue != nil {
+		t.Errorf("unexpected channel B: %v %v %v", b.QFactor, *b.PostFecBer, b.PostFecBerValue)
+	}
+
+	db.HMSet("NETIF_STATE_TABLE|Netif1", map[string]interface{}{"osnr": "high"})
+	if err := FillTransportState("Opt1", &model.PacketTransponder_OpticalModule{}); err == nil {
+		t.Error("expected error for an invalid osnr")
+	}
+}
@@ -2,6 +2,7 @@ package sonic
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/openconfig/ygot/ygot"
 
 	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
 )
 
 const (
@@ -19,45 +21,65 @@ const (
 	NETIF_STATE_TABLE = "NETIF_STATE_TABLE"
 )
 
-func gridTypeToInt(t model.E_PacketTransport_FrequencyGridType) int {
+// gridTypeToString returns tx-frequency-grid of MODULE_CONFIG_TABLE.
+// unknown grids are errors not to tune the laser to a wrong frequency
+func gridTypeToString(t model.E_PacketTransport_FrequencyGridType) (string, error) {
 	switch t {
 	case model.PacketTransport_FrequencyGridType_GRID_100GHZ:
-		return 100
+		return "100", nil
 	case model.PacketTransport_FrequencyGridType_GRID_50GHZ:
-		return 50
+		return "50", nil
 	case model.PacketTransport_FrequencyGridType_GRID_33GHZ:
-		return 33
+		return "33", nil
 	case model.PacketTransport_FrequencyGridType_GRID_25GHZ:
-		return 25
+		return "25", nil
+	case model.PacketTransport_FrequencyGridType_FLEXGRID:
+		return "flex", nil
 	}
-	return 50
+	return "", fmt.Errorf("unknown grid: %d", t)
 }
 
-func HandleOptDiff(name string, task []DiffTask) error {
-	if !strings.HasPrefix(name, "Opt") {
-		return fmt.Errorf("invalid optical-module name: %s", name)
+// moduleFrequencyFields are the fields of MODULE_CONFIG_TABLE which tune the laser
+var moduleFrequencyFields = []string{"tx-frequency-grid", "tx-frequency-ch", "tx-frequency-n", "tx-slot-width-m"}
+
+// frequencyConfigEntry returns the frequency fields of MODULE_CONFIG_TABLE for the frequency config.
+// the defaults are filled so that the incremental updates agree with ConfigureTransport
+func frequencyConfigEntry(f *model.PacketTransponder_OpticalModule_OpticalModuleFrequency) (ModuleConfigEntry, error) {
+	config := model.PacketTransponder_OpticalModule_OpticalModuleFrequency{}
+	if f != nil {
+		config = *f
+	}
+	fillDefaultFrequencyConfig(&config)
+	grid, err := gridTypeToString(config.Grid)
+	if err != nil {
+		return ModuleConfigEntry{}, err
+	}
+	entry := ModuleConfigEntry{TxFrequencyGrid: grid}
+	// the channel is given by n and m instead of the channel number for FLEXGRID
+	if config.Grid == model.PacketTransport_FrequencyGridType_FLEXGRID {
+		entry.TxFrequencyN = strconv.Itoa(int(*config.CentralFrequencyN))
+		entry.TxSlotWidthM = int(*config.SlotWidthM)
+	} else {
+		entry.TxFrequencyCh = int(*config.Channel)
+	}
+	return entry, nil
+}
+
+func HandleOptDiff(newConfig *model.PacketTransponder, name string, task []DiffTask) error {
+	module, err := platform.Current().OpticalModule(name)
+	if err != nil {
+		return err
 	}
 	entry := map[string]interface{}{}
+	frequencyChanged := false
 
 	for _, t := range task {
-		switch path := t.Path.String(); path {
-		case "optical-module-frequency.grid":
-			var grid int
-			e := model.ΛEnum["E_PacketTransport_FrequencyGridType"]
-			switch t.Value.Value.(*gnmipb.TypedValue_StringVal).StringVal {
-			case e[int64(model.PacketTransport_FrequencyGridType_GRID_100GHZ)].Name:
-				grid = 100
-			case e[int64(model.PacketTransport_FrequencyGridType_GRID_50GHZ)].Name:
-				grid = 50
-			case e[int64(model.PacketTransport_FrequencyGridType_GRID_33GHZ)].Name:
-				grid = 33
-			case e[int64(model.PacketTransport_FrequencyGridType_GRID_25GHZ)].Name:
-				grid = 25
-			}
-			entry["tx-frequency-grid"] = grid
-		case "optical-module-frequency.channel":
-			ch := t.Value.Value.(*gnmipb.TypedValue_UintVal).UintVal
-			entry["tx-frequency-ch"] = ch
+		path := t.Path.String()
+		if strings.HasPrefix(path, "optical-module-frequency.") {
+			frequencyChanged = true
+			continue
+		}
+		switch path {
 		case "ber-interval":
 			interval := t.Value.Value.(*gnmipb.TypedValue_UintVal).UintVal
 			entry[path] = interval
@@ -83,29 +105,60 @@ func HandleOptDiff(name string, task []DiffTask) error {
 				entry[path] = "off"
 			}
 		case "modulation-type":
-			mod := t.Value.Value.(*gnmipb.TypedValue_StringVal).StringVal
-			e := model.ΛEnum["E_PacketTransport_OpticalModulationType"]
-			switch mod {
-			case e[int64(model.PacketTransport_OpticalModulationType_DP_QPSK)].Name:
-				entry[path] = "dp-qpsk"
-			case e[int64(model.PacketTransport_OpticalModulationType_DP_16QAM)].Name:
-				entry[path] = "dp-16qam"
+			modName := t.Value.Value.(*gnmipb.TypedValue_StringVal).StringVal
+			typ := model.PacketTransport_OpticalModulationType_UNSET
+			for k, v := range model.ΛEnum["E_PacketTransport_OpticalModulationType"] {
+				if v.Name == modName {
+					typ = model.E_PacketTransport_OpticalModulationType(k)
+					break
+				}
 			}
+			mod, err := module.Modulation(typ)
+			if err != nil {
+				return err
+			}
+			entry[path] = mod.ConfigName
+		case "alarm-thresholds.post-fec-ber", "alarm-thresholds.sd-fec-ber-rising-samples":
+			// evaluated by the alarm manager of the gnmi server
 		default:
 			fmt.Println("unhandled task:", path)
 		}
 	}
 
+	// the frequency fields are written as a whole from the new config
+	// removing the fields the grid doesn't use
+	unused := []string{}
+	if o, ok := newConfig.OpticalModule[name]; ok && frequencyChanged {
+		fe, err := frequencyConfigEntry(o.OpticalModuleFrequency)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		fields, err := MarshalEntry(fe)
+		if err != nil {
+			return err
+		}
+		for _, f := range moduleFrequencyFields {
+			if v, ok := fields[f]; ok {
+				entry[f] = v
+			} else {
+				unused = append(unused, f)
+			}
+		}
+	}
+
 	if len(entry) == 0 {
 		return nil
 	}
 
-	client, err := NewSONiCDBClient("unix", DEFAULT_REDIS_UNIX_SOCKET, TRANSPORT_CONFIG_DB)
+	client, err := GetClient(TRANSPORT_CONFIG_DB)
 	if err != nil {
 		return err
 	}
 
-	return client.ModEntry(CONFIG_TABLE, name, entry)
+	b := client.Batch()
+	b.ModEntry(CONFIG_TABLE, name, entry)
+	b.DelFields(CONFIG_TABLE, name, unused...)
+	return b.Commit()
 }
 
 func createCh(t *model.PacketTransponder_OpticalModule, n string) *model.PacketTransponder_OpticalModule_ChannelStats {
@@ -120,18 +173,12 @@ func createCh(t *model.PacketTransponder_OpticalModule, n string) *model.PacketT
 	return t.ChannelStats[n]
 }
 
-func calculateOccupancy(ch string, t *model.PacketTransponder_OpticalModule, current *model.PacketTransponder) (float32, error) {
-	totalCapacity := 0
-	switch t.ModulationType {
-	case model.PacketTransport_OpticalModulationType_DP_QPSK:
-		if ch == "A" {
-			totalCapacity = 100000
-		}
-	case model.PacketTransport_OpticalModulationType_DP_16QAM:
-		totalCapacity = 100000
-	default:
-		return 0, fmt.Errorf("unknown modulation type: %d", t.ModulationType)
+func calculateOccupancy(ch string, module *platform.OpticalModule, t *model.PacketTransponder_OpticalModule, current *model.PacketTransponder) (float32, error) {
+	mod, err := module.Modulation(t.ModulationType)
+	if err != nil {
+		return 0, err
 	}
+	totalCapacity := mod.Capacity[ch]
 	acc := 0
 	for _, v := range current.Interface {
 		c := v.OpticalModuleConnection
@@ -155,6 +202,8 @@ func calculateOccupancy(ch string, t *model.PacketTransponder_OpticalModule, cur
 				acc += 1000
 			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_2500MB:
 				acc += 2500
+			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_20GB:
+				acc += 20000
 			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_25GB:
 				acc += 25000
 			case model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB:
@@ -184,22 +233,13 @@ func FillTransportDefaultConfig(t *model.PacketTransponder_OpticalModule, curren
 	if t.OpticalModuleFrequency == nil {
 		t.OpticalModuleFrequency = &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{}
 	}
-	if t.OpticalModuleFrequency.Channel == nil {
-		ch := uint8(1)
-		t.OpticalModuleFrequency.Channel = &ch
-	}
-	if t.OpticalModuleFrequency.Grid == model.PacketTransport_FrequencyGridType_UNSET {
-		t.OpticalModuleFrequency.Grid = model.PacketTransport_FrequencyGridType_GRID_50GHZ
-	}
+	fillDefaultFrequencyConfig(t.OpticalModuleFrequency)
 	if t.Losi == nil {
 		t.Losi = ygot.Bool(false)
 	}
 	if t.Prbs == nil {
 		t.Prbs = ygot.Bool(false)
 	}
-	if t.ModulationType == model.PacketTransport_OpticalModulationType_UNSET {
-		t.ModulationType = model.PacketTransport_OpticalModulationType_DP_16QAM
-	}
 	if t.BerInterval == nil {
 		t.BerInterval = ygot.Uint32(100)
 	}
@@ -213,8 +253,18 @@ func FillTransportDefaultConfig(t *model.PacketTransponder_OpticalModule, curren
 		}
 		t.AllowOversubscription = a
 	}
-	for _, ch := range []string{"A", "B"} {
-		occ, err := calculateOccupancy(ch, t, current)
+	if t.Name == nil {
+		return fmt.Errorf("optical module name is nil")
+	}
+	module, err := platform.Current().OpticalModule(*t.Name)
+	if err != nil {
+		return err
+	}
+	if t.ModulationType == model.PacketTransport_OpticalModulationType_UNSET {
+		t.ModulationType = module.DefaultModulation
+	}
+	for _, ch := range module.ChannelNames() {
+		occ, err := calculateOccupancy(ch, module, t, current)
 		if err != nil {
 			return err
 		}
@@ -223,34 +273,51 @@ func FillTransportDefaultConfig(t *model.PacketTransponder_OpticalModule, curren
 	return nil
 }
 
+// parseDecimal parses a value of NETIF_STATE_TABLE rounding it to the fraction digits of the leaf
+func parseDecimal(name, value string, fractionDigits int) (*float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	e := math.Pow10(fractionDigits)
+	return ygot.Float64(math.Round(v*e) / e), nil
+}
+
+// qFactor returns Q-factor in dB for the BER before error correction
+func qFactor(ber float64) (float64, bool) {
+	if ber <= 0 || ber >= 0.5 {
+		return 0, false
+	}
+	q := 20 * math.Log10(math.Sqrt2*math.Erfcinv(2*ber))
+	return math.Round(q*100) / 100, true
+}
+
 func FillTransportState(name string, t *model.PacketTransponder_OpticalModule) error {
 	if t == nil {
 		return fmt.Errorf("model is nil")
 	}
-	client, err := NewSONiCDBClient("unix", DEFAULT_REDIS_UNIX_SOCKET, TRANSPORT_STATE_DB)
+	fillFrequencyState(t)
+
+	client, err := GetClient(TRANSPORT_STATE_DB)
 	if err != nil {
 		return err
 	}
 
-	entry, err := client.GetEntry(MAPPING_TABLE, name)
-	if err != nil {
+	mapping := &ModuleMappingEntry{}
+	if _, err = client.LoadEntry(MAPPING_TABLE, name, mapping); err != nil {
 		return err
 	}
-
-	s, ok := entry["netif"]
-	if !ok {
+	if len(mapping.Netif) == 0 {
 		return nil
 	}
 
-	nid := s.([]string)[0]
-	entry, err = client.GetEntry(NETIF_STATE_TABLE, nid)
-	if err != nil {
+	entry := &NetifStateEntry{}
+	if _, err = client.LoadEntry(NETIF_STATE_TABLE, mapping.Netif[0], entry); err != nil {
 		return err
 	}
 
-	if s, ok := entry["rms"]; ok {
-		rms := s.(string)
-		elems := strings.Split(rms, ",")
+	if entry.Rms != "" {
+		elems := strings.Split(entry.Rms, ",")
 		if len(elems) != 4 {
 			elems = []string{"0", "0", "0", "0"}
 		}
@@ -284,16 +351,14 @@ func FillTransportState(name string, t *model.PacketTransponder_OpticalModule) e
 		}
 	}
 
-	if s, ok := entry["sync-error"]; ok {
-		if s.(string) == "false" {
-			t.SyncError = ygot.Bool(false)
-		} else if s.(string) == "true" {
-			t.SyncError = ygot.Bool(true)
-		}
+	if entry.SyncError == "false" {
+		t.SyncError = ygot.Bool(false)
+	} else if entry.SyncError == "true" {
+		t.SyncError = ygot.Bool(true)
 	}
 
-	if s, ok := entry["status"]; ok {
-		switch s.(string) {
+	if entry.Status != "" {
+		switch entry.Status {
 		case "down":
 			t.OperationStatus = model.PacketTransport_OpticalModuleStatusType_STATE_DOWN
 		case "booting-top-half":
@@ -311,47 +376,95 @@ func FillTransportState(name string, t *model.PacketTransponder_OpticalModule) e
 		}
 	}
 
-	if s, ok := entry["hd-fec-ber"]; ok {
-		e := s.([]string)
-		createCh(t, "A").HdFecBer = ygot.String(e[0])
-		createCh(t, "B").HdFecBer = ygot.String(e[1])
+	for _, d := range []struct {
+		name  string
+		value string
+		field **float64
+	}{
+		{"tx-power", entry.TxPower, &t.TxPower},
+		{"rx-power", entry.RxPower, &t.RxPower},
+		{"osnr", entry.Osnr, &t.Osnr},
+		{"chromatic-dispersion", entry.ChromaticDispersion, &t.ChromaticDispersion},
+		{"dgd", entry.Dgd, &t.Dgd},
+		{"laser-frequency-offset", entry.LaserFrequencyOffset, &t.LaserFrequencyOffset},
+		{"case-temperature", entry.CaseTemperature, &t.CaseTemperature},
+	} {
+		if d.value == "" {
+			continue
+		}
+		v, err := parseDecimal(d.name, d.value, 2)
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		*d.field = v
 	}
 
-	if s, ok := entry["sd-fec-ber"]; ok {
-		e := s.([]string)
-		createCh(t, "A").SdFecBer = ygot.String(e[0])
-		createCh(t, "B").SdFecBer = ygot.String(e[1])
+	// the BER values are given in the order of the channels of the module
+	module, err := platform.Current().OpticalModule(name)
+	if err != nil {
+		return err
+	}
+	channels := module.ChannelNames()
+
+	for _, ber := range []struct {
+		name   string
+		values []string
+		field  func(*model.PacketTransponder_OpticalModule_ChannelStats) (**string, **float64)
+	}{
+		{"hd-fec-ber", entry.HdFecBer, func(c *model.PacketTransponder_OpticalModule_ChannelStats) (**string, **float64) {
+			return &c.HdFecBer, &c.HdFecBerValue
+		}},
+		{"sd-fec-ber", entry.SdFecBer, func(c *model.PacketTransponder_OpticalModule_ChannelStats) (**string, **float64) {
+			return &c.SdFecBer, &c.SdFecBerValue
+		}},
+		{"post-fec-ber", entry.PostFecBer, func(c *model.PacketTransponder_OpticalModule_ChannelStats) (**string, **float64) {
+			return &c.PostFecBer, &c.PostFecBerValue
+		}},
+	} {
+		if len(ber.values) == 0 {
+			continue
+		}
+		if len(ber.values) != len(channels) {
+			return fmt.Errorf("%s of %s must have a value per channel %s: %v", ber.name, name, strings.Join(channels, ", "), ber.values)
+		}
+		for i, ch := range channels {
+			s, v := ber.field(createCh(t, ch))
+			*s = ygot.String(ber.values[i])
+			// the string is kept even when it isn't a number for compatibility
+			if n, err := strconv.ParseFloat(strings.TrimSpace(ber.values[i]), 64); err == nil {
+				*v = ygot.Float64(n)
+			}
+		}
 	}
 
-	if s, ok := entry["post-fec-ber"]; ok {
-		e := s.([]string)
-		createCh(t, "A").PostFecBer = ygot.String(e[0])
-		createCh(t, "B").PostFecBer = ygot.String(e[1])
+	for _, c := range t.ChannelStats {
+		if c.SdFecBerValue == nil {
+			continue
+		}
+		if q, ok := qFactor(*c.SdFecBerValue); ok {
+			c.QFactor = ygot.Float64(q)
+		}
 	}
 
 	return nil
 }
 
-func ConfigureTransport(m *model.PacketTransponder) error {
-	client, err := NewSONiCDBClient("unix", DEFAULT_REDIS_UNIX_SOCKET, TRANSPORT_CONFIG_DB)
-	if err != nil {
-		return err
-	}
-
+// TransportConfigEntries returns the MODULE_CONFIG_TABLE entries of the optical modules
+func TransportConfigEntries(m *model.PacketTransponder) (map[string]ModuleConfigEntry, error) {
+	entries := make(map[string]ModuleConfigEntry, len(m.OpticalModule))
 	for k, v := range m.OpticalModule {
-		if !strings.HasPrefix(k, "Opt") {
-			return fmt.Errorf("invalid optical-module name: %s", k)
-		}
-		index, err := strconv.Atoi(k[len("Opt"):])
+		module, err := platform.Current().OpticalModule(k)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err = FillTransportDefaultConfig(v, m); err != nil {
-			return err
+			return nil, err
 		}
 
-		ch := int(*v.OpticalModuleFrequency.Channel)
-		grid := gridTypeToInt(v.OpticalModuleFrequency.Grid)
+		frequency, err := frequencyConfigEntry(v.OpticalModuleFrequency)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", k, err)
+		}
 		ber := int(*v.BerInterval)
 
 		losi := "off"
@@ -364,9 +477,9 @@ func ConfigureTransport(m *model.PacketTransponder) error {
 			prbs = "on"
 		}
 
-		mod := "dp-16qam"
-		if v.ModulationType == model.PacketTransport_OpticalModulationType_DP_QPSK {
-			mod = "dp-qpsk"
+		mod, err := module.Modulation(v.ModulationType)
+		if err != nil {
+			return nil, err
 		}
 
 		enabled := "on"
@@ -374,20 +487,38 @@ func ConfigureTransport(m *model.PacketTransponder) error {
 			enabled = "off"
 		}
 
-		entry := map[string]interface{}{
-			"index":             index - 1,
-			"tx-frequency-ch":   ch,
-			"tx-frequency-grid": grid,
-			"losi":              losi,
-			"prbs":              prbs,
-			"modulation-type":   mod,
-			"ber-interval":      ber,
-			"enabled":           enabled,
+		entries[k] = ModuleConfigEntry{
+			Index:           module.Index,
+			TxFrequencyCh:   frequency.TxFrequencyCh,
+			TxFrequencyGrid: frequency.TxFrequencyGrid,
+			TxFrequencyN:    frequency.TxFrequencyN,
+			TxSlotWidthM:    frequency.TxSlotWidthM,
+			Losi:            losi,
+			Prbs:            prbs,
+			ModulationType:  mod.ConfigName,
+			BerInterval:     ber,
+			Enabled:         enabled,
 		}
-		err = client.SetEntry(CONFIG_TABLE, k, entry)
-		if err != nil {
+	}
+	return entries, nil
+}
+
+func ConfigureTransport(m *model.PacketTransponder) error {
+	entries, err := TransportConfigEntries(m)
+	if err != nil {
+		return err
+	}
+
+	client, err := GetClient(TRANSPORT_CONFIG_DB)
+	if err != nil {
+		return err
+	}
+
+	b := client.Batch()
+	for k, entry := range entries {
+		if err = b.StoreEntry(CONFIG_TABLE, k, entry); err != nil {
 			return err
 		}
 	}
-	return nil
+	return b.Commit()
 }
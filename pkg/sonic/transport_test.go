@@ -0,0 +1,342 @@
+package sonic
+
+import (
+	"reflect"
+	"testing"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/osrg/oopt/pkg/model"
+	"github.com/osrg/oopt/pkg/platform"
+)
+
+func configPath(names ...string) PathElems {
+	p := PathElems{}
+	for _, n := range names {
+		p = append(p, &gnmipb.PathElem{Name: n})
+	}
+	return p
+}
+
+func TestConfigureTransport(t *testing.T) {
+	_, store := newTestClient(t, TRANSPORT_CONFIG_DB)
+	db := store.DB(TRANSPORT_CONFIG_DB)
+	// fields which are not configured anymore are removed
+	db.HMSet("MODULE_CONFIG_TABLE|Opt1", map[string]interface{}{"stale": "1"})
+
+	m := &model.PacketTransponder{}
+	o, err := m.NewOpticalModule("Opt1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.Prbs = ygot.Bool(true)
+	o.ModulationType = model.PacketTransport_OpticalModulationType_DP_QPSK
+	if _, err = m.NewOpticalModule("Opt3"); err != nil {
+		t.Fatal(err)
+	}
+	if err = ConfigureTransport(m); err != nil {
+		t.Fatal(err)
+	}
+	h, _ := db.HGetAll("MODULE_CONFIG_TABLE|Opt1")
+	expected := map[string]string{
+		"index":             "0",
+		"tx-frequency-ch":   "1",
+		"tx-frequency-grid": "50",
+		"losi":              "off",
+		"prbs":              "on",
+		"modulation-type":   "dp-qpsk",
+		"ber-interval":      "100",
+		"enabled":           "on",
+	}
+	if !reflect.DeepEqual(h, expected) {
+		t.Errorf("expected %v, got %v", expected, h)
+	}
+	h, _ = db.HGetAll("MODULE_CONFIG_TABLE|Opt3")
+	if h["index"] != "2" || h["modulation-type"] != "dp-16qam" {
+		t.Errorf("unexpected Opt3 entry: %v", h)
+	}
+}
+
+func TestConfigureTransportUnknownModule(t *testing.T) {
+	newTestClient(t, TRANSPORT_CONFIG_DB)
+	m := &model.PacketTransponder{}
+	if _, err := m.NewOpticalModule("Opt100"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ConfigureTransport(m); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestHandleOptDiff(t *testing.T) {
+	_, store := newTestClient(t, TRANSPORT_CONFIG_DB)
+	db := store.DB(TRANSPORT_CONFIG_DB)
+	db.HMSet("MODULE_CONFIG_TABLE|Opt2", map[string]interface{}{"index": 1, "prbs": "off", "tx-frequency-grid": 50})
+
+	m := &model.PacketTransponder{}
+	o, err := m.NewOpticalModule("Opt2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.OpticalModuleFrequency = &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{
+		Grid:    model.PacketTransport_FrequencyGridType_GRID_100GHZ,
+		Channel: ygot.Uint8(10),
+	}
+	grid := model.ΛEnum["E_PacketTransport_FrequencyGridType"][int64(model.PacketTransport_FrequencyGridType_GRID_100GHZ)].Name
+	err = HandleOptDiff(m, "Opt2", []DiffTask{
+		{Type: DiffModified, Path: configPath("config", "prbs"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_BoolVal{BoolVal: true}}},
+		{Type: DiffModified, Path: configPath("optical-module-frequency", "config", "grid"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{StringVal: grid}}},
+		{Type: DiffModified, Path: configPath("optical-module-frequency", "config", "channel"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_UintVal{UintVal: 10}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _ := db.HGetAll("MODULE_CONFIG_TABLE|Opt2")
+	expected := map[string]string{
+		"index":             "1",
+		"prbs":              "on",
+		"tx-frequency-grid": "100",
+		"tx-frequency-ch":   "10",
+	}
+	if !reflect.DeepEqual(h, expected) {
+		t.Errorf("expected %v, got %v", expected, h)
+	}
+
+	if err = HandleOptDiff(m, "Opt100", nil); err == nil {
+		t.Error("expected error for unknown module")
+	}
+}
+
+func TestConfigureTransportFlexgrid(t *testing.T) {
+	_, store := newTestClient(t, TRANSPORT_CONFIG_DB)
+	db := store.DB(TRANSPORT_CONFIG_DB)
+
+	m := &model.PacketTransponder{}
+	o, err := m.NewOpticalModule("Opt1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.OpticalModuleFrequency = &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{
+		Grid:              model.PacketTransport_FrequencyGridType_FLEXGRID,
+		CentralFrequencyN: ygot.Int16(-8),
+	}
+	if err = ConfigureTransport(m); err != nil {
+		t.Fatal(err)
+	}
+	h, _ := db.HGetAll("MODULE_CONFIG_TABLE|Opt1")
+	if h["tx-frequency-grid"] != "flex" || h["tx-frequency-n"] != "-8" || h["tx-slot-width-m"] != "4" || h["tx-frequency-ch"] != "" {
+		t.Errorf("unexpected entry: %v", h)
+	}
+
+	// unknown grids aren't replaced with 50GHz
+	o.OpticalModuleFrequency.Grid = model.E_PacketTransport_FrequencyGridType(100)
+	if err = ConfigureTransport(m); err == nil {
+		t.Error("expected error for an unknown grid")
+	}
+}
+
+func TestHandleOptDiffFlexgrid(t *testing.T) {
+	_, store := newTestClient(t, TRANSPORT_CONFIG_DB)
+	db := store.DB(TRANSPORT_CONFIG_DB)
+	db.HMSet("MODULE_CONFIG_TABLE|Opt2", map[string]interface{}{"index": 1, "tx-frequency-grid": 100, "tx-frequency-ch": 10})
+
+	m := &model.PacketTransponder{}
+	o, err := m.NewOpticalModule("Opt2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gridTask := func(grid model.E_PacketTransport_FrequencyGridType) []DiffTask {
+		name := model.ΛEnum["E_PacketTransport_FrequencyGridType"][int64(grid)].Name
+		return []DiffTask{
+			{Type: DiffModified, Path: configPath("optical-module-frequency", "config", "grid"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{StringVal: name}}},
+		}
+	}
+
+	// only the grid is changed, so n and m are the defaults and the channel is removed
+	o.OpticalModuleFrequency = &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{
+		Grid:    model.PacketTransport_FrequencyGridType_FLEXGRID,
+		Channel: ygot.Uint8(10),
+	}
+	if err = HandleOptDiff(m, "Opt2", gridTask(model.PacketTransport_FrequencyGridType_FLEXGRID)); err != nil {
+		t.Fatal(err)
+	}
+	h, _ := db.HGetAll("MODULE_CONFIG_TABLE|Opt2")
+	expected := map[string]string{
+		"index":             "1",
+		"tx-frequency-grid": "flex",
+		"tx-frequency-n":    "0",
+		"tx-slot-width-m":   "4",
+	}
+	if !reflect.DeepEqual(h, expected) {
+		t.Errorf("expected %v, got %v", expected, h)
+	}
+
+	o.OpticalModuleFrequency.CentralFrequencyN = ygot.Int16(12)
+	o.OpticalModuleFrequency.SlotWidthM = ygot.Uint8(6)
+	err = HandleOptDiff(m, "Opt2", []DiffTask{
+		{Type: DiffModified, Path: configPath("optical-module-frequency", "config", "central-frequency-n"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_IntVal{IntVal: 12}}},
+		{Type: DiffModified, Path: configPath("optical-module-frequency", "config", "slot-width-m"), Value: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_UintVal{UintVal: 6}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h, _ = db.HGetAll("MODULE_CONFIG_TABLE|Opt2"); h["tx-frequency-n"] != "12" || h["tx-slot-width-m"] != "6" {
+		t.Errorf("unexpected entry: %v", h)
+	}
+
+	// back to a fixed grid without the channel, n and m are removed
+	o.OpticalModuleFrequency = &model.PacketTransponder_OpticalModule_OpticalModuleFrequency{
+		Grid: model.PacketTransport_FrequencyGridType_GRID_100GHZ,
+	}
+	if err = HandleOptDiff(m, "Opt2", gridTask(model.PacketTransport_FrequencyGridType_GRID_100GHZ)); err != nil {
+		t.Fatal(err)
+	}
+	h, _ = db.HGetAll("MODULE_CONFIG_TABLE|Opt2")
+	expected = map[string]string{
+		"index":             "1",
+		"tx-frequency-grid": "100",
+		"tx-frequency-ch":   "1",
+	}
+	if !reflect.DeepEqual(h, expected) {
+		t.Errorf("expected %v, got %v", expected, h)
+	}
+
+	o.OpticalModuleFrequency.Grid = model.E_PacketTransport_FrequencyGridType(100)
+	if err = HandleOptDiff(m, "Opt2", gridTask(model.PacketTransport_FrequencyGridType_GRID_100GHZ)); err == nil {
+		t.Error("expected error for an unknown grid")
+	}
+}
+
+func TestOccupancyModulationCatalogue(t *testing.T) {
+	m := &model.PacketTransponder{}
+	o, err := m.NewOpticalModule("Opt1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the channels of Opt1 of AS7716-24XC
+	intfs := map[string]*model.PacketTransponder_Interface{}
+	for name, ch := range map[string]string{"Ethernet25": "A", "Ethernet26": "B"} {
+		i, err := m.NewInterface(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		i.PortSpeed = model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB
+		i.OpticalModuleConnection = &model.PacketTransponder_Interface_OpticalModuleConnection{
+			OpticalModule: &model.PacketTransponder_Interface_OpticalModuleConnection_OpticalModule{Name: ygot.String("Opt1"), Channel: ygot.String(ch)},
+		}
+		intfs[ch] = i
+	}
+
+	// the default of the module
+	if err = FillTransportDefaultConfig(o, m); err != nil {
+		t.Fatal(err)
+	}
+	if o.ModulationType != model.PacketTransport_OpticalModulationType_DP_16QAM {
+		t.Errorf("unexpected default modulation: %v", o.ModulationType)
+	}
+	if a, b := *o.ChannelStats["A"].Occupancy, *o.ChannelStats["B"].Occupancy; a != "100.000000" || b != "100.000000" {
+		t.Errorf("unexpected occupancy: A %s, B %s", a, b)
+	}
+	entries, err := TransportConfigEntries(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries["Opt1"].ModulationType != "dp-16qam" {
+		t.Errorf("unexpected entry: %v", entries["Opt1"])
+	}
+
+	// 150G with DP_8QAM leaves 50G to channel B
+	o.ModulationType = model.PacketTransport_OpticalModulationType_DP_8QAM
+	intfs["B"].PortSpeed = model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_40GB
+	if err = FillTransportDefaultConfig(o, m); err != nil {
+		t.Fatal(err)
+	}
+	if a, b := *o.ChannelStats["A"].Occupancy, *o.ChannelStats["B"].Occupancy; a != "100.000000" || b != "80.000000" {
+		t.Errorf("unexpected occupancy: A %s, B %s", a, b)
+	}
+	if entries, err = TransportConfigEntries(m); err != nil {
+		t.Fatal(err)
+	}
+	if entries["Opt1"].ModulationType != "dp-8qam" {
+		t.Errorf("unexpected entry: %v", entries["Opt1"])
+	}
+
+	// channel B has no capacity with DP_QPSK
+	o.ModulationType = model.PacketTransport_OpticalModulationType_DP_QPSK
+	if err = FillTransportDefaultConfig(o, m); err == nil {
+		t.Error("expected error for a connection to the channel without capacity")
+	}
+	o.ModulationType = model.PacketTransport_OpticalModulationType_DP_16QAM_200G
+	if err = FillTransportDefaultConfig(o, m); err == nil {
+		t.Error("expected error for a modulation AS7716-24XC doesn't support")
+	}
+}
+
+// test-single-carrier is a module which carries 200G with DP-16QAM on a single carrier.
+// its only channel is connected to the ASIC by two 100G ports
+func TestOccupancySingleCarrier(t *testing.T) {
+	if _, err := platform.Get("test-single-carrier"); err != nil {
+		platform.Register(&platform.Platform{
+			Name: "test-single-carrier",
+			OpticalModules: []*platform.OpticalModule{{
+				Name:     "Opt1",
+				Channels: []*platform.Channel{{Name: "A"}},
+				Modulations: []platform.Modulation{
+					{Type: model.PacketTransport_OpticalModulationType_DP_QPSK, ConfigName: "dp-qpsk", Capacity: map[string]int{"A": 100000}},
+					{Type: model.PacketTransport_OpticalModulationType_DP_16QAM_200G, ConfigName: "dp-16qam-200g", Capacity: map[string]int{"A": 200000}},
+				},
+				DefaultModulation: model.PacketTransport_OpticalModulationType_DP_16QAM_200G,
+			}},
+		})
+	}
+	if err := platform.Select("test-single-carrier"); err != nil {
+		t.Fatal(err)
+	}
+	defer platform.Select(platform.DEFAULT_PLATFORM)
+
+	m := &model.PacketTransponder{}
+	o, err := m.NewOpticalModule("Opt1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Ethernet1", "Ethernet2"} {
+		i, err := m.NewInterface(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		i.PortSpeed = model.OpenconfigIfEthernet_ETHERNET_SPEED_SPEED_100GB
+		i.OpticalModuleConnection = &model.PacketTransponder_Interface_OpticalModuleConnection{
+			OpticalModule: &model.PacketTransponder_Interface_OpticalModuleConnection_OpticalModule{Name: ygot.String("Opt1"), Channel: ygot.String("A")},
+		}
+	}
+	if err = FillTransportDefaultConfig(o, m); err != nil {
+		t.Fatal(err)
+	}
+	if o.ModulationType != model.PacketTransport_OpticalModulationType_DP_16QAM_200G {
+		t.Errorf("unexpected default modulation: %v", o.ModulationType)
+	}
+	if a := *o.ChannelStats["A"].Occupancy; a != "100.000000" {
+		t.Errorf("unexpected occupancy: A %s", a)
+	}
+	entries, err := TransportConfigEntries(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries["Opt1"].ModulationType != "dp-16qam-200g" {
+		t.Errorf("unexpected entry: %v", entries["Opt1"])
+	}
+
+	// the two ports exceed the capacity of DP_QPSK
+	o.ModulationType = model.PacketTransport_OpticalModulationType_DP_QPSK
+	if err = FillTransportDefaultConfig(o, m); err != nil {
+		t.Fatal(err)
+	}
+	if a := *o.ChannelStats["A"].Occupancy; a != "200.000000" {
+		t.Errorf("unexpected occupancy: A %s", a)
+	}
+	o.ModulationType = model.PacketTransport_OpticalModulationType_DP_8QAM
+	if err = FillTransportDefaultConfig(o, m); err == nil {
+		t.Error("expected error for a modulation the module doesn't support")
+	}
+}